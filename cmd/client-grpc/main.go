@@ -8,8 +8,13 @@ import (
 	"time"
 
 	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/certs"
 	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -20,10 +25,27 @@ const (
 func main() {
 	//get configuration
 	address := flag.String("server", "", "gRPC server in format host:port")
+	apiKey := flag.String("api-key", "", "API key sent in authorization metadata")
+	token := flag.String("token", "", "JWT sent in authorization metadata, it takes precedence over API key")
+	tenant := flag.String("tenant", "", "Tenant sent in x-tenant-id metadata to multi-tenant server, it is created when the caller manages tenants")
+	useTLS := flag.Bool("tls", false, "Connect by TLS verifying server by the system CA certificates unless -tls-ca is given")
+	tlsCA := flag.String("tls-ca", "", "PEM file of CA certificates verifying server, it enables TLS")
+	tlsCert := flag.String("tls-cert", "", "PEM file of client certificate sent to server verifying clients, it enables TLS")
+	tlsKey := flag.String("tls-key", "", "PEM file of the private key of client certificate")
 	flag.Parse()
 
+	//connect in plaintext unless TLS is configured
+	dialOpt := grpc.WithInsecure()
+	if *useTLS || len(*tlsCA) > 0 || len(*tlsCert) > 0 {
+		tlsConfig, err := certs.LoadClientConfig(*tlsCA, *tlsCert, *tlsKey)
+		if err != nil {
+			log.Fatalf("invalid TLS configuration: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
 	//Set up a connection to the server
-	conn, err := grpc.Dial(*address, grpc.WithInsecure())
+	conn, err := grpc.Dial(*address, dialOpt)
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
 	}
@@ -34,6 +56,30 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	//changes are recorded in the history under the actor and the request ID
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", "client-grpc", "x-request-id", fmt.Sprintf("client-grpc-%d", time.Now().UnixNano()))
+
+	//credentials are required when server authenticates callers
+	switch {
+	case len(*token) > 0:
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	case len(*apiKey) > 0:
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "ApiKey "+*apiKey)
+	}
+
+	//CreateTenant unless it exists, only tenant administrators are allowed to, then scope the calls to the tenant
+	if len(*tenant) > 0 {
+		res, err := c.CreateTenant(ctx, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: *tenant, Name: "client-grpc"}})
+		switch status.Code(err) {
+		case codes.OK:
+			log.Printf("CreateTenant result: <%+v>\n\n", res)
+		case codes.AlreadyExists:
+		default:
+			log.Printf("CreateTenant failed: %v", err)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", *tenant)
+	}
+
 	t := time.Now().In(time.UTC)
 	etc := time.Date(
 		2019, 10, 17, 20, 34, 58, 651387237, time.UTC)
@@ -49,10 +95,11 @@ func main() {
 		ToDo: &v1.ToDo{
 			Title:                     fmt.Sprintf("title(%s)", pfx),
 			Description:               fmt.Sprintf("description (%s)", pfx),
-			Status:                    "Started",
+			Status:                    v1.Status_IN_PROGRESS,
 			EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-			ActualTimeOfCompletion:    estimatedTimeOfCompletion, //Initial actualTimeOfCompletion is the same as etimatedTimeOfCompletion
 			Reminder:                  reminder,
+			Recurrence:                "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=4",
+			Labels:                    []string{"work", "weekly"},
 		},
 	}
 	res1, err := c.Create(ctx, &req1)
@@ -82,9 +129,11 @@ func main() {
 			Id:                        res2.ToDo.Id,
 			Title:                     res2.ToDo.Title,
 			Description:               res2.ToDo.Description,
-			Status:                    "Completed",
+			Status:                    v1.Status_DONE,
 			EstimatedTimeOfCompletion: res2.ToDo.EstimatedTimeOfCompletion,
 			Reminder:                  res2.ToDo.Reminder,
+			Recurrence:                res2.ToDo.Recurrence,
+			Version:                   res2.ToDo.Version,
 		},
 	}
 
@@ -94,6 +143,74 @@ func main() {
 	}
 	log.Printf("Update result: <+%v>\n\n", res3)
 
+	//ListCompletions of the completed ToDo entity
+	req6 := v1.ListCompletionsRequest{
+		Api: apiVersion,
+		Id:  id,
+	}
+	res6, err := c.ListCompletions(ctx, &req6)
+	if err != nil {
+		log.Fatalf("ListCompletions failed: %v", err)
+	}
+	log.Printf("ListCompletions result: <%+v>\n\n", res6)
+
+	//ListOccurrences of the recurring ToDo entity
+	req7 := v1.ListOccurrencesRequest{
+		Api:       apiVersion,
+		Id:        id,
+		StartTime: estimatedTimeOfCompletion,
+	}
+	res7, err := c.ListOccurrences(ctx, &req7)
+	if err != nil {
+		log.Fatalf("ListOccurrences failed: %v", err)
+	}
+	log.Printf("ListOccurrences result: <%+v>\n\n", res7)
+
+	//ListLabels in use
+	res16, err := c.ListLabels(ctx, &v1.ListLabelsRequest{Api: apiVersion})
+	if err != nil {
+		log.Fatalf("ListLabels failed: %v", err)
+	}
+	log.Printf("ListLabels result: <%+v>\n\n", res16)
+
+	//Search ToDo entities by the phrase of their description
+	res17, err := c.Search(ctx, &v1.SearchRequest{
+		Api: apiVersion,
+		Q:   fmt.Sprintf(`"description %s"`, pfx),
+	})
+	if err != nil {
+		log.Fatalf("Search failed: %v", err)
+	}
+	log.Printf("Search result: <%+v>\n\n", res17)
+
+	//BatchCreate ToDo entities in one transaction and BatchDelete them
+	batch := &v1.BatchCreateRequest{Api: apiVersion, Mode: v1.BatchMode_PER_ITEM}
+	for i := 1; i <= 3; i++ {
+		batch.Requests = append(batch.Requests, &v1.CreateRequest{
+			Api: apiVersion,
+			ToDo: &v1.ToDo{
+				Title:                     fmt.Sprintf("imported %d (%s)", i, pfx),
+				EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+				Reminder:                  reminder,
+			},
+		})
+	}
+	res18, err := c.BatchCreate(ctx, batch)
+	if err != nil {
+		log.Fatalf("BatchCreate failed: %v", err)
+	}
+	log.Printf("BatchCreate result: <%+v>\n\n", res18)
+
+	deletes := &v1.BatchDeleteRequest{Api: apiVersion}
+	for _, r := range res18.Results {
+		deletes.Requests = append(deletes.Requests, &v1.DeleteRequest{Api: apiVersion, Id: r.Id})
+	}
+	res19, err := c.BatchDelete(ctx, deletes)
+	if err != nil {
+		log.Fatalf("BatchDelete failed: %v", err)
+	}
+	log.Printf("BatchDelete result: <%+v>\n\n", res19)
+
 	//ReadAll ToDo entities
 	req4 := v1.ReadAllRequest{
 		Api: apiVersion,
@@ -105,14 +222,155 @@ func main() {
 	}
 	log.Printf("ReadAll result: <%+v>\n\n", res4)
 
+	//Delete of the version read before the update is rejected
+	_, err = c.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: id, Version: res2.ToDo.Version})
+	if status.Code(err) != codes.Aborted {
+		log.Fatalf("Delete of stale version failed: %v", err)
+	}
+	log.Printf("Delete of stale version result: <%v>\n\n", err)
+
 	//Delete a ToDo entity
 	req5 := v1.DeleteRequest{
-		Api: apiVersion,
-		Id:  id,
+		Api:     apiVersion,
+		Id:      id,
+		Version: res3.Version,
 	}
 	res5, err := c.Delete(ctx, &req5)
 	if err != nil {
 		log.Fatalf("Delete failed: %v", err)
 	}
 	log.Printf("Delete result: <+%v>\n\n", res5)
+
+	//Undelete the ToDo entity from the trash and Delete it again
+	res20, err := c.Undelete(ctx, &v1.UndeleteRequest{Api: apiVersion, Id: id})
+	if err != nil {
+		log.Fatalf("Undelete failed: %v", err)
+	}
+	log.Printf("Undelete result: <%+v>\n\n", res20)
+	if _, err := c.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: id}); err != nil {
+		log.Fatalf("Delete failed: %v", err)
+	}
+
+	//ListHistory of the ToDo entity
+	res21, err := c.ListHistory(ctx, &v1.ListHistoryRequest{Api: apiVersion, Id: id})
+	if err != nil {
+		log.Fatalf("ListHistory failed: %v", err)
+	}
+	log.Printf("ListHistory result: <%+v>\n\n", res21)
+
+	//Delete the next occurrence created by completing the ToDo entity
+	if res3.NextId != 0 {
+		if _, err := c.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: res3.NextId}); err != nil {
+			log.Fatalf("Delete failed: %v", err)
+		}
+	}
+
+	//CreateProject and add a ToDo entity to it
+	res8, err := c.CreateProject(ctx, &v1.CreateProjectRequest{
+		Api:     apiVersion,
+		Project: &v1.Project{Name: fmt.Sprintf("project (%s)", pfx), Description: fmt.Sprintf("description (%s)", pfx)},
+	})
+	if err != nil {
+		log.Fatalf("CreateProject failed: %v", err)
+	}
+	log.Printf("CreateProject result: <%+v>\n\n", res8)
+
+	if _, err := c.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:                     fmt.Sprintf("title(%s)", pfx),
+			EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+			Reminder:                  reminder,
+			ProjectId:                 res8.Id,
+		},
+	}); err != nil {
+		log.Fatalf("Create failed: %v", err)
+	}
+
+	//ListProjects
+	res9, err := c.ListProjects(ctx, &v1.ListProjectsRequest{Api: apiVersion})
+	if err != nil {
+		log.Fatalf("ListProjects failed: %v", err)
+	}
+	log.Printf("ListProjects result: <%+v>\n\n", res9)
+
+	//DeleteProject together with its ToDo entities
+	res10, err := c.DeleteProject(ctx, &v1.DeleteProjectRequest{
+		Api:  apiVersion,
+		Id:   res8.Id,
+		Mode: v1.DeleteProjectRequest_CASCADE,
+	})
+	if err != nil {
+		log.Fatalf("DeleteProject failed: %v", err)
+	}
+	log.Printf("DeleteProject result: <%+v>\n\n", res10)
+
+	//Create a ToDo entity with a subtask
+	res11, err := c.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:                     fmt.Sprintf("parent(%s)", pfx),
+			EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+			Reminder:                  reminder,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Create failed: %v", err)
+	}
+	res15, err := c.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:                     fmt.Sprintf("subtask(%s)", pfx),
+			Status:                    v1.Status_DONE,
+			EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+			Reminder:                  reminder,
+			ParentId:                  res11.Id,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Create failed: %v", err)
+	}
+
+	//AddDependency so that the ToDo entity is completed after its subtask and ListTopological ToDo entities
+	if _, err := c.AddDependency(ctx, &v1.AddDependencyRequest{Api: apiVersion, Id: res11.Id, DependsOnId: res15.Id}); err != nil {
+		log.Fatalf("AddDependency failed: %v", err)
+	}
+	res14, err := c.ListTopological(ctx, &v1.ListTopologicalRequest{Api: apiVersion})
+	if err != nil {
+		log.Fatalf("ListTopological failed: %v", err)
+	}
+	log.Printf("ListTopological result: <%+v>\n\n", res14)
+
+	//GetProgress of the ToDo entity rolled up from its subtasks
+	res12, err := c.GetProgress(ctx, &v1.GetProgressRequest{Api: apiVersion, Id: res11.Id})
+	if err != nil {
+		log.Fatalf("GetProgress failed: %v", err)
+	}
+	log.Printf("GetProgress result: <%+v>\n\n", res12)
+
+	//Share the ToDo entity with another user who reads it as a viewer, then ListShares and Unshare it
+	res22, err := c.Share(ctx, &v1.ShareRequest{Api: apiVersion, Id: res11.Id, Share: &v1.Share{User: "client-grpc-viewer", Role: v1.ShareRole_VIEWER}})
+	if err != nil {
+		log.Fatalf("Share failed: %v", err)
+	}
+	log.Printf("Share result: <%+v>\n\n", res22)
+	viewer := metadata.AppendToOutgoingContext(ctx, "x-actor", "client-grpc-viewer")
+	if _, err := c.Read(viewer, &v1.ReadRequest{Api: apiVersion, Id: res11.Id}); err != nil {
+		log.Fatalf("Read of shared ToDo failed: %v", err)
+	}
+	res23, err := c.ListShares(ctx, &v1.ListSharesRequest{Api: apiVersion, Id: res11.Id})
+	if err != nil {
+		log.Fatalf("ListShares failed: %v", err)
+	}
+	log.Printf("ListShares result: <%+v>\n\n", res23)
+	if _, err := c.Unshare(ctx, &v1.UnshareRequest{Api: apiVersion, Id: res11.Id, User: "client-grpc-viewer"}); err != nil {
+		log.Fatalf("Unshare failed: %v", err)
+	}
+
+	//Delete the ToDo entity together with its subtasks
+	res13, err := c.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: res11.Id, Mode: v1.DeleteRequest_CASCADE})
+	if err != nil {
+		log.Fatalf("Delete failed: %v", err)
+	}
+	log.Printf("Delete result: <%+v>\n\n", res13)
 }
@@ -7,13 +7,41 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
+
+	"github.com/basebandit/go-grpc/pkg/certs"
 )
 
+//headerTransport sends the headers with every request e.g. Authorization and X-Tenant-ID
+type headerTransport struct {
+	base    http.RoundTripper
+	headers http.Header
+}
+
+//RoundTrip sends copy of the request with the headers
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := *req
+	r.Header = make(http.Header, len(req.Header)+len(t.headers))
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	for k, v := range t.headers {
+		r.Header[k] = v
+	}
+	return t.base.RoundTrip(&r)
+}
+
 func main() {
 	//get Configuration
-	address := flag.String("server", "http://localhost:8080", "HTTP gateway url, e.g. http://localhost:8080")
+	address := flag.String("server", "http://localhost:8080", "HTTP gateway url, e.g. http://localhost:8080 or https://localhost:8443")
+	apiKey := flag.String("api-key", "", "API key sent in Authorization header")
+	token := flag.String("token", "", "JWT sent in Authorization header, it takes precedence over API key")
+	tenant := flag.String("tenant", "", "Tenant sent in X-Tenant-ID header to multi-tenant server, it is created when the caller manages tenants")
+	tlsCA := flag.String("tls-ca", "", "PEM file of CA certificates verifying https server instead of the system CA certificates")
+	tlsCert := flag.String("tls-cert", "", "PEM file of client certificate sent to https server verifying clients")
+	tlsKey := flag.String("tls-key", "", "PEM file of the private key of client certificate")
 	flag.Parse()
 
 	t := time.Now().In(time.UTC)
@@ -27,6 +55,68 @@ func main() {
 		Timeout: time.Second * 10,
 	}
 
+	//https server is verified by the CA certificates and may require client certificate
+	var transport http.RoundTripper = http.DefaultTransport
+	if len(*tlsCA) > 0 || len(*tlsCert) > 0 {
+		tlsConfig, err := certs.LoadClientConfig(*tlsCA, *tlsCert, *tlsKey)
+		if err != nil {
+			log.Fatalf("invalid TLS configuration: %v", err)
+		}
+		transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		}
+		httpClient.Transport = transport
+	}
+
+	//credentials are required when server authenticates callers
+	headers := http.Header{}
+	switch {
+	case len(*token) > 0:
+		headers.Set("Authorization", "Bearer "+*token)
+	case len(*apiKey) > 0:
+		headers.Set("Authorization", "ApiKey "+*apiKey)
+	}
+	//multi-tenant server scopes the calls to the tenant, it is ignored by the calls managing tenants
+	if len(*tenant) > 0 {
+		headers.Set("X-Tenant-ID", *tenant)
+	}
+	if len(headers) > 0 {
+		httpClient.Transport = &headerTransport{base: transport, headers: headers}
+	}
+
+	//-------------------------------------------------------
+	// Call CreateTenant unless it exists, only tenant administrators are allowed to
+	//-------------------------------------------------------
+	if len(*tenant) > 0 {
+		req, err := http.NewRequest("POST", *address+"/v1/tenants", strings.NewReader(fmt.Sprintf(`
+		{
+			"api":"v1",
+			"tenant": {
+				"id":"%s",
+				"name":"client-rest"
+			}
+		}
+		`, *tenant)))
+		if err != nil {
+			log.Fatalf("failed to create CreateTenant request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor", "client-rest")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			log.Fatalf("failed to call CreateTenant method: %v", err)
+		}
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			body = fmt.Sprintf("failed to read CreateTenant response body: %v", err)
+		} else {
+			body = string(bodyBytes)
+		}
+		log.Printf("CreateTenant response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	}
+
 	//-------------------------------------------------------
 	// Call Create
 	//-------------------------------------------------------
@@ -81,7 +171,10 @@ func main() {
 	} else {
 		body = string(bodyBytes)
 	}
-	log.Printf("Read response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("Read response: Code=%d, ETag=%s, Body=%s\n\n", resp.StatusCode, resp.Header.Get("ETag"), body)
+
+	//version of the task is sent back in If-Match header so that the update fails when the task was changed since
+	etag := resp.Header.Get("ETag")
 
 	//-------------------------------------------------------
 	// Call update
@@ -91,7 +184,7 @@ func main() {
 		"toDo":{
 			"title":"title (%s) + updated",
 			"description":"description (%s) + updated",
-			"status":"Completed",
+			"status":"DONE",
 			"reminder":"%s",
 			"estimatedTimeOfCompletion":"%s",
 			"actualTimeOfCompletion":"%s"
@@ -100,11 +193,13 @@ func main() {
 		pfx, pfx, pfx, pfx, pfx)))
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
 
 	resp, err = httpClient.Do(req)
 	if err != nil {
 		log.Fatalf("failed to call Update method: %v", err)
 	}
+	updatedETag := resp.Header.Get("ETag")
 	bodyBytes, err = ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
@@ -112,7 +207,23 @@ func main() {
 	} else {
 		body = string(bodyBytes)
 	}
-	log.Printf("update response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+	log.Printf("update response: Code=%d, ETag=%s, Body=%s\n\n", resp.StatusCode, updatedETag, body)
+
+	//-------------------------------------------------------
+	// Call ListCompletions
+	//-------------------------------------------------------
+	resp, err = httpClient.Get(fmt.Sprintf("%s%s/%s/completions", *address, "/v1/tasq", created.ID))
+	if err != nil {
+		log.Fatalf("failed to call ListCompletions method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read ListCompletions response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("ListCompletions response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
 
 	//-------------------------------------------------------
 	// Call ReadAll
@@ -130,10 +241,45 @@ func main() {
 	}
 	log.Printf("ReadAll response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
 
+	//-------------------------------------------------------
+	// Call Search
+	//-------------------------------------------------------
+	resp, err = httpClient.Get(fmt.Sprintf("%s%s?q=%s", *address, "/v1/tasq:search", url.QueryEscape(fmt.Sprintf(`"description %s"`, pfx))))
+	if err != nil {
+		log.Fatalf("failed to call Search method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read Search response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("Search response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call Delete with the version read before the update
+	//-------------------------------------------------------
+	req, err = http.NewRequest("DELETE", fmt.Sprintf("%s%s/%s", *address, "/v1/tasq", created.ID), nil)
+	req.Header.Set("If-Match", etag)
+	resp, err = httpClient.Do(req)
+	if err != nil {
+		log.Fatalf("failed to call Delete method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read Delete response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("Delete of stale version response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
 	//-------------------------------------------------------
 	// Call Delete
 	//-------------------------------------------------------
 	req, err = http.NewRequest("DELETE", fmt.Sprintf("%s%s/%s", *address, "/v1/tasq", created.ID), nil)
+	req.Header.Set("If-Match", updatedETag)
 	resp, err = httpClient.Do(req)
 	if err != nil {
 		log.Fatalf("failed to call Delete method: %v", err)
@@ -146,4 +292,94 @@ func main() {
 		body = string(bodyBytes)
 	}
 	log.Printf("Delete response: Code=%d,Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call Undelete
+	//-------------------------------------------------------
+	resp, err = httpClient.Post(fmt.Sprintf("%s%s/%s:undelete", *address, "/v1/tasq", created.ID), "application/json", strings.NewReader(`{"api":"v1"}`))
+	if err != nil {
+		log.Fatalf("failed to call Undelete method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read Undelete response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("Undelete response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call ListHistory
+	//-------------------------------------------------------
+	resp, err = httpClient.Get(fmt.Sprintf("%s%s/%s/history", *address, "/v1/tasq", created.ID))
+	if err != nil {
+		log.Fatalf("failed to call ListHistory method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read ListHistory response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("ListHistory response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call Share
+	//-------------------------------------------------------
+	resp, err = httpClient.Post(fmt.Sprintf("%s%s/%s/shares", *address, "/v1/tasq", created.ID), "application/json", strings.NewReader(`
+	{
+		"api":"v1",
+		"share": {
+			"user":"client-rest-viewer",
+			"role":"VIEWER"
+		}
+	}
+	`))
+	if err != nil {
+		log.Fatalf("failed to call Share method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read Share response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("Share response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call Read as the user the task is shared with
+	//-------------------------------------------------------
+	req, err = http.NewRequest("GET", fmt.Sprintf("%s%s/%s", *address, "/v1/tasq", created.ID), nil)
+	req.Header.Set("X-Actor", "client-rest-viewer")
+	resp, err = httpClient.Do(req)
+	if err != nil {
+		log.Fatalf("failed to call Read method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read Read response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("Read of shared task response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
+
+	//-------------------------------------------------------
+	// Call ListShares
+	//-------------------------------------------------------
+	resp, err = httpClient.Get(fmt.Sprintf("%s%s/%s/shares", *address, "/v1/tasq", created.ID))
+	if err != nil {
+		log.Fatalf("failed to call ListShares method: %v", err)
+	}
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		body = fmt.Sprintf("failed to read ListShares response body: %v", err)
+	} else {
+		body = string(bodyBytes)
+	}
+	log.Printf("ListShares response: Code=%d, Body=%s\n\n", resp.StatusCode, body)
 }
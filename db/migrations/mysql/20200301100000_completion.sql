@@ -0,0 +1,33 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE `ToDo`
+		MODIFY `ActualTimeOfCompletion` timestamp NULL DEFAULT NULL,
+		ADD `CreatedAt` timestamp NULL DEFAULT NULL,
+		ADD `UpdatedAt` timestamp NULL DEFAULT NULL;
+
+-- actual time of completion used to be a copy of the estimated one
+UPDATE `ToDo` SET `ActualTimeOfCompletion` = NULL WHERE `Status` <> 'Completed';
+
+CREATE TABLE IF NOT EXISTS `ToDoCompletion` (
+		`ID` bigint(20) NOT NULL AUTO_INCREMENT,
+		`ToDoID` bigint(20) NOT NULL,
+		`CompletedAt` timestamp NULL DEFAULT NULL,
+		`ReopenedAt` timestamp NULL DEFAULT NULL,
+		PRIMARY KEY (ID),
+		KEY TODO_ID (ToDoID));
+
+INSERT INTO `ToDoCompletion` (`ToDoID`, `CompletedAt`)
+		SELECT `ID`, `ActualTimeOfCompletion` FROM `ToDo` WHERE `ActualTimeOfCompletion` IS NOT NULL;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE `ToDoCompletion`;
+
+UPDATE `ToDo` SET `ActualTimeOfCompletion` = `EstimatedTimeOfCompletion` WHERE `ActualTimeOfCompletion` IS NULL;
+
+ALTER TABLE `ToDo`
+		MODIFY `ActualTimeOfCompletion` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		DROP `CreatedAt`,
+		DROP `UpdatedAt`;
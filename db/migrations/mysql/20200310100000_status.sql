@@ -0,0 +1,20 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- free-form statuses are normalized to the names of the Status enum of the API
+UPDATE `ToDo` SET `Status` = CASE
+		WHEN UPPER(TRIM(`Status`)) IN ('TODO', 'TO DO', 'NEW', 'OPEN', 'PENDING') THEN 'TODO'
+		WHEN UPPER(TRIM(`Status`)) IN ('IN_PROGRESS', 'IN PROGRESS', 'INPROGRESS', 'PROGRESS', 'STARTED') THEN 'IN_PROGRESS'
+		WHEN UPPER(TRIM(`Status`)) IN ('BLOCKED', 'ON HOLD', 'WAITING') THEN 'BLOCKED'
+		WHEN UPPER(TRIM(`Status`)) IN ('DONE', 'COMPLETED', 'COMPLETE', 'FINISHED') THEN 'DONE'
+		WHEN UPPER(TRIM(`Status`)) IN ('CANCELLED', 'CANCELED') THEN 'CANCELLED'
+		ELSE 'TODO' END;
+
+ALTER TABLE `ToDo` MODIFY `Status` varchar(200) NOT NULL DEFAULT 'TODO';
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `ToDo` MODIFY `Status` varchar(200) DEFAULT 'progress';
+
+UPDATE `ToDo` SET `Status` = 'Completed' WHERE `Status` = 'DONE';
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE `ToDo`
+		ADD `ReminderSentAt` timestamp NULL DEFAULT NULL,
+		ADD KEY REMINDER (Reminder);
+
+-- reminders which came due before they were delivered by the server are not delivered
+UPDATE `ToDo` SET `ReminderSentAt` = CURRENT_TIMESTAMP WHERE `Reminder` <= CURRENT_TIMESTAMP;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `ToDo`
+		DROP KEY REMINDER,
+		DROP `ReminderSentAt`;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS `Project` (
+		`ID` bigint(20) NOT NULL AUTO_INCREMENT,
+		`Name` varchar(200) NOT NULL,
+		`Description` varchar(1024) NOT NULL DEFAULT '',
+		`Archived` boolean NOT NULL DEFAULT false,
+		`CreatedAt` timestamp NULL DEFAULT NULL,
+		`UpdatedAt` timestamp NULL DEFAULT NULL,
+		PRIMARY KEY (ID),
+		UNIQUE KEY NAME_UNIQUE (Name));
+
+-- titles are unique in a project, 0 is the project of tasks without project
+ALTER TABLE `ToDo`
+		ADD `ProjectID` bigint(20) NOT NULL DEFAULT 0,
+		DROP INDEX TITLE_UNIQUE,
+		ADD UNIQUE KEY PROJECT_TITLE_UNIQUE (ProjectID, Title);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `ToDo`
+		DROP INDEX PROJECT_TITLE_UNIQUE,
+		DROP `ProjectID`,
+		ADD UNIQUE KEY TITLE_UNIQUE (Title);
+
+DROP TABLE `Project`;
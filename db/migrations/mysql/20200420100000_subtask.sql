@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE `ToDo`
+		ADD `ParentID` bigint(20) NOT NULL DEFAULT 0,
+		ADD KEY PARENT (ParentID);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `ToDo`
+		DROP INDEX PARENT,
+		DROP `ParentID`;
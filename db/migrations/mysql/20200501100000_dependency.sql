@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS `ToDoDependency` (
+		`ToDoID` bigint(20) NOT NULL,
+		`DependsOnID` bigint(20) NOT NULL,
+		PRIMARY KEY (ToDoID, DependsOnID),
+		KEY DEPENDS_ON (DependsOnID));
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE `ToDoDependency`;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS `Label` (
+		`ID` bigint(20) NOT NULL AUTO_INCREMENT,
+		`Name` varchar(100) NOT NULL,
+		PRIMARY KEY (ID),
+		UNIQUE KEY LABEL_NAME_UNIQUE (Name));
+
+CREATE TABLE IF NOT EXISTS `ToDoLabel` (
+		`ToDoID` bigint(20) NOT NULL,
+		`LabelID` bigint(20) NOT NULL,
+		PRIMARY KEY (ToDoID, LabelID),
+		KEY LABEL_ID (LabelID));
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE `ToDoLabel`;
+
+DROP TABLE `Label`;
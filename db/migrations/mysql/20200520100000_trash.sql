@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE `ToDo`
+		ADD `DeletedAt` timestamp NULL DEFAULT NULL,
+		ADD KEY DELETED (DeletedAt);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- tasks in the trash are deleted for good
+DELETE FROM `ToDoCompletion` WHERE `ToDoID` IN (SELECT `ID` FROM `ToDo` WHERE `DeletedAt` IS NOT NULL);
+
+DELETE FROM `ToDoLabel` WHERE `ToDoID` IN (SELECT `ID` FROM `ToDo` WHERE `DeletedAt` IS NOT NULL);
+
+DELETE FROM `ToDoDependency` WHERE `ToDoID` IN (SELECT `ID` FROM `ToDo` WHERE `DeletedAt` IS NOT NULL) OR `DependsOnID` IN (SELECT `ID` FROM `ToDo` WHERE `DeletedAt` IS NOT NULL);
+
+DELETE FROM `ToDo` WHERE `DeletedAt` IS NOT NULL;
+
+ALTER TABLE `ToDo`
+		DROP KEY DELETED,
+		DROP `DeletedAt`;
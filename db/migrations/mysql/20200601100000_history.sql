@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS `ToDoHistory` (
+		`ID` bigint(20) NOT NULL AUTO_INCREMENT,
+		`ToDoID` bigint(20) NOT NULL,
+		`Action` varchar(20) NOT NULL,
+		`Snapshot` text NOT NULL,
+		`Actor` varchar(200) NOT NULL,
+		`RequestID` varchar(200) NOT NULL,
+		`CreatedAt` timestamp NULL DEFAULT NULL,
+		PRIMARY KEY (ID),
+		KEY HISTORY_TODO_ID (ToDoID));
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE `ToDoHistory`;
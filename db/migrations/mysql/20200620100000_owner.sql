@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- titles are unique among tasks of the owner in a project,
+-- the server assigns tasks without owner to its default owner on start
+ALTER TABLE `ToDo`
+		ADD `Owner` varchar(200) NOT NULL DEFAULT '',
+		DROP INDEX PROJECT_TITLE_UNIQUE,
+		ADD UNIQUE KEY OWNER_PROJECT_TITLE_UNIQUE (Owner, ProjectID, Title);
+
+CREATE TABLE IF NOT EXISTS `ToDoShare` (
+		`ToDoID` bigint(20) NOT NULL,
+		`UserName` varchar(200) NOT NULL,
+		`Role` varchar(20) NOT NULL,
+		PRIMARY KEY (ToDoID, UserName),
+		KEY SHARE_USER_NAME (UserName));
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE `ToDoShare`;
+
+ALTER TABLE `ToDo`
+		DROP INDEX OWNER_PROJECT_TITLE_UNIQUE,
+		DROP `Owner`,
+		ADD UNIQUE KEY PROJECT_TITLE_UNIQUE (ProjectID, Title);
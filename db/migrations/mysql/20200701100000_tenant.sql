@@ -0,0 +1,43 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- tasks, projects and history entries belong to a tenant, empty TenantID is the data stored without tenant.
+-- Titles and project names are unique in a tenant
+CREATE TABLE IF NOT EXISTS `Tenant` (
+		`ID` varchar(63) NOT NULL,
+		`Name` varchar(200) NOT NULL DEFAULT '',
+		`MaxToDos` bigint(20) NOT NULL DEFAULT 0,
+		`Suspended` boolean NOT NULL DEFAULT false,
+		`CreatedAt` timestamp NULL DEFAULT NULL,
+		`UpdatedAt` timestamp NULL DEFAULT NULL,
+		PRIMARY KEY (ID));
+
+ALTER TABLE `ToDo`
+		ADD `TenantID` varchar(63) NOT NULL DEFAULT '',
+		DROP INDEX OWNER_PROJECT_TITLE_UNIQUE,
+		ADD UNIQUE KEY TENANT_OWNER_PROJECT_TITLE_UNIQUE (TenantID, Owner, ProjectID, Title);
+
+ALTER TABLE `Project`
+		ADD `TenantID` varchar(63) NOT NULL DEFAULT '',
+		DROP INDEX NAME_UNIQUE,
+		ADD UNIQUE KEY TENANT_NAME_UNIQUE (TenantID, Name);
+
+ALTER TABLE `ToDoHistory`
+		ADD `TenantID` varchar(63) NOT NULL DEFAULT '';
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE `ToDoHistory`
+		DROP `TenantID`;
+
+ALTER TABLE `Project`
+		DROP INDEX TENANT_NAME_UNIQUE,
+		DROP `TenantID`,
+		ADD UNIQUE KEY NAME_UNIQUE (Name);
+
+ALTER TABLE `ToDo`
+		DROP INDEX TENANT_OWNER_PROJECT_TITLE_UNIQUE,
+		DROP `TenantID`,
+		ADD UNIQUE KEY OWNER_PROJECT_TITLE_UNIQUE (Owner, ProjectID, Title);
+
+DROP TABLE `Tenant`;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo
+		ALTER COLUMN ActualTimeOfCompletion DROP NOT NULL,
+		ALTER COLUMN ActualTimeOfCompletion DROP DEFAULT,
+		ADD COLUMN CreatedAt timestamp with time zone NULL DEFAULT NULL,
+		ADD COLUMN UpdatedAt timestamp with time zone NULL DEFAULT NULL;
+
+-- actual time of completion used to be a copy of the estimated one
+UPDATE ToDo SET ActualTimeOfCompletion = NULL WHERE Status <> 'Completed';
+
+CREATE TABLE IF NOT EXISTS ToDoCompletion (
+		ID bigserial NOT NULL PRIMARY KEY,
+		ToDoID bigint NOT NULL,
+		CompletedAt timestamp with time zone NULL DEFAULT NULL,
+		ReopenedAt timestamp with time zone NULL DEFAULT NULL);
+
+CREATE INDEX TODO_ID ON ToDoCompletion (ToDoID);
+
+INSERT INTO ToDoCompletion (ToDoID, CompletedAt)
+		SELECT ID, ActualTimeOfCompletion FROM ToDo WHERE ActualTimeOfCompletion IS NOT NULL;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoCompletion;
+
+UPDATE ToDo SET ActualTimeOfCompletion = COALESCE(EstimatedTimeOfCompletion, CURRENT_TIMESTAMP) WHERE ActualTimeOfCompletion IS NULL;
+
+ALTER TABLE ToDo
+		ALTER COLUMN ActualTimeOfCompletion SET DEFAULT CURRENT_TIMESTAMP,
+		ALTER COLUMN ActualTimeOfCompletion SET NOT NULL,
+		DROP COLUMN CreatedAt,
+		DROP COLUMN UpdatedAt;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo
+		ADD COLUMN ReminderSentAt timestamp with time zone NULL DEFAULT NULL;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+-- reminders which came due before they were delivered by the server are not delivered
+UPDATE ToDo SET ReminderSentAt = CURRENT_TIMESTAMP WHERE Reminder <= CURRENT_TIMESTAMP;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP INDEX REMINDER;
+
+ALTER TABLE ToDo
+		DROP COLUMN ReminderSentAt;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo ADD COLUMN Recurrence varchar(255) NOT NULL DEFAULT '';
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE ToDo DROP COLUMN Recurrence;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS Project (
+		ID bigserial NOT NULL PRIMARY KEY,
+		Name varchar(200) NOT NULL,
+		Description varchar(1024) NOT NULL DEFAULT '',
+		Archived boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp with time zone NULL DEFAULT NULL,
+		UpdatedAt timestamp with time zone NULL DEFAULT NULL,
+		CONSTRAINT NAME_UNIQUE UNIQUE (Name));
+
+-- titles are unique in a project, 0 is the project of tasks without project
+ALTER TABLE ToDo
+		ADD COLUMN ProjectID bigint NOT NULL DEFAULT 0,
+		DROP CONSTRAINT TITLE_UNIQUE,
+		ADD CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE ToDo
+		DROP CONSTRAINT PROJECT_TITLE_UNIQUE,
+		DROP COLUMN ProjectID,
+		ADD CONSTRAINT TITLE_UNIQUE UNIQUE (Title);
+
+DROP TABLE Project;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo
+		ADD COLUMN ParentID bigint NOT NULL DEFAULT 0;
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP INDEX PARENT;
+
+ALTER TABLE ToDo
+		DROP COLUMN ParentID;
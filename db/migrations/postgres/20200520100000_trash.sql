@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo
+		ADD COLUMN DeletedAt timestamp with time zone NULL DEFAULT NULL;
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- tasks in the trash are deleted for good
+DELETE FROM ToDoCompletion WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDoLabel WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDoDependency WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL) OR DependsOnID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDo WHERE DeletedAt IS NOT NULL;
+
+DROP INDEX DELETED;
+
+ALTER TABLE ToDo
+		DROP COLUMN DeletedAt;
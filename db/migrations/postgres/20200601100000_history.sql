@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS ToDoHistory (
+		ID bigserial NOT NULL PRIMARY KEY,
+		ToDoID bigint NOT NULL,
+		Action varchar(20) NOT NULL,
+		Snapshot text NOT NULL,
+		Actor varchar(200) NOT NULL,
+		RequestID varchar(200) NOT NULL,
+		CreatedAt timestamp with time zone NULL DEFAULT NULL);
+
+CREATE INDEX HISTORY_TODO_ID ON ToDoHistory (ToDoID);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoHistory;
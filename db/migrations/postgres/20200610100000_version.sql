@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo
+		ADD COLUMN Version bigint NOT NULL DEFAULT 1;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE ToDo
+		DROP COLUMN Version;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- titles are unique among tasks of the owner in a project,
+-- the server assigns tasks without owner to its default owner on start
+ALTER TABLE ToDo
+		ADD COLUMN Owner varchar(200) NOT NULL DEFAULT '',
+		DROP CONSTRAINT PROJECT_TITLE_UNIQUE,
+		ADD CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE UNIQUE (Owner, ProjectID, Title);
+
+CREATE TABLE IF NOT EXISTS ToDoShare (
+		ToDoID bigint NOT NULL,
+		UserName varchar(200) NOT NULL,
+		Role varchar(20) NOT NULL,
+		PRIMARY KEY (ToDoID, UserName));
+
+CREATE INDEX SHARE_USER_NAME ON ToDoShare (UserName);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoShare;
+
+ALTER TABLE ToDo
+		DROP CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE,
+		DROP COLUMN Owner,
+		ADD CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title);
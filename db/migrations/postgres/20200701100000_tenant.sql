@@ -0,0 +1,42 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- tasks, projects and history entries belong to a tenant, empty TenantID is the data stored without tenant.
+-- Titles and project names are unique in a tenant
+CREATE TABLE IF NOT EXISTS Tenant (
+		ID varchar(63) NOT NULL PRIMARY KEY,
+		Name varchar(200) NOT NULL DEFAULT '',
+		MaxToDos bigint NOT NULL DEFAULT 0,
+		Suspended boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp with time zone NULL DEFAULT NULL,
+		UpdatedAt timestamp with time zone NULL DEFAULT NULL);
+
+ALTER TABLE ToDo
+		ADD COLUMN TenantID varchar(63) NOT NULL DEFAULT '',
+		DROP CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE,
+		ADD CONSTRAINT TENANT_OWNER_PROJECT_TITLE_UNIQUE UNIQUE (TenantID, Owner, ProjectID, Title);
+
+ALTER TABLE Project
+		ADD COLUMN TenantID varchar(63) NOT NULL DEFAULT '',
+		DROP CONSTRAINT NAME_UNIQUE,
+		ADD CONSTRAINT TENANT_NAME_UNIQUE UNIQUE (TenantID, Name);
+
+ALTER TABLE ToDoHistory
+		ADD COLUMN TenantID varchar(63) NOT NULL DEFAULT '';
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE ToDoHistory
+		DROP COLUMN TenantID;
+
+ALTER TABLE Project
+		DROP CONSTRAINT TENANT_NAME_UNIQUE,
+		DROP COLUMN TenantID,
+		ADD CONSTRAINT NAME_UNIQUE UNIQUE (Name);
+
+ALTER TABLE ToDo
+		DROP CONSTRAINT TENANT_OWNER_PROJECT_TITLE_UNIQUE,
+		DROP COLUMN TenantID,
+		ADD CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE UNIQUE (Owner, ProjectID, Title);
+
+DROP TABLE Tenant;
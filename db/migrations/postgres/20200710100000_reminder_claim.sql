@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- reminder is claimed by a scheduler until the lease expires, it is marked sent after it is delivered
+ALTER TABLE ToDo
+		ADD COLUMN ReminderClaimedUntil timestamp with time zone NULL DEFAULT NULL;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE ToDo
+		DROP COLUMN ReminderClaimedUntil;
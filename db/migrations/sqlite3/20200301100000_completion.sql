@@ -0,0 +1,57 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- SQLite can't drop NOT NULL constraint of a column so ToDo table is rebuilt
+CREATE TABLE ToDoNew (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		CONSTRAINT TITLE_UNIQUE UNIQUE (Title));
+
+-- actual time of completion used to be a copy of the estimated one
+INSERT INTO ToDoNew (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion,
+		CASE WHEN Status = 'Completed' THEN ActualTimeOfCompletion ELSE NULL END FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoNew RENAME TO ToDo;
+
+CREATE TABLE IF NOT EXISTS ToDoCompletion (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		ToDoID integer NOT NULL,
+		CompletedAt timestamp NULL DEFAULT NULL,
+		ReopenedAt timestamp NULL DEFAULT NULL);
+
+CREATE INDEX TODO_ID ON ToDoCompletion (ToDoID);
+
+INSERT INTO ToDoCompletion (ToDoID, CompletedAt)
+		SELECT ID, ActualTimeOfCompletion FROM ToDo WHERE ActualTimeOfCompletion IS NOT NULL;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoCompletion;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		CONSTRAINT TITLE_UNIQUE UNIQUE (Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion,
+		COALESCE(ActualTimeOfCompletion, EstimatedTimeOfCompletion, CURRENT_TIMESTAMP) FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
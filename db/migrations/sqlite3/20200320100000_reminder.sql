@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo ADD COLUMN ReminderSentAt timestamp NULL DEFAULT NULL;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+-- reminders which came due before they were delivered by the server are not delivered,
+-- timestamps are stored in UTC so they are compared as text
+UPDATE ToDo SET ReminderSentAt = CURRENT_TIMESTAMP WHERE Reminder <= CURRENT_TIMESTAMP;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		CONSTRAINT TITLE_UNIQUE UNIQUE (Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
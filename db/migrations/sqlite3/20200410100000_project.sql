@@ -0,0 +1,69 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS Project (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Name varchar(200) NOT NULL,
+		Description varchar(1024) NOT NULL DEFAULT '',
+		Archived boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		CONSTRAINT NAME_UNIQUE UNIQUE (Name));
+
+-- titles are unique in a project, 0 is the project of tasks without project.
+-- SQLite can't drop a constraint so ToDo table is rebuilt
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoNew (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title));
+
+INSERT INTO ToDoNew (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoNew RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		CONSTRAINT TITLE_UNIQUE UNIQUE (Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+DROP TABLE Project;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS ToDoDependency (
+		ToDoID integer NOT NULL,
+		DependsOnID integer NOT NULL,
+		PRIMARY KEY (ToDoID, DependsOnID));
+
+CREATE INDEX DEPENDS_ON ON ToDoDependency (DependsOnID);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoDependency;
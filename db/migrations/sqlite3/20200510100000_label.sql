@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS Label (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Name varchar(100) NOT NULL,
+		CONSTRAINT LABEL_NAME_UNIQUE UNIQUE (Name));
+
+CREATE TABLE IF NOT EXISTS ToDoLabel (
+		ToDoID integer NOT NULL,
+		LabelID integer NOT NULL,
+		PRIMARY KEY (ToDoID, LabelID));
+
+CREATE INDEX LABEL_ID ON ToDoLabel (LabelID);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoLabel;
+
+DROP TABLE Label;
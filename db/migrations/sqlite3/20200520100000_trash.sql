@@ -0,0 +1,51 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo ADD COLUMN DeletedAt timestamp NULL DEFAULT NULL;
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- tasks in the trash are deleted for good
+DELETE FROM ToDoCompletion WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDoLabel WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDoDependency WHERE ToDoID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL) OR DependsOnID IN (SELECT ID FROM ToDo WHERE DeletedAt IS NOT NULL);
+
+DELETE FROM ToDo WHERE DeletedAt IS NOT NULL;
+
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
@@ -0,0 +1,43 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE ToDo ADD COLUMN Version integer NOT NULL DEFAULT 1;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
@@ -0,0 +1,93 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- titles are unique among tasks of the owner in a project,
+-- the server assigns tasks without owner to its default owner on start.
+-- SQLite can't drop a constraint so ToDo table is rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoNew (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		Version integer NOT NULL DEFAULT 1,
+		Owner varchar(200) NOT NULL DEFAULT '',
+		CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE UNIQUE (Owner, ProjectID, Title));
+
+INSERT INTO ToDoNew (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoNew RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
+
+CREATE TABLE IF NOT EXISTS ToDoShare (
+		ToDoID integer NOT NULL,
+		UserName varchar(200) NOT NULL,
+		Role varchar(20) NOT NULL,
+		PRIMARY KEY (ToDoID, UserName));
+
+CREATE INDEX SHARE_USER_NAME ON ToDoShare (UserName);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE ToDoShare;
+
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		Version integer NOT NULL DEFAULT 1,
+		CONSTRAINT PROJECT_TITLE_UNIQUE UNIQUE (ProjectID, Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
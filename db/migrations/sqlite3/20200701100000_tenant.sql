@@ -0,0 +1,150 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- tasks, projects and history entries belong to a tenant, empty TenantID is the data stored without tenant.
+-- Titles and project names are unique in a tenant
+CREATE TABLE IF NOT EXISTS Tenant (
+		ID varchar(63) NOT NULL PRIMARY KEY,
+		Name varchar(200) NOT NULL DEFAULT '',
+		MaxToDos integer NOT NULL DEFAULT 0,
+		Suspended boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL);
+
+-- SQLite can't drop a constraint so ToDo and Project tables are rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoNew (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		Version integer NOT NULL DEFAULT 1,
+		Owner varchar(200) NOT NULL DEFAULT '',
+		TenantID varchar(63) NOT NULL DEFAULT '',
+		CONSTRAINT TENANT_OWNER_PROJECT_TITLE_UNIQUE UNIQUE (TenantID, Owner, ProjectID, Title));
+
+INSERT INTO ToDoNew (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoNew RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
+
+CREATE TABLE ProjectNew (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Name varchar(200) NOT NULL,
+		Description varchar(1024) NOT NULL DEFAULT '',
+		Archived boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		TenantID varchar(63) NOT NULL DEFAULT '',
+		CONSTRAINT TENANT_NAME_UNIQUE UNIQUE (TenantID, Name));
+
+INSERT INTO ProjectNew (ID, Name, Description, Archived, CreatedAt, UpdatedAt)
+		SELECT ID, Name, Description, Archived, CreatedAt, UpdatedAt FROM Project;
+
+DROP TABLE Project;
+
+ALTER TABLE ProjectNew RENAME TO Project;
+
+ALTER TABLE ToDoHistory ADD COLUMN TenantID varchar(63) NOT NULL DEFAULT '';
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- SQLite can't drop a column so ToDoHistory, ToDo and Project tables are rebuilt
+DROP INDEX HISTORY_TODO_ID;
+
+CREATE TABLE ToDoHistoryOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		ToDoID integer NOT NULL,
+		Action varchar(20) NOT NULL,
+		Snapshot text NOT NULL,
+		Actor varchar(200) NOT NULL,
+		RequestID varchar(200) NOT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL);
+
+INSERT INTO ToDoHistoryOld (ID, ToDoID, Action, Snapshot, Actor, RequestID, CreatedAt)
+		SELECT ID, ToDoID, Action, Snapshot, Actor, RequestID, CreatedAt FROM ToDoHistory;
+
+DROP TABLE ToDoHistory;
+
+ALTER TABLE ToDoHistoryOld RENAME TO ToDoHistory;
+
+CREATE INDEX HISTORY_TODO_ID ON ToDoHistory (ToDoID);
+
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		Version integer NOT NULL DEFAULT 1,
+		Owner varchar(200) NOT NULL DEFAULT '',
+		CONSTRAINT OWNER_PROJECT_TITLE_UNIQUE UNIQUE (Owner, ProjectID, Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
+
+CREATE TABLE ProjectOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Name varchar(200) NOT NULL,
+		Description varchar(1024) NOT NULL DEFAULT '',
+		Archived boolean NOT NULL DEFAULT false,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		CONSTRAINT NAME_UNIQUE UNIQUE (Name));
+
+INSERT INTO ProjectOld (ID, Name, Description, Archived, CreatedAt, UpdatedAt)
+		SELECT ID, Name, Description, Archived, CreatedAt, UpdatedAt FROM Project;
+
+DROP TABLE Project;
+
+ALTER TABLE ProjectOld RENAME TO Project;
+
+DROP TABLE Tenant;
@@ -0,0 +1,47 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- reminder is claimed by a scheduler until the lease expires, it is marked sent after it is delivered
+ALTER TABLE ToDo ADD COLUMN ReminderClaimedUntil timestamp NULL DEFAULT NULL;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- SQLite can't drop a column so ToDo table is rebuilt
+DROP INDEX DELETED;
+
+DROP INDEX PARENT;
+
+DROP INDEX REMINDER;
+
+CREATE TABLE ToDoOld (
+		ID integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		Title varchar(200) DEFAULT NULL,
+		Description varchar(1024) DEFAULT NULL,
+		Reminder timestamp NULL DEFAULT NULL,
+		Status varchar(200) DEFAULT 'progress',
+		EstimatedTimeOfCompletion timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+		ActualTimeOfCompletion timestamp NULL DEFAULT NULL,
+		CreatedAt timestamp NULL DEFAULT NULL,
+		UpdatedAt timestamp NULL DEFAULT NULL,
+		ReminderSentAt timestamp NULL DEFAULT NULL,
+		Recurrence varchar(255) NOT NULL DEFAULT '',
+		ProjectID integer NOT NULL DEFAULT 0,
+		ParentID integer NOT NULL DEFAULT 0,
+		DeletedAt timestamp NULL DEFAULT NULL,
+		Version integer NOT NULL DEFAULT 1,
+		Owner varchar(200) NOT NULL DEFAULT '',
+		TenantID varchar(63) NOT NULL DEFAULT '',
+		CONSTRAINT TENANT_OWNER_PROJECT_TITLE_UNIQUE UNIQUE (TenantID, Owner, ProjectID, Title));
+
+INSERT INTO ToDoOld (ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner, TenantID)
+		SELECT ID, Title, Description, Reminder, Status, EstimatedTimeOfCompletion, ActualTimeOfCompletion, CreatedAt, UpdatedAt, ReminderSentAt, Recurrence, ProjectID, ParentID, DeletedAt, Version, Owner, TenantID FROM ToDo;
+
+DROP TABLE ToDo;
+
+ALTER TABLE ToDoOld RENAME TO ToDo;
+
+CREATE INDEX REMINDER ON ToDo (Reminder);
+
+CREATE INDEX PARENT ON ToDo (ParentID);
+
+CREATE INDEX DELETED ON ToDo (DeletedAt);
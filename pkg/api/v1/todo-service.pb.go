@@ -7,12 +7,15 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -27,7 +30,287 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//Tasks we have todo
+// Status of the task
+// Allowed transitions between statuses are configured on server
+type Status int32
+
+const (
+	//Status is not set, new task gets TODO status
+	Status_STATUS_UNSPECIFIED Status = 0
+	//Task is waiting to be started
+	Status_TODO Status = 1
+	//Task is being worked on
+	Status_IN_PROGRESS Status = 2
+	//Task can't proceed until something else happens
+	Status_BLOCKED Status = 3
+	//Task is completed
+	Status_DONE Status = 4
+	//Task is abandoned
+	Status_CANCELLED Status = 5
+)
+
+var Status_name = map[int32]string{
+	0: "STATUS_UNSPECIFIED",
+	1: "TODO",
+	2: "IN_PROGRESS",
+	3: "BLOCKED",
+	4: "DONE",
+	5: "CANCELLED",
+}
+
+var Status_value = map[string]int32{
+	"STATUS_UNSPECIFIED": 0,
+	"TODO":               1,
+	"IN_PROGRESS":        2,
+	"BLOCKED":            3,
+	"DONE":               4,
+	"CANCELLED":          5,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{0}
+}
+
+// How batch handles failed items
+type BatchMode int32
+
+const (
+	// Same as ATOMIC
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// Nothing is changed when an item fails, the call fails with the status of the first failed item
+	BatchMode_ATOMIC BatchMode = 1
+	// Failed items are skipped, the rest of items is applied and every item has its own status
+	BatchMode_PER_ITEM BatchMode = 2
+)
+
+var BatchMode_name = map[int32]string{
+	0: "BATCH_MODE_UNSPECIFIED",
+	1: "ATOMIC",
+	2: "PER_ITEM",
+}
+
+var BatchMode_value = map[string]int32{
+	"BATCH_MODE_UNSPECIFIED": 0,
+	"ATOMIC":                 1,
+	"PER_ITEM":               2,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{1}
+}
+
+// What a user todo task is shared with may do with it
+type ShareRole int32
+
+const (
+	// Role is not set
+	ShareRole_SHARE_ROLE_UNSPECIFIED ShareRole = 0
+	// User may read the task, its subtasks, completions and history
+	ShareRole_VIEWER ShareRole = 1
+	// User may read and change the task but may not delete, restore or share it
+	ShareRole_EDITOR ShareRole = 2
+)
+
+var ShareRole_name = map[int32]string{
+	0: "SHARE_ROLE_UNSPECIFIED",
+	1: "VIEWER",
+	2: "EDITOR",
+}
+
+var ShareRole_value = map[string]int32{
+	"SHARE_ROLE_UNSPECIFIED": 0,
+	"VIEWER":                 1,
+	"EDITOR":                 2,
+}
+
+func (x ShareRole) String() string {
+	return proto.EnumName(ShareRole_name, int32(x))
+}
+
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{2}
+}
+
+// What happens to the subtasks of deleted task
+type DeleteRequest_Mode int32
+
+const (
+	// Task is deleted only when it has no subtasks
+	DeleteRequest_MODE_UNSPECIFIED DeleteRequest_Mode = 0
+	// Task is deleted together with all its subtasks, Undelete restores them together
+	DeleteRequest_CASCADE DeleteRequest_Mode = 1
+	// Subtasks are moved to the parent of deleted task
+	DeleteRequest_REPARENT DeleteRequest_Mode = 2
+)
+
+var DeleteRequest_Mode_name = map[int32]string{
+	0: "MODE_UNSPECIFIED",
+	1: "CASCADE",
+	2: "REPARENT",
+}
+
+var DeleteRequest_Mode_value = map[string]int32{
+	"MODE_UNSPECIFIED": 0,
+	"CASCADE":          1,
+	"REPARENT":         2,
+}
+
+func (x DeleteRequest_Mode) String() string {
+	return proto.EnumName(DeleteRequest_Mode_name, int32(x))
+}
+
+func (DeleteRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{9, 0}
+}
+
+// How tasks are matched by labels
+type ReadAllRequest_LabelMatch int32
+
+const (
+	// Same as ALL
+	ReadAllRequest_LABEL_MATCH_UNSPECIFIED ReadAllRequest_LabelMatch = 0
+	// Return tasks having all the labels
+	ReadAllRequest_ALL ReadAllRequest_LabelMatch = 1
+	// Return tasks having at least one of the labels
+	ReadAllRequest_ANY ReadAllRequest_LabelMatch = 2
+)
+
+var ReadAllRequest_LabelMatch_name = map[int32]string{
+	0: "LABEL_MATCH_UNSPECIFIED",
+	1: "ALL",
+	2: "ANY",
+}
+
+var ReadAllRequest_LabelMatch_value = map[string]int32{
+	"LABEL_MATCH_UNSPECIFIED": 0,
+	"ALL":                     1,
+	"ANY":                     2,
+}
+
+func (x ReadAllRequest_LabelMatch) String() string {
+	return proto.EnumName(ReadAllRequest_LabelMatch_name, int32(x))
+}
+
+func (ReadAllRequest_LabelMatch) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{22, 0}
+}
+
+type HistoryEntry_Action int32
+
+const (
+	// Kind of change is not set
+	HistoryEntry_ACTION_UNSPECIFIED HistoryEntry_Action = 0
+	// Task is created
+	HistoryEntry_CREATED HistoryEntry_Action = 1
+	// Task is updated
+	HistoryEntry_UPDATED HistoryEntry_Action = 2
+	// Task is moved to the trash or deleted together with its project
+	HistoryEntry_DELETED HistoryEntry_Action = 3
+	// Task is restored from the trash by Undelete
+	HistoryEntry_RESTORED HistoryEntry_Action = 4
+)
+
+var HistoryEntry_Action_name = map[int32]string{
+	0: "ACTION_UNSPECIFIED",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+	4: "RESTORED",
+}
+
+var HistoryEntry_Action_value = map[string]int32{
+	"ACTION_UNSPECIFIED": 0,
+	"CREATED":            1,
+	"UPDATED":            2,
+	"DELETED":            3,
+	"RESTORED":           4,
+}
+
+func (x HistoryEntry_Action) String() string {
+	return proto.EnumName(HistoryEntry_Action_name, int32(x))
+}
+
+func (HistoryEntry_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{32, 0}
+}
+
+// What happens to the tasks of deleted project
+type DeleteProjectRequest_Mode int32
+
+const (
+	// Project is deleted only when it has no tasks
+	DeleteProjectRequest_MODE_UNSPECIFIED DeleteProjectRequest_Mode = 0
+	// Project is deleted together with its tasks
+	DeleteProjectRequest_CASCADE DeleteProjectRequest_Mode = 1
+	// Project is archived instead of deleting, its tasks are kept
+	DeleteProjectRequest_ARCHIVE DeleteProjectRequest_Mode = 2
+)
+
+var DeleteProjectRequest_Mode_name = map[int32]string{
+	0: "MODE_UNSPECIFIED",
+	1: "CASCADE",
+	2: "ARCHIVE",
+}
+
+var DeleteProjectRequest_Mode_value = map[string]int32{
+	"MODE_UNSPECIFIED": 0,
+	"CASCADE":          1,
+	"ARCHIVE":          2,
+}
+
+func (x DeleteProjectRequest_Mode) String() string {
+	return proto.EnumName(DeleteProjectRequest_Mode_name, int32(x))
+}
+
+func (DeleteProjectRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{70, 0}
+}
+
+// Kind of change
+type WatchResponse_EventType int32
+
+const (
+	// Kind of change is not set
+	WatchResponse_EVENT_TYPE_UNSPECIFIED WatchResponse_EventType = 0
+	// Task is created
+	WatchResponse_CREATED WatchResponse_EventType = 1
+	// Task is updated
+	WatchResponse_UPDATED WatchResponse_EventType = 2
+	// Task is deleted
+	WatchResponse_DELETED WatchResponse_EventType = 3
+)
+
+var WatchResponse_EventType_name = map[int32]string{
+	0: "EVENT_TYPE_UNSPECIFIED",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+
+var WatchResponse_EventType_value = map[string]int32{
+	"EVENT_TYPE_UNSPECIFIED": 0,
+	"CREATED":                1,
+	"UPDATED":                2,
+	"DELETED":                3,
+}
+
+func (x WatchResponse_EventType) String() string {
+	return proto.EnumName(WatchResponse_EventType_name, int32(x))
+}
+
+func (WatchResponse_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{83, 0}
+}
+
+// Tasks we have todo
 type ToDo struct {
 	//Unique integer identifier of the task
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -36,16 +319,45 @@ type ToDo struct {
 	//Detailed description of the todo task
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	//Status of the task
-	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Status Status `protobuf:"varint,4,opt,name=status,proto3,enum=v1.Status" json:"status,omitempty"`
 	//Estimated date and time of completion
 	EstimatedTimeOfCompletion *timestamp.Timestamp `protobuf:"bytes,5,opt,name=estimatedTimeOfCompletion,proto3" json:"estimatedTimeOfCompletion,omitempty"`
 	//Actual date and time of completion
+	//It is set by server when the task is completed and cleared when the task is reopened
 	ActualTimeOfCompletion *timestamp.Timestamp `protobuf:"bytes,6,opt,name=actualTimeOfCompletion,proto3" json:"actualTimeOfCompletion,omitempty"`
 	//Date and time to remind the todo task
-	Reminder             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=reminder,proto3" json:"reminder,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Reminder *timestamp.Timestamp `protobuf:"bytes,7,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	//Date and time the task was created, set by server
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	//Date and time the task was last updated, set by server
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	//iCalendar RRULE the task repeats by e.g. "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10"
+	//FREQ is DAILY, WEEKLY or MONTHLY, INTERVAL, BYDAY, WKST and one of COUNT or UNTIL are optional
+	//Estimated time of completion is the first occurrence, completing the task creates the next one
+	Recurrence string `protobuf:"bytes,10,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	//ID of the project the task belongs to, 0 when the task is not in any project
+	//Title of the task is unique among tasks of its owner in its project
+	ProjectId int64 `protobuf:"varint,11,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	//ID of the task this task is a subtask of, 0 for top level task.
+	//It is set on creation and changed by Move
+	ParentId int64 `protobuf:"varint,12,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	//Labels categorizing the task sorted by name.
+	//They are set on creation and changed by AddLabels and RemoveLabels
+	Labels []string `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`
+	//Date and time the task was moved to the trash by Delete, set by server.
+	//Tasks in the trash are returned only by ReadAll with show_deleted and are purged after retention period
+	DeletedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	//Version of the task, set by server and incremented on every change of the task.
+	//Update and Delete given the version fail with ABORTED (HTTP 409) when the task was changed since it was read,
+	//HTTP clients may send it in If-Match header instead and get 412 Precondition Failed
+	Version int64 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	//User the task belongs to, set by server to the caller creating the task: the authenticated subject,
+	//"x-actor" metadata (X-Actor HTTP header) or the default owner configured on server.
+	//Other users get PERMISSION_DENIED (HTTP 403) unless the task is shared with them by Share
+	Owner                string   `protobuf:"bytes,16,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ToDo) Reset()         { *m = ToDo{} }
@@ -94,11 +406,11 @@ func (m *ToDo) GetDescription() string {
 	return ""
 }
 
-func (m *ToDo) GetStatus() string {
+func (m *ToDo) GetStatus() Status {
 	if m != nil {
 		return m.Status
 	}
-	return ""
+	return Status_STATUS_UNSPECIFIED
 }
 
 func (m *ToDo) GetEstimatedTimeOfCompletion() *timestamp.Timestamp {
@@ -122,7 +434,243 @@ func (m *ToDo) GetReminder() *timestamp.Timestamp {
 	return nil
 }
 
-//Request data to create new todo task
+func (m *ToDo) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ToDo) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ToDo) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
+	}
+	return ""
+}
+
+func (m *ToDo) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+func (m *ToDo) GetParentId() int64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *ToDo) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ToDo) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *ToDo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ToDo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// Project groups todo tasks e.g. of a team
+type Project struct {
+	//Unique integer identifier of the project
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//Unique name of the project
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//Detailed description of the project
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	//Archived project keeps its tasks but no task can be added to it
+	Archived bool `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	//Date and time the project was created, set by server
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	//Date and time the project was last updated, set by server
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Project) Reset()         { *m = Project{} }
+func (m *Project) String() string { return proto.CompactTextString(m) }
+func (*Project) ProtoMessage()    {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{1}
+}
+
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Project.Unmarshal(m, b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Project.Marshal(b, m, deterministic)
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return xxx_messageInfo_Project.Size(m)
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *Project) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Project) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Project) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Project) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *Project) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Project) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+// Tenant is a team whose tasks and projects are isolated from other tenants in multi-tenant mode
+type Tenant struct {
+	//Unique identifier of the tenant sent by its users in "x-tenant-id" metadata (X-Tenant-ID HTTP header),
+	//lowercase letters, digits and dashes
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//Display name of the tenant
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//Maximum number of tasks of the tenant including the deleted ones kept in the trash, 0 means no limit
+	MaxTasks int64 `protobuf:"varint,3,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+	//Suspended tenant keeps its data but its users get PERMISSION_DENIED (HTTP 403)
+	Suspended bool `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	//Date and time the tenant was created, set by server
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	//Date and time the tenant was last updated, set by server
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Tenant) Reset()         { *m = Tenant{} }
+func (m *Tenant) String() string { return proto.CompactTextString(m) }
+func (*Tenant) ProtoMessage()    {}
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{2}
+}
+
+func (m *Tenant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tenant.Unmarshal(m, b)
+}
+func (m *Tenant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tenant.Marshal(b, m, deterministic)
+}
+func (m *Tenant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tenant.Merge(m, src)
+}
+func (m *Tenant) XXX_Size() int {
+	return xxx_messageInfo_Tenant.Size(m)
+}
+func (m *Tenant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tenant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tenant proto.InternalMessageInfo
+
+func (m *Tenant) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Tenant) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tenant) GetMaxTasks() int64 {
+	if m != nil {
+		return m.MaxTasks
+	}
+	return 0
+}
+
+func (m *Tenant) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *Tenant) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Tenant) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+// Request data to create new todo task
 type CreateRequest struct {
 	//API Versioning : Best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
@@ -137,7 +685,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{1}
+	return fileDescriptor_80b701c7b1c502fe, []int{3}
 }
 
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
@@ -172,7 +720,7 @@ func (m *CreateRequest) GetToDo() *ToDo {
 	return nil
 }
 
-//Contains data of created todo task
+// Contains data of created todo task
 type CreateResponse struct {
 	//API Versioning : best practice
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
@@ -187,7 +735,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{2}
+	return fileDescriptor_80b701c7b1c502fe, []int{4}
 }
 
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
@@ -222,7 +770,7 @@ func (m *CreateResponse) GetId() int64 {
 	return 0
 }
 
-//Request data to read todo task
+// Request data to read todo task
 type ReadRequest struct {
 	//API versioning:Best practice
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
@@ -237,7 +785,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{3}
+	return fileDescriptor_80b701c7b1c502fe, []int{5}
 }
 
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
@@ -272,7 +820,7 @@ func (m *ReadRequest) GetId() int64 {
 	return 0
 }
 
-//Contains todo task data specified in ID Request
+// Contains todo task data specified in ID Request
 type ReadResponse struct {
 	//API versioning
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
@@ -287,7 +835,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{4}
+	return fileDescriptor_80b701c7b1c502fe, []int{6}
 }
 
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
@@ -327,17 +875,22 @@ type UpdateRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	// Task entity to update
-	ToDo                 *ToDo    `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Its version is checked like version of DeleteRequest, it is never part of the update mask
+	ToDo *ToDo `protobuf:"bytes,2,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Fields of the task to update e.g. "title,reminder"
+	// All fields are replaced when the mask is empty
+	// PATCH infers the mask from the fields present in the JSON body
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{5}
+	return fileDescriptor_80b701c7b1c502fe, []int{7}
 }
 
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -372,13 +925,24 @@ func (m *UpdateRequest) GetToDo() *ToDo {
 	return nil
 }
 
+func (m *UpdateRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 // Contains status of update operation
 type UpdateResponse struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	// Contains number of entities have been updated
 	// Equals 1 in case of successful update
-	Updated              int64    `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Updated int64 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// ID of the next occurrence created when recurring task is completed
+	NextId int64 `protobuf:"varint,3,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Version of the updated task
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -388,7 +952,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{6}
+	return fileDescriptor_80b701c7b1c502fe, []int{8}
 }
 
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -423,12 +987,33 @@ func (m *UpdateResponse) GetUpdated() int64 {
 	return 0
 }
 
+func (m *UpdateResponse) GetNextId() int64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+func (m *UpdateResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // Request data to delete todo task
+// Deleted task is moved to the trash, it can be restored by Undelete until it is purged
+// Title of the task stays in use until the task is purged
 type DeleteRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	// Unique integer identifier of the todo task to delete
-	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to the subtasks of the task
+	Mode DeleteRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.DeleteRequest_Mode" json:"mode,omitempty"`
+	// Version of the task read by client, the task is deleted only when it was not changed since.
+	// 0 deletes any version unless server requires versions
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -438,7 +1023,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{7}
+	return fileDescriptor_80b701c7b1c502fe, []int{9}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -473,12 +1058,26 @@ func (m *DeleteRequest) GetId() int64 {
 	return 0
 }
 
+func (m *DeleteRequest) GetMode() DeleteRequest_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return DeleteRequest_MODE_UNSPECIFIED
+}
+
+func (m *DeleteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // Contains status of delete operation
 type DeleteResponse struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	// Contains number of entities have beed deleted
-	// Equals 1 in case of succesfull delete
+	// Equals 1 in case of succesfull delete, subtasks deleted in CASCADE mode are counted too
 	Deleted              int64    `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -489,7 +1088,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{8}
+	return fileDescriptor_80b701c7b1c502fe, []int{10}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -524,364 +1123,5708 @@ func (m *DeleteResponse) GetDeleted() int64 {
 	return 0
 }
 
-// Request data to read all todo task
-type ReadAllRequest struct {
+// Request data to restore todo task from the trash
+type UndeleteRequest struct {
 	// API versioning: it is my best practice to specify version explicitly
-	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the deleted todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadAllRequest) Reset()         { *m = ReadAllRequest{} }
-func (m *ReadAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadAllRequest) ProtoMessage()    {}
-func (*ReadAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{9}
+func (m *UndeleteRequest) Reset()         { *m = UndeleteRequest{} }
+func (m *UndeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*UndeleteRequest) ProtoMessage()    {}
+func (*UndeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{11}
 }
 
-func (m *ReadAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadAllRequest.Unmarshal(m, b)
+func (m *UndeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UndeleteRequest.Unmarshal(m, b)
 }
-func (m *ReadAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadAllRequest.Marshal(b, m, deterministic)
+func (m *UndeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UndeleteRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadAllRequest.Merge(m, src)
+func (m *UndeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndeleteRequest.Merge(m, src)
 }
-func (m *ReadAllRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadAllRequest.Size(m)
+func (m *UndeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_UndeleteRequest.Size(m)
 }
-func (m *ReadAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadAllRequest.DiscardUnknown(m)
+func (m *UndeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_UndeleteRequest proto.InternalMessageInfo
 
-func (m *ReadAllRequest) GetApi() string {
+func (m *UndeleteRequest) GetApi() string {
 	if m != nil {
 		return m.Api
 	}
 	return ""
 }
 
-// Contains list of all todo tasks
-type ReadAllResponse struct {
+func (m *UndeleteRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains status of undelete operation
+type UndeleteResponse struct {
 	// API versioning: it is my best practice to specify version explicitly
 	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
-	// List of all todo tasks
-	ToDos                []*ToDo  `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// Contains number of restored tasks including subtasks deleted together with the task in CASCADE mode
+	Undeleted            int64    `protobuf:"varint,2,opt,name=undeleted,proto3" json:"undeleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadAllResponse) Reset()         { *m = ReadAllResponse{} }
-func (m *ReadAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadAllResponse) ProtoMessage()    {}
-func (*ReadAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b701c7b1c502fe, []int{10}
+func (m *UndeleteResponse) Reset()         { *m = UndeleteResponse{} }
+func (m *UndeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*UndeleteResponse) ProtoMessage()    {}
+func (*UndeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{12}
 }
 
-func (m *ReadAllResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadAllResponse.Unmarshal(m, b)
+func (m *UndeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UndeleteResponse.Unmarshal(m, b)
 }
-func (m *ReadAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadAllResponse.Marshal(b, m, deterministic)
+func (m *UndeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UndeleteResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadAllResponse.Merge(m, src)
+func (m *UndeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndeleteResponse.Merge(m, src)
 }
-func (m *ReadAllResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadAllResponse.Size(m)
+func (m *UndeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_UndeleteResponse.Size(m)
 }
-func (m *ReadAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadAllResponse.DiscardUnknown(m)
+func (m *UndeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndeleteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_UndeleteResponse proto.InternalMessageInfo
 
-func (m *ReadAllResponse) GetApi() string {
+func (m *UndeleteResponse) GetApi() string {
 	if m != nil {
 		return m.Api
 	}
 	return ""
 }
 
-func (m *ReadAllResponse) GetToDos() []*ToDo {
+func (m *UndeleteResponse) GetUndeleted() int64 {
 	if m != nil {
-		return m.ToDos
+		return m.Undeleted
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*ToDo)(nil), "v1.ToDo")
-	proto.RegisterType((*CreateRequest)(nil), "v1.CreateRequest")
-	proto.RegisterType((*CreateResponse)(nil), "v1.CreateResponse")
-	proto.RegisterType((*ReadRequest)(nil), "v1.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "v1.ReadResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "v1.UpdateResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "v1.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "v1.DeleteResponse")
-	proto.RegisterType((*ReadAllRequest)(nil), "v1.ReadAllRequest")
-	proto.RegisterType((*ReadAllResponse)(nil), "v1.ReadAllResponse")
+// Request data to create todo tasks in one transaction
+type BatchCreateRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tasks to create, at most 1000
+	Requests []*CreateRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// How failed items are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func init() { proto.RegisterFile("todo-service.proto", fileDescriptor_80b701c7b1c502fe) }
+func (m *BatchCreateRequest) Reset()         { *m = BatchCreateRequest{} }
+func (m *BatchCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateRequest) ProtoMessage()    {}
+func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{13}
+}
 
-var fileDescriptor_80b701c7b1c502fe = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6f, 0xeb, 0x44,
-	0x10, 0x96, 0x9d, 0x34, 0xe9, 0x9b, 0xbc, 0xa4, 0x61, 0x1f, 0xef, 0x91, 0x67, 0x3d, 0xc1, 0xca,
-	0xa7, 0x2a, 0xa2, 0x76, 0x13, 0xaa, 0x77, 0x08, 0x15, 0x6d, 0x69, 0xc4, 0x15, 0xc9, 0x14, 0x84,
-	0xb8, 0x6d, 0xe2, 0xa9, 0xb3, 0xd4, 0xf6, 0xba, 0xde, 0x75, 0x8a, 0x84, 0x7a, 0xe1, 0xcc, 0x09,
-	0x6e, 0xfc, 0x26, 0x2e, 0x08, 0xf1, 0x0f, 0xf8, 0x21, 0xc8, 0xbb, 0x76, 0xd2, 0xb4, 0x4d, 0x7b,
-	0xe0, 0x94, 0xcc, 0xec, 0x7c, 0xdf, 0x37, 0x33, 0x9f, 0x35, 0x40, 0x94, 0x08, 0xc5, 0x81, 0xc4,
-	0x7c, 0xc9, 0xe7, 0xe8, 0x65, 0xb9, 0x50, 0x82, 0xd8, 0xcb, 0x91, 0xf3, 0x49, 0x24, 0x44, 0x14,
-	0xa3, 0xaf, 0x33, 0xb3, 0xe2, 0xd2, 0x57, 0x3c, 0x41, 0xa9, 0x58, 0x92, 0x99, 0x22, 0xe7, 0x5d,
-	0x55, 0xc0, 0x32, 0xee, 0xb3, 0x34, 0x15, 0x8a, 0x29, 0x2e, 0x52, 0x59, 0xbd, 0x7e, 0xaa, 0x7f,
-	0xe6, 0x07, 0x11, 0xa6, 0x07, 0xf2, 0x86, 0x45, 0x11, 0xe6, 0xbe, 0xc8, 0x74, 0xc5, 0xc3, 0x6a,
-	0xf7, 0x4f, 0x1b, 0x9a, 0x17, 0x62, 0x2a, 0x48, 0x0f, 0x6c, 0x1e, 0x0e, 0x2c, 0x6a, 0xed, 0x37,
-	0x02, 0x9b, 0x87, 0xe4, 0x43, 0xd8, 0x51, 0x5c, 0xc5, 0x38, 0xb0, 0xa9, 0xb5, 0xff, 0x22, 0x30,
-	0x01, 0xa1, 0xd0, 0x09, 0x51, 0xce, 0x73, 0xae, 0x09, 0x07, 0x0d, 0xfd, 0x76, 0x37, 0x45, 0xde,
-	0x40, 0x4b, 0x2a, 0xa6, 0x0a, 0x39, 0x68, 0xea, 0xc7, 0x2a, 0x22, 0xdf, 0xc3, 0x5b, 0x94, 0x8a,
-	0x27, 0x4c, 0x61, 0x78, 0xc1, 0x13, 0xfc, 0xfa, 0xf2, 0x5c, 0x24, 0x59, 0x8c, 0x9a, 0x67, 0x87,
-	0x5a, 0xfb, 0x9d, 0xb1, 0xe3, 0x99, 0xc1, 0xbc, 0x7a, 0x72, 0xef, 0xa2, 0x9e, 0x3c, 0xd8, 0x0e,
-	0x26, 0x01, 0xbc, 0x61, 0x73, 0x55, 0xb0, 0xf8, 0x01, 0x6d, 0xeb, 0x59, 0xda, 0x2d, 0x48, 0xf2,
-	0x1e, 0x76, 0x73, 0x4c, 0x78, 0x1a, 0x62, 0x3e, 0x68, 0x3f, 0xcb, 0xb2, 0xaa, 0x75, 0x4f, 0xa0,
-	0x7b, 0x9e, 0x23, 0x53, 0x18, 0xe0, 0x75, 0x81, 0x52, 0x91, 0x3e, 0x34, 0x58, 0xc6, 0xf5, 0x5e,
-	0x5f, 0x04, 0xe5, 0x5f, 0xf2, 0x0e, 0x9a, 0x4a, 0x4c, 0x85, 0xde, 0x6b, 0x67, 0xbc, 0xeb, 0x2d,
-	0x47, 0x5e, 0x69, 0x40, 0xa0, 0xb3, 0xee, 0x18, 0x7a, 0x35, 0x81, 0xcc, 0x44, 0x2a, 0xf1, 0x11,
-	0x06, 0x63, 0x95, 0x5d, 0x5b, 0xe5, 0xfa, 0xd0, 0x09, 0x90, 0x85, 0xdb, 0x25, 0xef, 0x03, 0xbe,
-	0x80, 0x97, 0x06, 0xb0, 0x55, 0xe2, 0xe9, 0x26, 0x4f, 0xa0, 0xfb, 0x6d, 0x16, 0xfe, 0x8f, 0x29,
-	0x8f, 0xa1, 0x57, 0x13, 0x6c, 0x6d, 0x61, 0x00, 0xed, 0x42, 0xd7, 0xd4, 0x9d, 0xd7, 0xa1, 0x3b,
-	0x82, 0xee, 0x14, 0x63, 0x7c, 0x4a, 0xfe, 0xfe, 0xc4, 0xc7, 0xd0, 0xab, 0x21, 0x4f, 0x09, 0x86,
-	0xba, 0x66, 0x25, 0x58, 0x85, 0xae, 0x0b, 0xbd, 0x72, 0x5f, 0x67, 0x71, 0xbc, 0x55, 0xd1, 0x3d,
-	0x87, 0xbd, 0x55, 0xcd, 0x56, 0x89, 0x8f, 0x61, 0xa7, 0x9c, 0x5f, 0x0e, 0x6c, 0xda, 0xd8, 0x58,
-	0x8b, 0x49, 0x8f, 0x7f, 0x6d, 0x40, 0xa7, 0x8c, 0xbf, 0x31, 0x47, 0x81, 0x4c, 0xa1, 0x65, 0xbe,
-	0x06, 0xf2, 0x41, 0x59, 0xba, 0xf1, 0x69, 0x39, 0xe4, 0x6e, 0xca, 0x48, 0xba, 0xaf, 0x7e, 0xf9,
-	0xfb, 0xdf, 0xdf, 0xed, 0xae, 0xbb, 0xeb, 0x2f, 0x47, 0xbe, 0x62, 0xf2, 0x7a, 0x62, 0x0d, 0xc9,
-	0x29, 0x34, 0xcb, 0xd6, 0xc8, 0x5e, 0x09, 0xb8, 0xf3, 0xa5, 0x38, 0xfd, 0x75, 0xa2, 0xc2, 0xbf,
-	0xd6, 0xf8, 0x3d, 0xd2, 0xad, 0xf1, 0xfe, 0xcf, 0x3c, 0xbc, 0x25, 0x11, 0xb4, 0x8c, 0x5f, 0xa6,
-	0x8f, 0x0d, 0xf3, 0x4d, 0x1f, 0x9b, 0x76, 0xba, 0xef, 0x35, 0xcf, 0xe1, 0x98, 0xac, 0x79, 0xca,
-	0x09, 0x3d, 0x1e, 0xde, 0x4e, 0xac, 0xe1, 0x0f, 0x1f, 0x39, 0x8f, 0x3f, 0x90, 0xaf, 0xa0, 0x65,
-	0x7c, 0x32, 0x42, 0x1b, 0x36, 0x1b, 0xa1, 0x4d, 0x1b, 0xeb, 0x86, 0x87, 0xf7, 0x1a, 0x9e, 0x42,
-	0xbb, 0x72, 0x83, 0x90, 0x7a, 0xc8, 0xb5, 0x7d, 0xce, 0xab, 0x8d, 0x5c, 0x45, 0xd5, 0xd7, 0x54,
-	0x40, 0x56, 0xbb, 0xfb, 0xf2, 0x1f, 0xeb, 0xb7, 0xb3, 0xbf, 0x2c, 0x72, 0x05, 0x2f, 0x4b, 0x53,
-	0x68, 0x75, 0xaa, 0xdd, 0xef, 0xe0, 0x2d, 0xa3, 0x92, 0x97, 0xc7, 0x82, 0x2a, 0x26, 0xaf, 0x68,
-	0xc2, 0x52, 0x16, 0x61, 0x4e, 0x4b, 0x8b, 0xdd, 0x85, 0x52, 0x99, 0x9c, 0xf8, 0x7e, 0xc4, 0xd5,
-	0xa2, 0x98, 0x79, 0x73, 0x91, 0xf8, 0x33, 0x26, 0x71, 0xc6, 0xd2, 0x90, 0x2b, 0xcd, 0xeb, 0xbc,
-	0x36, 0xe0, 0xd3, 0x75, 0xde, 0x0b, 0x71, 0x39, 0x6e, 0x8c, 0xbc, 0xc3, 0xa1, 0x65, 0x8d, 0xfb,
-	0x2c, 0xcb, 0x62, 0x3e, 0xd7, 0x77, 0xda, 0xff, 0x51, 0x8a, 0x74, 0xf2, 0x20, 0x13, 0x7c, 0x0e,
-	0x8d, 0xa3, 0xc3, 0x23, 0x72, 0x04, 0xc3, 0x00, 0x55, 0x91, 0xa7, 0x18, 0xd2, 0x9b, 0x05, 0xa6,
-	0x54, 0x2d, 0x90, 0xe6, 0x28, 0x45, 0x91, 0xcf, 0x91, 0x86, 0x02, 0x25, 0x4d, 0x85, 0xa2, 0xf8,
-	0x13, 0x97, 0xca, 0x23, 0x2d, 0x68, 0xfe, 0x61, 0x5b, 0xed, 0x59, 0x4b, 0xdf, 0xb0, 0xcf, 0xfe,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x36, 0xaf, 0xf2, 0x3b, 0x82, 0x06, 0x00, 0x00,
+func (m *BatchCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateRequest.Unmarshal(m, b)
+}
+func (m *BatchCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateRequest.Merge(m, src)
+}
+func (m *BatchCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateRequest.Size(m)
+}
+func (m *BatchCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_BatchCreateRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BatchCreateRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
 
-// ToDoServiceClient is the client API for ToDoService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ToDoServiceClient interface {
-	// Create new todo task
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
-	// Read todo task
-	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
-	// Update todo task
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Delete todo task
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	// Read all todo tasks
-	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
+func (m *BatchCreateRequest) GetRequests() []*CreateRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
 }
 
-type toDoServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BatchCreateRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
 }
 
-func NewToDoServiceClient(cc *grpc.ClientConn) ToDoServiceClient {
-	return &toDoServiceClient{cc}
+// Result of creating one todo task of the batch
+type BatchCreateResult struct {
+	// Status of the item, code is 0 when the task is created
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// ID of created task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *toDoServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
-	out := new(CreateResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BatchCreateResult) Reset()         { *m = BatchCreateResult{} }
+func (m *BatchCreateResult) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateResult) ProtoMessage()    {}
+func (*BatchCreateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{14}
 }
 
-func (c *toDoServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
-	out := new(ReadResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Read", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCreateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateResult.Unmarshal(m, b)
+}
+func (m *BatchCreateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateResult.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateResult.Merge(m, src)
+}
+func (m *BatchCreateResult) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateResult.Size(m)
+}
+func (m *BatchCreateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateResult proto.InternalMessageInfo
+
+func (m *BatchCreateResult) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *toDoServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCreateResult) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *toDoServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Contains results of batch create in the order of requests
+type BatchCreateResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Result of every request
+	Results              []*BatchCreateResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchCreateResponse) Reset()         { *m = BatchCreateResponse{} }
+func (m *BatchCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateResponse) ProtoMessage()    {}
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{15}
+}
+
+func (m *BatchCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCreateResponse.Unmarshal(m, b)
+}
+func (m *BatchCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCreateResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateResponse.Merge(m, src)
+}
+func (m *BatchCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchCreateResponse.Size(m)
+}
+func (m *BatchCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateResponse proto.InternalMessageInfo
+
+func (m *BatchCreateResponse) GetApi() string {
+	if m != nil {
+		return m.Api
 	}
-	return out, nil
+	return ""
 }
 
-func (c *toDoServiceClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
-	out := new(ReadAllResponse)
-	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCreateResponse) GetResults() []*BatchCreateResult {
+	if m != nil {
+		return m.Results
 	}
-	return out, nil
+	return nil
 }
 
-// ToDoServiceServer is the server API for ToDoService service.
-type ToDoServiceServer interface {
-	// Create new todo task
-	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	// Read todo task
-	Read(context.Context, *ReadRequest) (*ReadResponse, error)
-	// Update todo task
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Delete todo task
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	// Read all todo tasks
-	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
+// Request data to update todo tasks in one transaction
+type BatchUpdateRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Updates of tasks, at most 1000 and every task at most once
+	Requests []*UpdateRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// How failed items are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-// UnimplementedToDoServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedToDoServiceServer struct {
+func (m *BatchUpdateRequest) Reset()         { *m = BatchUpdateRequest{} }
+func (m *BatchUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateRequest) ProtoMessage()    {}
+func (*BatchUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{16}
 }
 
-func (*UnimplementedToDoServiceServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *BatchUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateRequest.Unmarshal(m, b)
 }
-func (*UnimplementedToDoServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+func (m *BatchUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedToDoServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *BatchUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateRequest.Merge(m, src)
 }
-func (*UnimplementedToDoServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *BatchUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateRequest.Size(m)
 }
-func (*UnimplementedToDoServiceServer) ReadAll(ctx context.Context, req *ReadAllRequest) (*ReadAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadAll not implemented")
+func (m *BatchUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateRequest.DiscardUnknown(m)
 }
 
-func RegisterToDoServiceServer(s *grpc.Server, srv ToDoServiceServer) {
-	s.RegisterService(&_ToDoService_serviceDesc, srv)
-}
+var xxx_messageInfo_BatchUpdateRequest proto.InternalMessageInfo
 
-func _ToDoService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ToDoServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/v1.ToDoService/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Create(ctx, req.(*CreateRequest))
+func (m *BatchUpdateRequest) GetApi() string {
+	if m != nil {
+		return m.Api
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ToDoService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchUpdateRequest) GetRequests() []*UpdateRequest {
+	if m != nil {
+		return m.Requests
 	}
-	if interceptor == nil {
-		return srv.(ToDoServiceServer).Read(ctx, in)
+	return nil
+}
+
+func (m *BatchUpdateRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// Result of updating one todo task of the batch
+type BatchUpdateResult struct {
+	// Status of the item, code is 0 when the task is updated
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of updated tasks
+	Updated int64 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// ID of the next occurrence created when recurring task is completed
+	NextId               int64    `protobuf:"varint,3,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateResult) Reset()         { *m = BatchUpdateResult{} }
+func (m *BatchUpdateResult) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateResult) ProtoMessage()    {}
+func (*BatchUpdateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{17}
+}
+
+func (m *BatchUpdateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateResult.Unmarshal(m, b)
+}
+func (m *BatchUpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateResult.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateResult.Merge(m, src)
+}
+func (m *BatchUpdateResult) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateResult.Size(m)
+}
+func (m *BatchUpdateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateResult proto.InternalMessageInfo
+
+func (m *BatchUpdateResult) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BatchUpdateResult) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *BatchUpdateResult) GetNextId() int64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+// Contains results of batch update in the order of requests
+type BatchUpdateResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Result of every request
+	Results              []*BatchUpdateResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchUpdateResponse) Reset()         { *m = BatchUpdateResponse{} }
+func (m *BatchUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateResponse) ProtoMessage()    {}
+func (*BatchUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{18}
+}
+
+func (m *BatchUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateResponse.Unmarshal(m, b)
+}
+func (m *BatchUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateResponse.Merge(m, src)
+}
+func (m *BatchUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateResponse.Size(m)
+}
+func (m *BatchUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateResponse proto.InternalMessageInfo
+
+func (m *BatchUpdateResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchUpdateResponse) GetResults() []*BatchUpdateResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Request data to delete todo tasks in one transaction
+type BatchDeleteRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tasks to delete, at most 1000 and every task at most once
+	Requests []*DeleteRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// How failed items are handled
+	Mode                 BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.BatchMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BatchDeleteRequest) Reset()         { *m = BatchDeleteRequest{} }
+func (m *BatchDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteRequest) ProtoMessage()    {}
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{19}
+}
+
+func (m *BatchDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteRequest.Unmarshal(m, b)
+}
+func (m *BatchDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteRequest.Merge(m, src)
+}
+func (m *BatchDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteRequest.Size(m)
+}
+func (m *BatchDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteRequest proto.InternalMessageInfo
+
+func (m *BatchDeleteRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchDeleteRequest) GetRequests() []*DeleteRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *BatchDeleteRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// Result of deleting one todo task of the batch
+type BatchDeleteResult struct {
+	// Status of the item, code is 0 when the task is deleted
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of deleted tasks, subtasks deleted in CASCADE mode are counted too
+	Deleted              int64    `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchDeleteResult) Reset()         { *m = BatchDeleteResult{} }
+func (m *BatchDeleteResult) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteResult) ProtoMessage()    {}
+func (*BatchDeleteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{20}
+}
+
+func (m *BatchDeleteResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteResult.Unmarshal(m, b)
+}
+func (m *BatchDeleteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteResult.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteResult.Merge(m, src)
+}
+func (m *BatchDeleteResult) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteResult.Size(m)
+}
+func (m *BatchDeleteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteResult proto.InternalMessageInfo
+
+func (m *BatchDeleteResult) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BatchDeleteResult) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+// Contains results of batch delete in the order of requests
+type BatchDeleteResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Result of every request
+	Results              []*BatchDeleteResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchDeleteResponse) Reset()         { *m = BatchDeleteResponse{} }
+func (m *BatchDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteResponse) ProtoMessage()    {}
+func (*BatchDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{21}
+}
+
+func (m *BatchDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteResponse.Unmarshal(m, b)
+}
+func (m *BatchDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteResponse.Merge(m, src)
+}
+func (m *BatchDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteResponse.Size(m)
+}
+func (m *BatchDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteResponse proto.InternalMessageInfo
+
+func (m *BatchDeleteResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *BatchDeleteResponse) GetResults() []*BatchDeleteResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Request data to read all todo task
+type ReadAllRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Maximum number of tasks to return in one page
+	// Server uses 100 when it is not set and never returns more than 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token of the page to return, it is next_page_token of the previous response
+	// Filters and order_by must be the same as in the request returned the token
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Return only tasks with the status
+	Status Status `protobuf:"varint,4,opt,name=status,proto3,enum=v1.Status" json:"status,omitempty"`
+	// Return only tasks with reminder at or after the time
+	ReminderAfter *timestamp.Timestamp `protobuf:"bytes,5,opt,name=reminder_after,json=reminderAfter,proto3" json:"reminder_after,omitempty"`
+	// Return only tasks with reminder before the time
+	ReminderBefore *timestamp.Timestamp `protobuf:"bytes,6,opt,name=reminder_before,json=reminderBefore,proto3" json:"reminder_before,omitempty"`
+	// Return only tasks with estimated time of completion at or after the time
+	DueAfter *timestamp.Timestamp `protobuf:"bytes,7,opt,name=due_after,json=dueAfter,proto3" json:"due_after,omitempty"`
+	// Return only tasks with estimated time of completion before the time
+	DueBefore *timestamp.Timestamp `protobuf:"bytes,8,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"`
+	// Sort order of the tasks: one of id, title, status, estimatedTimeOfCompletion, reminder
+	// optionally followed by " desc" e.g. "estimatedTimeOfCompletion desc", default is "id"
+	OrderBy string `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Return only tasks of the project
+	ProjectId int64 `protobuf:"varint,10,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Return only open tasks which prerequisites are all completed or cancelled
+	Ready bool `protobuf:"varint,11,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Return only tasks with the labels
+	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Whether tasks must have all the labels or any of them
+	LabelMatch ReadAllRequest_LabelMatch `protobuf:"varint,13,opt,name=label_match,json=labelMatch,proto3,enum=v1.ReadAllRequest_LabelMatch" json:"label_match,omitempty"`
+	// Return tasks in the trash too, they have deletedAt set
+	ShowDeleted          bool     `protobuf:"varint,14,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadAllRequest) Reset()         { *m = ReadAllRequest{} }
+func (m *ReadAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadAllRequest) ProtoMessage()    {}
+func (*ReadAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{22}
+}
+
+func (m *ReadAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadAllRequest.Unmarshal(m, b)
+}
+func (m *ReadAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadAllRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadAllRequest.Merge(m, src)
+}
+func (m *ReadAllRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadAllRequest.Size(m)
+}
+func (m *ReadAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadAllRequest proto.InternalMessageInfo
+
+func (m *ReadAllRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadAllRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ReadAllRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ReadAllRequest) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (m *ReadAllRequest) GetReminderAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReminderAfter
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetReminderBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReminderBefore
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetDueAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.DueAfter
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetDueBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.DueBefore
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *ReadAllRequest) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+func (m *ReadAllRequest) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *ReadAllRequest) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ReadAllRequest) GetLabelMatch() ReadAllRequest_LabelMatch {
+	if m != nil {
+		return m.LabelMatch
+	}
+	return ReadAllRequest_LABEL_MATCH_UNSPECIFIED
+}
+
+func (m *ReadAllRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
+// Contains list of all todo tasks
+type ReadAllResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// List of all todo tasks
+	ToDos []*ToDo `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// Token to read the next page, it is empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadAllResponse) Reset()         { *m = ReadAllResponse{} }
+func (m *ReadAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadAllResponse) ProtoMessage()    {}
+func (*ReadAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{23}
+}
+
+func (m *ReadAllResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadAllResponse.Unmarshal(m, b)
+}
+func (m *ReadAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadAllResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadAllResponse.Merge(m, src)
+}
+func (m *ReadAllResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadAllResponse.Size(m)
+}
+func (m *ReadAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadAllResponse proto.InternalMessageInfo
+
+func (m *ReadAllResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadAllResponse) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+func (m *ReadAllResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// Request data to list completions of todo task
+type ListCompletionsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCompletionsRequest) Reset()         { *m = ListCompletionsRequest{} }
+func (m *ListCompletionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCompletionsRequest) ProtoMessage()    {}
+func (*ListCompletionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{24}
+}
+
+func (m *ListCompletionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompletionsRequest.Unmarshal(m, b)
+}
+func (m *ListCompletionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompletionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCompletionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompletionsRequest.Merge(m, src)
+}
+func (m *ListCompletionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCompletionsRequest.Size(m)
+}
+func (m *ListCompletionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompletionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompletionsRequest proto.InternalMessageInfo
+
+func (m *ListCompletionsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListCompletionsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Period the todo task stayed completed
+type Completion struct {
+	// Date and time the task was completed
+	CompletedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Date and time the task was reopened, it is not set while the task is completed
+	ReopenedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at,omitempty"`
+	// Time from creation of the task to its completion
+	// It is not set when creation time of the task is unknown
+	CycleTime            *duration.Duration `protobuf:"bytes,3,opt,name=cycle_time,json=cycleTime,proto3" json:"cycle_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Completion) Reset()         { *m = Completion{} }
+func (m *Completion) String() string { return proto.CompactTextString(m) }
+func (*Completion) ProtoMessage()    {}
+func (*Completion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{25}
+}
+
+func (m *Completion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Completion.Unmarshal(m, b)
+}
+func (m *Completion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Completion.Marshal(b, m, deterministic)
+}
+func (m *Completion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Completion.Merge(m, src)
+}
+func (m *Completion) XXX_Size() int {
+	return xxx_messageInfo_Completion.Size(m)
+}
+func (m *Completion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Completion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Completion proto.InternalMessageInfo
+
+func (m *Completion) GetCompletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return nil
+}
+
+func (m *Completion) GetReopenedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReopenedAt
+	}
+	return nil
+}
+
+func (m *Completion) GetCycleTime() *duration.Duration {
+	if m != nil {
+		return m.CycleTime
+	}
+	return nil
+}
+
+// Contains completion history of todo task
+type ListCompletionsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Completions of the task from the oldest to the latest
+	Completions          []*Completion `protobuf:"bytes,2,rep,name=completions,proto3" json:"completions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListCompletionsResponse) Reset()         { *m = ListCompletionsResponse{} }
+func (m *ListCompletionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCompletionsResponse) ProtoMessage()    {}
+func (*ListCompletionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{26}
+}
+
+func (m *ListCompletionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompletionsResponse.Unmarshal(m, b)
+}
+func (m *ListCompletionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompletionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCompletionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompletionsResponse.Merge(m, src)
+}
+func (m *ListCompletionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCompletionsResponse.Size(m)
+}
+func (m *ListCompletionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompletionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompletionsResponse proto.InternalMessageInfo
+
+func (m *ListCompletionsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListCompletionsResponse) GetCompletions() []*Completion {
+	if m != nil {
+		return m.Completions
+	}
+	return nil
+}
+
+// Request data to expand occurrences of todo task
+type ListOccurrencesRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Return occurrences at or after the time, current time is used when it is not set
+	StartTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Return occurrences before the time, 30 days after start_time is used when it is not set
+	EndTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum number of occurrences to return
+	// Server uses 100 when it is not set and never returns more than 1000
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOccurrencesRequest) Reset()         { *m = ListOccurrencesRequest{} }
+func (m *ListOccurrencesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOccurrencesRequest) ProtoMessage()    {}
+func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{27}
+}
+
+func (m *ListOccurrencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOccurrencesRequest.Unmarshal(m, b)
+}
+func (m *ListOccurrencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOccurrencesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListOccurrencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOccurrencesRequest.Merge(m, src)
+}
+func (m *ListOccurrencesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOccurrencesRequest.Size(m)
+}
+func (m *ListOccurrencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOccurrencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOccurrencesRequest proto.InternalMessageInfo
+
+func (m *ListOccurrencesRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListOccurrencesRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ListOccurrencesRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ListOccurrencesRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ListOccurrencesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Occurrence of recurring todo task
+type Occurrence struct {
+	// Estimated date and time of completion of the occurrence
+	EstimatedTimeOfCompletion *timestamp.Timestamp `protobuf:"bytes,1,opt,name=estimatedTimeOfCompletion,proto3" json:"estimatedTimeOfCompletion,omitempty"`
+	// Date and time to remind the occurrence
+	Reminder             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Occurrence) Reset()         { *m = Occurrence{} }
+func (m *Occurrence) String() string { return proto.CompactTextString(m) }
+func (*Occurrence) ProtoMessage()    {}
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{28}
+}
+
+func (m *Occurrence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Occurrence.Unmarshal(m, b)
+}
+func (m *Occurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Occurrence.Marshal(b, m, deterministic)
+}
+func (m *Occurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Occurrence.Merge(m, src)
+}
+func (m *Occurrence) XXX_Size() int {
+	return xxx_messageInfo_Occurrence.Size(m)
+}
+func (m *Occurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Occurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Occurrence proto.InternalMessageInfo
+
+func (m *Occurrence) GetEstimatedTimeOfCompletion() *timestamp.Timestamp {
+	if m != nil {
+		return m.EstimatedTimeOfCompletion
+	}
+	return nil
+}
+
+func (m *Occurrence) GetReminder() *timestamp.Timestamp {
+	if m != nil {
+		return m.Reminder
+	}
+	return nil
+}
+
+// Contains occurrences of todo task
+type ListOccurrencesResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Occurrences of the task in the time window from the earliest
+	Occurrences          []*Occurrence `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListOccurrencesResponse) Reset()         { *m = ListOccurrencesResponse{} }
+func (m *ListOccurrencesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOccurrencesResponse) ProtoMessage()    {}
+func (*ListOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{29}
+}
+
+func (m *ListOccurrencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOccurrencesResponse.Unmarshal(m, b)
+}
+func (m *ListOccurrencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOccurrencesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOccurrencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOccurrencesResponse.Merge(m, src)
+}
+func (m *ListOccurrencesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOccurrencesResponse.Size(m)
+}
+func (m *ListOccurrencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOccurrencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOccurrencesResponse proto.InternalMessageInfo
+
+func (m *ListOccurrencesResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListOccurrencesResponse) GetOccurrences() []*Occurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
+// Request data to list change history of todo task
+type ListHistoryRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHistoryRequest) Reset()         { *m = ListHistoryRequest{} }
+func (m *ListHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryRequest) ProtoMessage()    {}
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{30}
+}
+
+func (m *ListHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryRequest.Merge(m, src)
+}
+func (m *ListHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryRequest.Size(m)
+}
+func (m *ListHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryRequest proto.InternalMessageInfo
+
+func (m *ListHistoryRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListHistoryRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Change of one field of todo task, values are formatted as in JSON representation of the task
+// e.g. "2020-05-20T10:00:00Z" for times and "home,work" for labels, unset value is empty
+type FieldChange struct {
+	// Name of the changed field e.g. "estimatedTimeOfCompletion"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Value of the field before the change
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// Value of the field after the change
+	After                string   `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldChange) Reset()         { *m = FieldChange{} }
+func (m *FieldChange) String() string { return proto.CompactTextString(m) }
+func (*FieldChange) ProtoMessage()    {}
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{31}
+}
+
+func (m *FieldChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldChange.Unmarshal(m, b)
+}
+func (m *FieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldChange.Marshal(b, m, deterministic)
+}
+func (m *FieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldChange.Merge(m, src)
+}
+func (m *FieldChange) XXX_Size() int {
+	return xxx_messageInfo_FieldChange.Size(m)
+}
+func (m *FieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldChange proto.InternalMessageInfo
+
+func (m *FieldChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldChange) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *FieldChange) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+// Immutable record of a change of todo task
+type HistoryEntry struct {
+	// Unique integer identifier of the entry, later entries have greater ones
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of change
+	Action HistoryEntry_Action `protobuf:"varint,2,opt,name=action,proto3,enum=v1.HistoryEntry_Action" json:"action,omitempty"`
+	// Changed fields of the task, the first recorded entry of the task changes all its set fields
+	Changes []*FieldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Caller who made the change, "anonymous" when the caller is not known
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// ID of the request which made the change e.g. the one assigned by HTTP gateway
+	RequestId string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Date and time of the change
+	Time                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HistoryEntry) Reset()         { *m = HistoryEntry{} }
+func (m *HistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryEntry) ProtoMessage()    {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{32}
+}
+
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryEntry.Unmarshal(m, b)
+}
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
+}
+func (m *HistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_HistoryEntry.Size(m)
+}
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
+
+func (m *HistoryEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetAction() HistoryEntry_Action {
+	if m != nil {
+		return m.Action
+	}
+	return HistoryEntry_ACTION_UNSPECIFIED
+}
+
+func (m *HistoryEntry) GetChanges() []*FieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// Contains change history of todo task
+type ListHistoryResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Changes of the task from the oldest to the latest
+	Entries              []*HistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListHistoryResponse) Reset()         { *m = ListHistoryResponse{} }
+func (m *ListHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryResponse) ProtoMessage()    {}
+func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{33}
+}
+
+func (m *ListHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryResponse.Merge(m, src)
+}
+func (m *ListHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryResponse.Size(m)
+}
+func (m *ListHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryResponse proto.InternalMessageInfo
+
+func (m *ListHistoryResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListHistoryResponse) GetEntries() []*HistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// Request data to list subtasks of todo task
+type ListChildrenRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the parent todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Return subtasks of subtasks too
+	Recursive            bool     `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChildrenRequest) Reset()         { *m = ListChildrenRequest{} }
+func (m *ListChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChildrenRequest) ProtoMessage()    {}
+func (*ListChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{34}
+}
+
+func (m *ListChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChildrenRequest.Unmarshal(m, b)
+}
+func (m *ListChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChildrenRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChildrenRequest.Merge(m, src)
+}
+func (m *ListChildrenRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChildrenRequest.Size(m)
+}
+func (m *ListChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChildrenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChildrenRequest proto.InternalMessageInfo
+
+func (m *ListChildrenRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListChildrenRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ListChildrenRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+// Contains subtasks of todo task
+type ListChildrenResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Subtasks in breadth-first order, subtasks of the same parent are sorted by ID
+	ToDos                []*ToDo  `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChildrenResponse) Reset()         { *m = ListChildrenResponse{} }
+func (m *ListChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChildrenResponse) ProtoMessage()    {}
+func (*ListChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{35}
+}
+
+func (m *ListChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChildrenResponse.Unmarshal(m, b)
+}
+func (m *ListChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChildrenResponse.Marshal(b, m, deterministic)
+}
+func (m *ListChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChildrenResponse.Merge(m, src)
+}
+func (m *ListChildrenResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChildrenResponse.Size(m)
+}
+func (m *ListChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChildrenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChildrenResponse proto.InternalMessageInfo
+
+func (m *ListChildrenResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListChildrenResponse) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+// Request data to move todo task together with its subtasks
+type MoveRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task to move
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the new parent todo task, 0 makes the task top level task
+	ParentId             int64    `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveRequest) Reset()         { *m = MoveRequest{} }
+func (m *MoveRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveRequest) ProtoMessage()    {}
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{36}
+}
+
+func (m *MoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveRequest.Unmarshal(m, b)
+}
+func (m *MoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveRequest.Merge(m, src)
+}
+func (m *MoveRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveRequest.Size(m)
+}
+func (m *MoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveRequest proto.InternalMessageInfo
+
+func (m *MoveRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoveRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MoveRequest) GetParentId() int64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+// Contains status of move operation
+type MoveResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of moved tasks including subtasks moved together with the task
+	Moved                int64    `protobuf:"varint,2,opt,name=moved,proto3" json:"moved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveResponse) Reset()         { *m = MoveResponse{} }
+func (m *MoveResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveResponse) ProtoMessage()    {}
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{37}
+}
+
+func (m *MoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResponse.Unmarshal(m, b)
+}
+func (m *MoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResponse.Merge(m, src)
+}
+func (m *MoveResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveResponse.Size(m)
+}
+func (m *MoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResponse proto.InternalMessageInfo
+
+func (m *MoveResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoveResponse) GetMoved() int64 {
+	if m != nil {
+		return m.Moved
+	}
+	return 0
+}
+
+// Request data to compute progress of todo task
+type GetProgressRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProgressRequest) Reset()         { *m = GetProgressRequest{} }
+func (m *GetProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProgressRequest) ProtoMessage()    {}
+func (*GetProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{38}
+}
+
+func (m *GetProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProgressRequest.Unmarshal(m, b)
+}
+func (m *GetProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProgressRequest.Merge(m, src)
+}
+func (m *GetProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProgressRequest.Size(m)
+}
+func (m *GetProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProgressRequest proto.InternalMessageInfo
+
+func (m *GetProgressRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GetProgressRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains roll-up progress of todo task
+type GetProgressResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Number of subtasks at all levels, cancelled subtasks are not counted
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of completed subtasks at all levels
+	Done int64 `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	// Percentage of completed subtasks, task without subtasks is 100 when it is completed itself and 0 otherwise
+	Percent              float64  `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProgressResponse) Reset()         { *m = GetProgressResponse{} }
+func (m *GetProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProgressResponse) ProtoMessage()    {}
+func (*GetProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{39}
+}
+
+func (m *GetProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProgressResponse.Unmarshal(m, b)
+}
+func (m *GetProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProgressResponse.Merge(m, src)
+}
+func (m *GetProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProgressResponse.Size(m)
+}
+func (m *GetProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProgressResponse proto.InternalMessageInfo
+
+func (m *GetProgressResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GetProgressResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetProgressResponse) GetDone() int64 {
+	if m != nil {
+		return m.Done
+	}
+	return 0
+}
+
+func (m *GetProgressResponse) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+// Request data to make todo task depend on another todo task
+type AddDependencyRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the dependent todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique integer identifier of the prerequisite todo task which must be completed first
+	DependsOnId          int64    `protobuf:"varint,3,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDependencyRequest) Reset()         { *m = AddDependencyRequest{} }
+func (m *AddDependencyRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDependencyRequest) ProtoMessage()    {}
+func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{40}
+}
+
+func (m *AddDependencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDependencyRequest.Unmarshal(m, b)
+}
+func (m *AddDependencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDependencyRequest.Marshal(b, m, deterministic)
+}
+func (m *AddDependencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDependencyRequest.Merge(m, src)
+}
+func (m *AddDependencyRequest) XXX_Size() int {
+	return xxx_messageInfo_AddDependencyRequest.Size(m)
+}
+func (m *AddDependencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDependencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDependencyRequest proto.InternalMessageInfo
+
+func (m *AddDependencyRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *AddDependencyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AddDependencyRequest) GetDependsOnId() int64 {
+	if m != nil {
+		return m.DependsOnId
+	}
+	return 0
+}
+
+// Contains status of add dependency operation
+type AddDependencyResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDependencyResponse) Reset()         { *m = AddDependencyResponse{} }
+func (m *AddDependencyResponse) String() string { return proto.CompactTextString(m) }
+func (*AddDependencyResponse) ProtoMessage()    {}
+func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{41}
+}
+
+func (m *AddDependencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDependencyResponse.Unmarshal(m, b)
+}
+func (m *AddDependencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDependencyResponse.Marshal(b, m, deterministic)
+}
+func (m *AddDependencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDependencyResponse.Merge(m, src)
+}
+func (m *AddDependencyResponse) XXX_Size() int {
+	return xxx_messageInfo_AddDependencyResponse.Size(m)
+}
+func (m *AddDependencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDependencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDependencyResponse proto.InternalMessageInfo
+
+func (m *AddDependencyResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Request data to remove dependency of todo task on another todo task
+type RemoveDependencyRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the dependent todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique integer identifier of the prerequisite todo task
+	DependsOnId          int64    `protobuf:"varint,3,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDependencyRequest) Reset()         { *m = RemoveDependencyRequest{} }
+func (m *RemoveDependencyRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDependencyRequest) ProtoMessage()    {}
+func (*RemoveDependencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{42}
+}
+
+func (m *RemoveDependencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDependencyRequest.Unmarshal(m, b)
+}
+func (m *RemoveDependencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDependencyRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveDependencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDependencyRequest.Merge(m, src)
+}
+func (m *RemoveDependencyRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDependencyRequest.Size(m)
+}
+func (m *RemoveDependencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDependencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDependencyRequest proto.InternalMessageInfo
+
+func (m *RemoveDependencyRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *RemoveDependencyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RemoveDependencyRequest) GetDependsOnId() int64 {
+	if m != nil {
+		return m.DependsOnId
+	}
+	return 0
+}
+
+// Contains status of remove dependency operation
+type RemoveDependencyResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDependencyResponse) Reset()         { *m = RemoveDependencyResponse{} }
+func (m *RemoveDependencyResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDependencyResponse) ProtoMessage()    {}
+func (*RemoveDependencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{43}
+}
+
+func (m *RemoveDependencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDependencyResponse.Unmarshal(m, b)
+}
+func (m *RemoveDependencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDependencyResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveDependencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDependencyResponse.Merge(m, src)
+}
+func (m *RemoveDependencyResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveDependencyResponse.Size(m)
+}
+func (m *RemoveDependencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDependencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDependencyResponse proto.InternalMessageInfo
+
+func (m *RemoveDependencyResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Dependency of todo task on a prerequisite todo task
+type Dependency struct {
+	// Unique integer identifier of the dependent todo task
+	ToDoId int64 `protobuf:"varint,1,opt,name=to_do_id,json=toDoId,proto3" json:"to_do_id,omitempty"`
+	// Unique integer identifier of the prerequisite todo task
+	DependsOnId          int64    `protobuf:"varint,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Dependency) Reset()         { *m = Dependency{} }
+func (m *Dependency) String() string { return proto.CompactTextString(m) }
+func (*Dependency) ProtoMessage()    {}
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{44}
+}
+
+func (m *Dependency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dependency.Unmarshal(m, b)
+}
+func (m *Dependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dependency.Marshal(b, m, deterministic)
+}
+func (m *Dependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dependency.Merge(m, src)
+}
+func (m *Dependency) XXX_Size() int {
+	return xxx_messageInfo_Dependency.Size(m)
+}
+func (m *Dependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dependency proto.InternalMessageInfo
+
+func (m *Dependency) GetToDoId() int64 {
+	if m != nil {
+		return m.ToDoId
+	}
+	return 0
+}
+
+func (m *Dependency) GetDependsOnId() int64 {
+	if m != nil {
+		return m.DependsOnId
+	}
+	return 0
+}
+
+// Request data to list todo tasks in dependency order
+type ListTopologicalRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// List only tasks of the project
+	ProjectId            int64    `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTopologicalRequest) Reset()         { *m = ListTopologicalRequest{} }
+func (m *ListTopologicalRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTopologicalRequest) ProtoMessage()    {}
+func (*ListTopologicalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{45}
+}
+
+func (m *ListTopologicalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTopologicalRequest.Unmarshal(m, b)
+}
+func (m *ListTopologicalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTopologicalRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTopologicalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopologicalRequest.Merge(m, src)
+}
+func (m *ListTopologicalRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTopologicalRequest.Size(m)
+}
+func (m *ListTopologicalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopologicalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTopologicalRequest proto.InternalMessageInfo
+
+func (m *ListTopologicalRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListTopologicalRequest) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+// Contains todo tasks in dependency order
+type ListTopologicalResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tasks ordered so that every task follows its prerequisites,
+	// tasks which can go in any order are sorted by ID
+	ToDos []*ToDo `protobuf:"bytes,2,rep,name=toDos,proto3" json:"toDos,omitempty"`
+	// Dependencies between the listed tasks
+	Dependencies         []*Dependency `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListTopologicalResponse) Reset()         { *m = ListTopologicalResponse{} }
+func (m *ListTopologicalResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTopologicalResponse) ProtoMessage()    {}
+func (*ListTopologicalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{46}
+}
+
+func (m *ListTopologicalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTopologicalResponse.Unmarshal(m, b)
+}
+func (m *ListTopologicalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTopologicalResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTopologicalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopologicalResponse.Merge(m, src)
+}
+func (m *ListTopologicalResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTopologicalResponse.Size(m)
+}
+func (m *ListTopologicalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopologicalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTopologicalResponse proto.InternalMessageInfo
+
+func (m *ListTopologicalResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListTopologicalResponse) GetToDos() []*ToDo {
+	if m != nil {
+		return m.ToDos
+	}
+	return nil
+}
+
+func (m *ListTopologicalResponse) GetDependencies() []*Dependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+// Request data to add labels to todo task
+type AddLabelsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Labels to add, labels the task already has are ignored
+	Labels               []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddLabelsRequest) Reset()         { *m = AddLabelsRequest{} }
+func (m *AddLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddLabelsRequest) ProtoMessage()    {}
+func (*AddLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{47}
+}
+
+func (m *AddLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddLabelsRequest.Unmarshal(m, b)
+}
+func (m *AddLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddLabelsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLabelsRequest.Merge(m, src)
+}
+func (m *AddLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddLabelsRequest.Size(m)
+}
+func (m *AddLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddLabelsRequest proto.InternalMessageInfo
+
+func (m *AddLabelsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *AddLabelsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AddLabelsRequest) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Contains labels of todo task after they are added
+type AddLabelsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// All labels of the task sorted by name
+	Labels               []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddLabelsResponse) Reset()         { *m = AddLabelsResponse{} }
+func (m *AddLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*AddLabelsResponse) ProtoMessage()    {}
+func (*AddLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{48}
+}
+
+func (m *AddLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddLabelsResponse.Unmarshal(m, b)
+}
+func (m *AddLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddLabelsResponse.Marshal(b, m, deterministic)
+}
+func (m *AddLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLabelsResponse.Merge(m, src)
+}
+func (m *AddLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_AddLabelsResponse.Size(m)
+}
+func (m *AddLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddLabelsResponse proto.InternalMessageInfo
+
+func (m *AddLabelsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *AddLabelsResponse) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Request data to remove labels from todo task
+type RemoveLabelsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Labels to remove, labels the task does not have are ignored
+	Labels               []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveLabelsRequest) Reset()         { *m = RemoveLabelsRequest{} }
+func (m *RemoveLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveLabelsRequest) ProtoMessage()    {}
+func (*RemoveLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{49}
+}
+
+func (m *RemoveLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveLabelsRequest.Unmarshal(m, b)
+}
+func (m *RemoveLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveLabelsRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLabelsRequest.Merge(m, src)
+}
+func (m *RemoveLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveLabelsRequest.Size(m)
+}
+func (m *RemoveLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLabelsRequest proto.InternalMessageInfo
+
+func (m *RemoveLabelsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *RemoveLabelsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RemoveLabelsRequest) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Contains labels of todo task after they are removed
+type RemoveLabelsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// All labels of the task sorted by name
+	Labels               []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveLabelsResponse) Reset()         { *m = RemoveLabelsResponse{} }
+func (m *RemoveLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveLabelsResponse) ProtoMessage()    {}
+func (*RemoveLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{50}
+}
+
+func (m *RemoveLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveLabelsResponse.Unmarshal(m, b)
+}
+func (m *RemoveLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveLabelsResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLabelsResponse.Merge(m, src)
+}
+func (m *RemoveLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveLabelsResponse.Size(m)
+}
+func (m *RemoveLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLabelsResponse proto.InternalMessageInfo
+
+func (m *RemoveLabelsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *RemoveLabelsResponse) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Request data to list labels
+type ListLabelsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Count only tasks of the project
+	ProjectId            int64    `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLabelsRequest) Reset()         { *m = ListLabelsRequest{} }
+func (m *ListLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLabelsRequest) ProtoMessage()    {}
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{51}
+}
+
+func (m *ListLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLabelsRequest.Unmarshal(m, b)
+}
+func (m *ListLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLabelsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLabelsRequest.Merge(m, src)
+}
+func (m *ListLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLabelsRequest.Size(m)
+}
+func (m *ListLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLabelsRequest proto.InternalMessageInfo
+
+func (m *ListLabelsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListLabelsRequest) GetProjectId() int64 {
+	if m != nil {
+		return m.ProjectId
+	}
+	return 0
+}
+
+// Label and number of todo tasks having it
+type LabelCount struct {
+	// Name of the label
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of tasks having the label
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelCount) Reset()         { *m = LabelCount{} }
+func (m *LabelCount) String() string { return proto.CompactTextString(m) }
+func (*LabelCount) ProtoMessage()    {}
+func (*LabelCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{52}
+}
+
+func (m *LabelCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelCount.Unmarshal(m, b)
+}
+func (m *LabelCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelCount.Marshal(b, m, deterministic)
+}
+func (m *LabelCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelCount.Merge(m, src)
+}
+func (m *LabelCount) XXX_Size() int {
+	return xxx_messageInfo_LabelCount.Size(m)
+}
+func (m *LabelCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelCount proto.InternalMessageInfo
+
+func (m *LabelCount) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LabelCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Contains labels in use
+type ListLabelsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Labels of at least one task sorted by name
+	Labels               []*LabelCount `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListLabelsResponse) Reset()         { *m = ListLabelsResponse{} }
+func (m *ListLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLabelsResponse) ProtoMessage()    {}
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{53}
+}
+
+func (m *ListLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLabelsResponse.Unmarshal(m, b)
+}
+func (m *ListLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLabelsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLabelsResponse.Merge(m, src)
+}
+func (m *ListLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLabelsResponse.Size(m)
+}
+func (m *ListLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLabelsResponse proto.InternalMessageInfo
+
+func (m *ListLabelsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListLabelsResponse) GetLabels() []*LabelCount {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// Request data to search todo tasks
+type SearchRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Words to search for in title and description, a task must match all of them
+	// "quoted words" match the phrase, word* matches words starting with word
+	Q string `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	// Maximum number of tasks to return
+	// Server uses 20 when it is not set and never returns more than 100
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Text to put before every match in highlights, it is <em> when not set
+	PreTag string `protobuf:"bytes,4,opt,name=pre_tag,json=preTag,proto3" json:"pre_tag,omitempty"`
+	// Text to put after every match in highlights, it is </em> when not set
+	PostTag              string   `protobuf:"bytes,5,opt,name=post_tag,json=postTag,proto3" json:"post_tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{54}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetQ() string {
+	if m != nil {
+		return m.Q
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetPreTag() string {
+	if m != nil {
+		return m.PreTag
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPostTag() string {
+	if m != nil {
+		return m.PostTag
+	}
+	return ""
+}
+
+// Todo task matching the search
+type SearchResult struct {
+	// Matching task entity
+	ToDo *ToDo `protobuf:"bytes,1,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Relevance of the task, better matches have higher score
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Title of the task with matches highlighted
+	TitleHighlight string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	// Part of the description around the first match with matches highlighted
+	DescriptionSnippet   string   `protobuf:"bytes,4,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{55}
+}
+
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResult.Unmarshal(m, b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return xxx_messageInfo_SearchResult.Size(m)
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *SearchResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchResult) GetTitleHighlight() string {
+	if m != nil {
+		return m.TitleHighlight
+	}
+	return ""
+}
+
+func (m *SearchResult) GetDescriptionSnippet() string {
+	if m != nil {
+		return m.DescriptionSnippet
+	}
+	return ""
+}
+
+// Contains todo tasks matching the search
+type SearchResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Matching tasks, the best match first
+	Results []*SearchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// Number of all matching tasks, it is greater than number of results when they are cut by page_size
+	TotalSize            int32    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{56}
+}
+
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+// Access of a user other than the owner to todo task
+type Share struct {
+	// User the task is shared with
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// What the user may do with the task
+	Role                 ShareRole `protobuf:"varint,2,opt,name=role,proto3,enum=v1.ShareRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Share) Reset()         { *m = Share{} }
+func (m *Share) String() string { return proto.CompactTextString(m) }
+func (*Share) ProtoMessage()    {}
+func (*Share) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{57}
+}
+
+func (m *Share) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Share.Unmarshal(m, b)
+}
+func (m *Share) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Share.Marshal(b, m, deterministic)
+}
+func (m *Share) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Share.Merge(m, src)
+}
+func (m *Share) XXX_Size() int {
+	return xxx_messageInfo_Share.Size(m)
+}
+func (m *Share) XXX_DiscardUnknown() {
+	xxx_messageInfo_Share.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Share proto.InternalMessageInfo
+
+func (m *Share) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Share) GetRole() ShareRole {
+	if m != nil {
+		return m.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+// Request data to share todo task with a user
+type ShareRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// User to share the task with and the role, role of already shared task is replaced
+	Share                *Share   `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareRequest) Reset()         { *m = ShareRequest{} }
+func (m *ShareRequest) String() string { return proto.CompactTextString(m) }
+func (*ShareRequest) ProtoMessage()    {}
+func (*ShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{58}
+}
+
+func (m *ShareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareRequest.Unmarshal(m, b)
+}
+func (m *ShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareRequest.Marshal(b, m, deterministic)
+}
+func (m *ShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareRequest.Merge(m, src)
+}
+func (m *ShareRequest) XXX_Size() int {
+	return xxx_messageInfo_ShareRequest.Size(m)
+}
+func (m *ShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareRequest proto.InternalMessageInfo
+
+func (m *ShareRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ShareRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ShareRequest) GetShare() *Share {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+// Contains shares of todo task after it is shared
+type ShareResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// All shares of the task sorted by user
+	Shares               []*Share `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareResponse) Reset()         { *m = ShareResponse{} }
+func (m *ShareResponse) String() string { return proto.CompactTextString(m) }
+func (*ShareResponse) ProtoMessage()    {}
+func (*ShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{59}
+}
+
+func (m *ShareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareResponse.Unmarshal(m, b)
+}
+func (m *ShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareResponse.Marshal(b, m, deterministic)
+}
+func (m *ShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareResponse.Merge(m, src)
+}
+func (m *ShareResponse) XXX_Size() int {
+	return xxx_messageInfo_ShareResponse.Size(m)
+}
+func (m *ShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareResponse proto.InternalMessageInfo
+
+func (m *ShareResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ShareResponse) GetShares() []*Share {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// Request data to stop sharing todo task with a user
+type UnshareRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// User to revoke access of, users may revoke their own access
+	User                 string   `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnshareRequest) Reset()         { *m = UnshareRequest{} }
+func (m *UnshareRequest) String() string { return proto.CompactTextString(m) }
+func (*UnshareRequest) ProtoMessage()    {}
+func (*UnshareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{60}
+}
+
+func (m *UnshareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnshareRequest.Unmarshal(m, b)
+}
+func (m *UnshareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnshareRequest.Marshal(b, m, deterministic)
+}
+func (m *UnshareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnshareRequest.Merge(m, src)
+}
+func (m *UnshareRequest) XXX_Size() int {
+	return xxx_messageInfo_UnshareRequest.Size(m)
+}
+func (m *UnshareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnshareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnshareRequest proto.InternalMessageInfo
+
+func (m *UnshareRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UnshareRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnshareRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// Contains shares of todo task after the access is revoked
+type UnshareResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Remaining shares of the task sorted by user, empty for the user who revoked their own access
+	Shares               []*Share `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnshareResponse) Reset()         { *m = UnshareResponse{} }
+func (m *UnshareResponse) String() string { return proto.CompactTextString(m) }
+func (*UnshareResponse) ProtoMessage()    {}
+func (*UnshareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{61}
+}
+
+func (m *UnshareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnshareResponse.Unmarshal(m, b)
+}
+func (m *UnshareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnshareResponse.Marshal(b, m, deterministic)
+}
+func (m *UnshareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnshareResponse.Merge(m, src)
+}
+func (m *UnshareResponse) XXX_Size() int {
+	return xxx_messageInfo_UnshareResponse.Size(m)
+}
+func (m *UnshareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnshareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnshareResponse proto.InternalMessageInfo
+
+func (m *UnshareResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UnshareResponse) GetShares() []*Share {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// Request data to list shares of todo task
+type ListSharesRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the todo task
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSharesRequest) Reset()         { *m = ListSharesRequest{} }
+func (m *ListSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSharesRequest) ProtoMessage()    {}
+func (*ListSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{62}
+}
+
+func (m *ListSharesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSharesRequest.Unmarshal(m, b)
+}
+func (m *ListSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSharesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSharesRequest.Merge(m, src)
+}
+func (m *ListSharesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSharesRequest.Size(m)
+}
+func (m *ListSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSharesRequest proto.InternalMessageInfo
+
+func (m *ListSharesRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListSharesRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains shares of todo task
+type ListSharesResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Owner of the task
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Shares of the task sorted by user
+	Shares               []*Share `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSharesResponse) Reset()         { *m = ListSharesResponse{} }
+func (m *ListSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSharesResponse) ProtoMessage()    {}
+func (*ListSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{63}
+}
+
+func (m *ListSharesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSharesResponse.Unmarshal(m, b)
+}
+func (m *ListSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSharesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSharesResponse.Merge(m, src)
+}
+func (m *ListSharesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSharesResponse.Size(m)
+}
+func (m *ListSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSharesResponse proto.InternalMessageInfo
+
+func (m *ListSharesResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListSharesResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ListSharesResponse) GetShares() []*Share {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// Request data to create new project
+type CreateProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity to add
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectRequest) Reset()         { *m = CreateProjectRequest{} }
+func (m *CreateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectRequest) ProtoMessage()    {}
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{64}
+}
+
+func (m *CreateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectRequest.Unmarshal(m, b)
+}
+func (m *CreateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectRequest.Merge(m, src)
+}
+func (m *CreateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectRequest.Size(m)
+}
+func (m *CreateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectRequest proto.InternalMessageInfo
+
+func (m *CreateProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateProjectRequest) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Contains data of created project
+type CreateProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// ID of created project
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectResponse) Reset()         { *m = CreateProjectResponse{} }
+func (m *CreateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectResponse) ProtoMessage()    {}
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{65}
+}
+
+func (m *CreateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectResponse.Unmarshal(m, b)
+}
+func (m *CreateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectResponse.Merge(m, src)
+}
+func (m *CreateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectResponse.Size(m)
+}
+func (m *CreateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectResponse proto.InternalMessageInfo
+
+func (m *CreateProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateProjectResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Request data to read project
+type ReadProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the project
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadProjectRequest) Reset()         { *m = ReadProjectRequest{} }
+func (m *ReadProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadProjectRequest) ProtoMessage()    {}
+func (*ReadProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{66}
+}
+
+func (m *ReadProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadProjectRequest.Unmarshal(m, b)
+}
+func (m *ReadProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadProjectRequest.Merge(m, src)
+}
+func (m *ReadProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadProjectRequest.Size(m)
+}
+func (m *ReadProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadProjectRequest proto.InternalMessageInfo
+
+func (m *ReadProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadProjectRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Contains project data specified by ID
+type ReadProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity read by ID
+	Project              *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadProjectResponse) Reset()         { *m = ReadProjectResponse{} }
+func (m *ReadProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadProjectResponse) ProtoMessage()    {}
+func (*ReadProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{67}
+}
+
+func (m *ReadProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadProjectResponse.Unmarshal(m, b)
+}
+func (m *ReadProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadProjectResponse.Merge(m, src)
+}
+func (m *ReadProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadProjectResponse.Size(m)
+}
+func (m *ReadProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadProjectResponse proto.InternalMessageInfo
+
+func (m *ReadProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ReadProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// Request data to update project
+type UpdateProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Project entity to update
+	Project *Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// Fields of the project to update e.g. "name,archived"
+	// All fields are replaced when the mask is empty
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateProjectRequest) Reset()         { *m = UpdateProjectRequest{} }
+func (m *UpdateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectRequest) ProtoMessage()    {}
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{68}
+}
+
+func (m *UpdateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectRequest.Unmarshal(m, b)
+}
+func (m *UpdateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectRequest.Merge(m, src)
+}
+func (m *UpdateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectRequest.Size(m)
+}
+func (m *UpdateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectRequest proto.InternalMessageInfo
+
+func (m *UpdateProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateProjectRequest) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *UpdateProjectRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// Contains status of project update operation
+type UpdateProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of projects have been updated
+	Updated              int64    `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProjectResponse) Reset()         { *m = UpdateProjectResponse{} }
+func (m *UpdateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectResponse) ProtoMessage()    {}
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{69}
+}
+
+func (m *UpdateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectResponse.Unmarshal(m, b)
+}
+func (m *UpdateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectResponse.Merge(m, src)
+}
+func (m *UpdateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectResponse.Size(m)
+}
+func (m *UpdateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectResponse proto.InternalMessageInfo
+
+func (m *UpdateProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateProjectResponse) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+// Request data to delete project
+type DeleteProjectRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique integer identifier of the project to delete
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to the tasks of the project
+	Mode                 DeleteProjectRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=v1.DeleteProjectRequest_Mode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DeleteProjectRequest) Reset()         { *m = DeleteProjectRequest{} }
+func (m *DeleteProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectRequest) ProtoMessage()    {}
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{70}
+}
+
+func (m *DeleteProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectRequest.Unmarshal(m, b)
+}
+func (m *DeleteProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectRequest.Merge(m, src)
+}
+func (m *DeleteProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectRequest.Size(m)
+}
+func (m *DeleteProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectRequest proto.InternalMessageInfo
+
+func (m *DeleteProjectRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteProjectRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeleteProjectRequest) GetMode() DeleteProjectRequest_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return DeleteProjectRequest_MODE_UNSPECIFIED
+}
+
+// Contains status of project delete operation
+type DeleteProjectResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of projects have been deleted, it is 0 when the project is archived
+	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Contains number of tasks have been deleted with the project
+	DeletedTasks int64 `protobuf:"varint,3,opt,name=deleted_tasks,json=deletedTasks,proto3" json:"deleted_tasks,omitempty"`
+	// Project is archived instead of deleting
+	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProjectResponse) Reset()         { *m = DeleteProjectResponse{} }
+func (m *DeleteProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectResponse) ProtoMessage()    {}
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{71}
+}
+
+func (m *DeleteProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectResponse.Unmarshal(m, b)
+}
+func (m *DeleteProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectResponse.Merge(m, src)
+}
+func (m *DeleteProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectResponse.Size(m)
+}
+func (m *DeleteProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectResponse proto.InternalMessageInfo
+
+func (m *DeleteProjectResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteProjectResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *DeleteProjectResponse) GetDeletedTasks() int64 {
+	if m != nil {
+		return m.DeletedTasks
+	}
+	return 0
+}
+
+func (m *DeleteProjectResponse) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+// Request data to list projects
+type ListProjectsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Return archived projects too
+	ShowArchived         bool     `protobuf:"varint,2,opt,name=show_archived,json=showArchived,proto3" json:"show_archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
+func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsRequest) ProtoMessage()    {}
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{72}
+}
+
+func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
+}
+func (m *ListProjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsRequest.Merge(m, src)
+}
+func (m *ListProjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsRequest.Size(m)
+}
+func (m *ListProjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsRequest proto.InternalMessageInfo
+
+func (m *ListProjectsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListProjectsRequest) GetShowArchived() bool {
+	if m != nil {
+		return m.ShowArchived
+	}
+	return false
+}
+
+// Contains list of projects
+type ListProjectsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Projects sorted by name
+	Projects             []*Project `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
+func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsResponse) ProtoMessage()    {}
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{73}
+}
+
+func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
+}
+func (m *ListProjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsResponse.Merge(m, src)
+}
+func (m *ListProjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsResponse.Size(m)
+}
+func (m *ListProjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsResponse proto.InternalMessageInfo
+
+func (m *ListProjectsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListProjectsResponse) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+// Request data to create new tenant
+type CreateTenantRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tenant entity to add
+	Tenant               *Tenant  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTenantRequest) Reset()         { *m = CreateTenantRequest{} }
+func (m *CreateTenantRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTenantRequest) ProtoMessage()    {}
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{74}
+}
+
+func (m *CreateTenantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTenantRequest.Unmarshal(m, b)
+}
+func (m *CreateTenantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTenantRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTenantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTenantRequest.Merge(m, src)
+}
+func (m *CreateTenantRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTenantRequest.Size(m)
+}
+func (m *CreateTenantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTenantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTenantRequest proto.InternalMessageInfo
+
+func (m *CreateTenantRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateTenantRequest) GetTenant() *Tenant {
+	if m != nil {
+		return m.Tenant
+	}
+	return nil
+}
+
+// Contains data of created tenant
+type CreateTenantResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Created tenant
+	Tenant               *Tenant  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTenantResponse) Reset()         { *m = CreateTenantResponse{} }
+func (m *CreateTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTenantResponse) ProtoMessage()    {}
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{75}
+}
+
+func (m *CreateTenantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTenantResponse.Unmarshal(m, b)
+}
+func (m *CreateTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTenantResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTenantResponse.Merge(m, src)
+}
+func (m *CreateTenantResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTenantResponse.Size(m)
+}
+func (m *CreateTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTenantResponse proto.InternalMessageInfo
+
+func (m *CreateTenantResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *CreateTenantResponse) GetTenant() *Tenant {
+	if m != nil {
+		return m.Tenant
+	}
+	return nil
+}
+
+// Request data to update tenant e.g. to suspend it
+type UpdateTenantRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tenant entity to update
+	Tenant *Tenant `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Fields of the tenant to update e.g. "suspended"
+	// All fields are replaced when the mask is empty
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateTenantRequest) Reset()         { *m = UpdateTenantRequest{} }
+func (m *UpdateTenantRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTenantRequest) ProtoMessage()    {}
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{76}
+}
+
+func (m *UpdateTenantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTenantRequest.Unmarshal(m, b)
+}
+func (m *UpdateTenantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTenantRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTenantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTenantRequest.Merge(m, src)
+}
+func (m *UpdateTenantRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTenantRequest.Size(m)
+}
+func (m *UpdateTenantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTenantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTenantRequest proto.InternalMessageInfo
+
+func (m *UpdateTenantRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateTenantRequest) GetTenant() *Tenant {
+	if m != nil {
+		return m.Tenant
+	}
+	return nil
+}
+
+func (m *UpdateTenantRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// Contains status of tenant update operation
+type UpdateTenantResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of tenants have been updated
+	Updated              int64    `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTenantResponse) Reset()         { *m = UpdateTenantResponse{} }
+func (m *UpdateTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTenantResponse) ProtoMessage()    {}
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{77}
+}
+
+func (m *UpdateTenantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTenantResponse.Unmarshal(m, b)
+}
+func (m *UpdateTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTenantResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTenantResponse.Merge(m, src)
+}
+func (m *UpdateTenantResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTenantResponse.Size(m)
+}
+func (m *UpdateTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTenantResponse proto.InternalMessageInfo
+
+func (m *UpdateTenantResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *UpdateTenantResponse) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+// Request data to delete tenant with all its data
+type DeleteTenantRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Unique identifier of the tenant to delete
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTenantRequest) Reset()         { *m = DeleteTenantRequest{} }
+func (m *DeleteTenantRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTenantRequest) ProtoMessage()    {}
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{78}
+}
+
+func (m *DeleteTenantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTenantRequest.Unmarshal(m, b)
+}
+func (m *DeleteTenantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTenantRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTenantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTenantRequest.Merge(m, src)
+}
+func (m *DeleteTenantRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTenantRequest.Size(m)
+}
+func (m *DeleteTenantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTenantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTenantRequest proto.InternalMessageInfo
+
+func (m *DeleteTenantRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteTenantRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Contains status of tenant delete operation
+type DeleteTenantResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Contains number of tenants have been deleted
+	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Contains number of tasks have been deleted with the tenant
+	DeletedTasks         int64    `protobuf:"varint,3,opt,name=deleted_tasks,json=deletedTasks,proto3" json:"deleted_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTenantResponse) Reset()         { *m = DeleteTenantResponse{} }
+func (m *DeleteTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTenantResponse) ProtoMessage()    {}
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{79}
+}
+
+func (m *DeleteTenantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTenantResponse.Unmarshal(m, b)
+}
+func (m *DeleteTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTenantResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTenantResponse.Merge(m, src)
+}
+func (m *DeleteTenantResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTenantResponse.Size(m)
+}
+func (m *DeleteTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTenantResponse proto.InternalMessageInfo
+
+func (m *DeleteTenantResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DeleteTenantResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *DeleteTenantResponse) GetDeletedTasks() int64 {
+	if m != nil {
+		return m.DeletedTasks
+	}
+	return 0
+}
+
+// Request data to list tenants
+type ListTenantsRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTenantsRequest) Reset()         { *m = ListTenantsRequest{} }
+func (m *ListTenantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTenantsRequest) ProtoMessage()    {}
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{80}
+}
+
+func (m *ListTenantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTenantsRequest.Unmarshal(m, b)
+}
+func (m *ListTenantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTenantsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTenantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTenantsRequest.Merge(m, src)
+}
+func (m *ListTenantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTenantsRequest.Size(m)
+}
+func (m *ListTenantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTenantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTenantsRequest proto.InternalMessageInfo
+
+func (m *ListTenantsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+// Contains list of tenants
+type ListTenantsResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Tenants sorted by ID
+	Tenants              []*Tenant `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListTenantsResponse) Reset()         { *m = ListTenantsResponse{} }
+func (m *ListTenantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTenantsResponse) ProtoMessage()    {}
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{81}
+}
+
+func (m *ListTenantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTenantsResponse.Unmarshal(m, b)
+}
+func (m *ListTenantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTenantsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTenantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTenantsResponse.Merge(m, src)
+}
+func (m *ListTenantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTenantsResponse.Size(m)
+}
+func (m *ListTenantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTenantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTenantsResponse proto.InternalMessageInfo
+
+func (m *ListTenantsResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ListTenantsResponse) GetTenants() []*Tenant {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+// Request data to watch changes of todo tasks
+type WatchRequest struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// resume_token of the last received change, the changes made after it are streamed first
+	// Only changes made after the call are streamed when it is empty
+	ResumeToken          string   `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{82}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// Change of todo task
+type WatchResponse struct {
+	// API versioning: it is my best practice to specify version explicitly
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Kind of change
+	Type WatchResponse_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=v1.WatchResponse_EventType" json:"type,omitempty"`
+	// Task after the change, deleted task is returned as it was before deletion
+	ToDo *ToDo `protobuf:"bytes,3,opt,name=toDo,proto3" json:"toDo,omitempty"`
+	// Token to resume watching right after this change
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Date and time of the change
+	Time                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b701c7b1c502fe, []int{83}
+}
+
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchResponse.Unmarshal(m, b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchResponse.Size(m)
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+func (m *WatchResponse) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *WatchResponse) GetType() WatchResponse_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return WatchResponse_EVENT_TYPE_UNSPECIFIED
+}
+
+func (m *WatchResponse) GetToDo() *ToDo {
+	if m != nil {
+		return m.ToDo
+	}
+	return nil
+}
+
+func (m *WatchResponse) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+func (m *WatchResponse) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("v1.Status", Status_name, Status_value)
+	proto.RegisterEnum("v1.BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterEnum("v1.ShareRole", ShareRole_name, ShareRole_value)
+	proto.RegisterEnum("v1.DeleteRequest_Mode", DeleteRequest_Mode_name, DeleteRequest_Mode_value)
+	proto.RegisterEnum("v1.ReadAllRequest_LabelMatch", ReadAllRequest_LabelMatch_name, ReadAllRequest_LabelMatch_value)
+	proto.RegisterEnum("v1.HistoryEntry_Action", HistoryEntry_Action_name, HistoryEntry_Action_value)
+	proto.RegisterEnum("v1.DeleteProjectRequest_Mode", DeleteProjectRequest_Mode_name, DeleteProjectRequest_Mode_value)
+	proto.RegisterEnum("v1.WatchResponse_EventType", WatchResponse_EventType_name, WatchResponse_EventType_value)
+	proto.RegisterType((*ToDo)(nil), "v1.ToDo")
+	proto.RegisterType((*Project)(nil), "v1.Project")
+	proto.RegisterType((*Tenant)(nil), "v1.Tenant")
+	proto.RegisterType((*CreateRequest)(nil), "v1.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "v1.CreateResponse")
+	proto.RegisterType((*ReadRequest)(nil), "v1.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "v1.ReadResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "v1.UpdateResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "v1.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "v1.DeleteResponse")
+	proto.RegisterType((*UndeleteRequest)(nil), "v1.UndeleteRequest")
+	proto.RegisterType((*UndeleteResponse)(nil), "v1.UndeleteResponse")
+	proto.RegisterType((*BatchCreateRequest)(nil), "v1.BatchCreateRequest")
+	proto.RegisterType((*BatchCreateResult)(nil), "v1.BatchCreateResult")
+	proto.RegisterType((*BatchCreateResponse)(nil), "v1.BatchCreateResponse")
+	proto.RegisterType((*BatchUpdateRequest)(nil), "v1.BatchUpdateRequest")
+	proto.RegisterType((*BatchUpdateResult)(nil), "v1.BatchUpdateResult")
+	proto.RegisterType((*BatchUpdateResponse)(nil), "v1.BatchUpdateResponse")
+	proto.RegisterType((*BatchDeleteRequest)(nil), "v1.BatchDeleteRequest")
+	proto.RegisterType((*BatchDeleteResult)(nil), "v1.BatchDeleteResult")
+	proto.RegisterType((*BatchDeleteResponse)(nil), "v1.BatchDeleteResponse")
+	proto.RegisterType((*ReadAllRequest)(nil), "v1.ReadAllRequest")
+	proto.RegisterType((*ReadAllResponse)(nil), "v1.ReadAllResponse")
+	proto.RegisterType((*ListCompletionsRequest)(nil), "v1.ListCompletionsRequest")
+	proto.RegisterType((*Completion)(nil), "v1.Completion")
+	proto.RegisterType((*ListCompletionsResponse)(nil), "v1.ListCompletionsResponse")
+	proto.RegisterType((*ListOccurrencesRequest)(nil), "v1.ListOccurrencesRequest")
+	proto.RegisterType((*Occurrence)(nil), "v1.Occurrence")
+	proto.RegisterType((*ListOccurrencesResponse)(nil), "v1.ListOccurrencesResponse")
+	proto.RegisterType((*ListHistoryRequest)(nil), "v1.ListHistoryRequest")
+	proto.RegisterType((*FieldChange)(nil), "v1.FieldChange")
+	proto.RegisterType((*HistoryEntry)(nil), "v1.HistoryEntry")
+	proto.RegisterType((*ListHistoryResponse)(nil), "v1.ListHistoryResponse")
+	proto.RegisterType((*ListChildrenRequest)(nil), "v1.ListChildrenRequest")
+	proto.RegisterType((*ListChildrenResponse)(nil), "v1.ListChildrenResponse")
+	proto.RegisterType((*MoveRequest)(nil), "v1.MoveRequest")
+	proto.RegisterType((*MoveResponse)(nil), "v1.MoveResponse")
+	proto.RegisterType((*GetProgressRequest)(nil), "v1.GetProgressRequest")
+	proto.RegisterType((*GetProgressResponse)(nil), "v1.GetProgressResponse")
+	proto.RegisterType((*AddDependencyRequest)(nil), "v1.AddDependencyRequest")
+	proto.RegisterType((*AddDependencyResponse)(nil), "v1.AddDependencyResponse")
+	proto.RegisterType((*RemoveDependencyRequest)(nil), "v1.RemoveDependencyRequest")
+	proto.RegisterType((*RemoveDependencyResponse)(nil), "v1.RemoveDependencyResponse")
+	proto.RegisterType((*Dependency)(nil), "v1.Dependency")
+	proto.RegisterType((*ListTopologicalRequest)(nil), "v1.ListTopologicalRequest")
+	proto.RegisterType((*ListTopologicalResponse)(nil), "v1.ListTopologicalResponse")
+	proto.RegisterType((*AddLabelsRequest)(nil), "v1.AddLabelsRequest")
+	proto.RegisterType((*AddLabelsResponse)(nil), "v1.AddLabelsResponse")
+	proto.RegisterType((*RemoveLabelsRequest)(nil), "v1.RemoveLabelsRequest")
+	proto.RegisterType((*RemoveLabelsResponse)(nil), "v1.RemoveLabelsResponse")
+	proto.RegisterType((*ListLabelsRequest)(nil), "v1.ListLabelsRequest")
+	proto.RegisterType((*LabelCount)(nil), "v1.LabelCount")
+	proto.RegisterType((*ListLabelsResponse)(nil), "v1.ListLabelsResponse")
+	proto.RegisterType((*SearchRequest)(nil), "v1.SearchRequest")
+	proto.RegisterType((*SearchResult)(nil), "v1.SearchResult")
+	proto.RegisterType((*SearchResponse)(nil), "v1.SearchResponse")
+	proto.RegisterType((*Share)(nil), "v1.Share")
+	proto.RegisterType((*ShareRequest)(nil), "v1.ShareRequest")
+	proto.RegisterType((*ShareResponse)(nil), "v1.ShareResponse")
+	proto.RegisterType((*UnshareRequest)(nil), "v1.UnshareRequest")
+	proto.RegisterType((*UnshareResponse)(nil), "v1.UnshareResponse")
+	proto.RegisterType((*ListSharesRequest)(nil), "v1.ListSharesRequest")
+	proto.RegisterType((*ListSharesResponse)(nil), "v1.ListSharesResponse")
+	proto.RegisterType((*CreateProjectRequest)(nil), "v1.CreateProjectRequest")
+	proto.RegisterType((*CreateProjectResponse)(nil), "v1.CreateProjectResponse")
+	proto.RegisterType((*ReadProjectRequest)(nil), "v1.ReadProjectRequest")
+	proto.RegisterType((*ReadProjectResponse)(nil), "v1.ReadProjectResponse")
+	proto.RegisterType((*UpdateProjectRequest)(nil), "v1.UpdateProjectRequest")
+	proto.RegisterType((*UpdateProjectResponse)(nil), "v1.UpdateProjectResponse")
+	proto.RegisterType((*DeleteProjectRequest)(nil), "v1.DeleteProjectRequest")
+	proto.RegisterType((*DeleteProjectResponse)(nil), "v1.DeleteProjectResponse")
+	proto.RegisterType((*ListProjectsRequest)(nil), "v1.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "v1.ListProjectsResponse")
+	proto.RegisterType((*CreateTenantRequest)(nil), "v1.CreateTenantRequest")
+	proto.RegisterType((*CreateTenantResponse)(nil), "v1.CreateTenantResponse")
+	proto.RegisterType((*UpdateTenantRequest)(nil), "v1.UpdateTenantRequest")
+	proto.RegisterType((*UpdateTenantResponse)(nil), "v1.UpdateTenantResponse")
+	proto.RegisterType((*DeleteTenantRequest)(nil), "v1.DeleteTenantRequest")
+	proto.RegisterType((*DeleteTenantResponse)(nil), "v1.DeleteTenantResponse")
+	proto.RegisterType((*ListTenantsRequest)(nil), "v1.ListTenantsRequest")
+	proto.RegisterType((*ListTenantsResponse)(nil), "v1.ListTenantsResponse")
+	proto.RegisterType((*WatchRequest)(nil), "v1.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "v1.WatchResponse")
+}
+
+func init() { proto.RegisterFile("todo-service.proto", fileDescriptor_80b701c7b1c502fe) }
+
+var fileDescriptor_80b701c7b1c502fe = []byte{
+	// 3831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x19, 0x10, 0x9f, 0x0f, 0x1f, 0x84, 0x9a, 0x20, 0x01, 0x0e, 0x25, 0x19, 0x9a, 0xcd, 0xda,
+	0x5a, 0x94, 0x4d, 0x58, 0xb4, 0x57, 0x8e, 0xe5, 0x5d, 0xdb, 0x20, 0x00, 0xad, 0xb8, 0x4b, 0x12,
+	0xf4, 0x10, 0xb2, 0x2d, 0x65, 0x1d, 0xd4, 0x08, 0xd3, 0x02, 0xc7, 0x02, 0x66, 0xe0, 0x99, 0x01,
+	0x25, 0xad, 0xa2, 0xca, 0x47, 0xa5, 0x52, 0x7b, 0xc8, 0x21, 0x95, 0xbd, 0xa5, 0x52, 0xb9, 0xe4,
+	0x92, 0x63, 0x4e, 0xf9, 0x01, 0xf9, 0x01, 0xa9, 0x4a, 0xa5, 0x72, 0xca, 0x21, 0x97, 0xfc, 0x90,
+	0x54, 0x7f, 0xcd, 0x07, 0x06, 0x03, 0x80, 0xb2, 0xb7, 0x72, 0xe2, 0xf4, 0xfb, 0xee, 0xd7, 0xaf,
+	0xbb, 0x5f, 0xbf, 0x07, 0x02, 0x72, 0x2d, 0xdd, 0x7a, 0xcf, 0xc1, 0xf6, 0xa5, 0x31, 0xc4, 0xfb,
+	0x53, 0xdb, 0x72, 0x2d, 0x94, 0xb8, 0xbc, 0x23, 0xbf, 0x35, 0xb2, 0xac, 0xd1, 0x18, 0x37, 0x29,
+	0xe4, 0xc9, 0xec, 0x69, 0xd3, 0x35, 0x26, 0xd8, 0x71, 0xb5, 0xc9, 0x94, 0x11, 0xc9, 0x37, 0xe7,
+	0x09, 0xf4, 0x99, 0xad, 0xb9, 0x86, 0x65, 0x72, 0x7c, 0x7d, 0x1e, 0xff, 0xd4, 0xc0, 0x63, 0x7d,
+	0x30, 0xd1, 0x9c, 0x67, 0x9c, 0xe2, 0x3a, 0xa7, 0xd0, 0xa6, 0x46, 0x53, 0x33, 0x4d, 0xcb, 0xa5,
+	0xec, 0x0e, 0xc7, 0x56, 0x39, 0xd6, 0x9e, 0x0e, 0x9b, 0x8e, 0xab, 0xb9, 0x33, 0x81, 0x78, 0x97,
+	0xfe, 0x19, 0xbe, 0x37, 0xc2, 0xe6, 0x7b, 0xce, 0x73, 0x6d, 0x34, 0xc2, 0x76, 0xd3, 0x9a, 0x52,
+	0xd6, 0xa8, 0x18, 0xe5, 0x9f, 0x53, 0x90, 0xec, 0x5b, 0x1d, 0x0b, 0x95, 0x20, 0x61, 0xe8, 0x35,
+	0xa9, 0x2e, 0xdd, 0xde, 0x50, 0x13, 0x86, 0x8e, 0x2a, 0x90, 0x72, 0x0d, 0x77, 0x8c, 0x6b, 0x89,
+	0xba, 0x74, 0x3b, 0xa7, 0xb2, 0x01, 0xaa, 0x43, 0x5e, 0xc7, 0xce, 0xd0, 0x36, 0xa8, 0xc0, 0xda,
+	0x06, 0xc5, 0x05, 0x41, 0x48, 0x81, 0x34, 0x33, 0xa7, 0x96, 0xac, 0x4b, 0xb7, 0x4b, 0x07, 0xb0,
+	0x7f, 0x79, 0x67, 0xff, 0x9c, 0x42, 0x54, 0x8e, 0x41, 0x5f, 0xc3, 0x2e, 0x76, 0x5c, 0x63, 0xa2,
+	0xb9, 0x58, 0xef, 0x1b, 0x13, 0xdc, 0x7b, 0xda, 0xb6, 0x26, 0xd3, 0x31, 0xa6, 0x32, 0x53, 0x75,
+	0xe9, 0x76, 0xfe, 0x40, 0xde, 0x67, 0xf3, 0xdb, 0x17, 0xfe, 0xd9, 0xef, 0x0b, 0x07, 0xab, 0xf1,
+	0xcc, 0x48, 0x85, 0x1d, 0x6d, 0xe8, 0xce, 0xb4, 0x71, 0x44, 0x6c, 0x7a, 0xa5, 0xd8, 0x18, 0x4e,
+	0x74, 0x17, 0xb2, 0x36, 0x9e, 0x18, 0xa6, 0x8e, 0xed, 0x5a, 0x66, 0xa5, 0x14, 0x8f, 0x16, 0xfd,
+	0x11, 0xe4, 0x86, 0x36, 0x26, 0x66, 0xb6, 0xdc, 0x5a, 0x76, 0x25, 0xa3, 0x4f, 0x4c, 0x38, 0x67,
+	0x53, 0x9d, 0x73, 0xe6, 0x56, 0x73, 0x7a, 0xc4, 0xe8, 0x26, 0x80, 0x8d, 0x87, 0x33, 0xdb, 0xc6,
+	0xe6, 0x10, 0xd7, 0x80, 0x2e, 0x4f, 0x00, 0x82, 0xae, 0x43, 0x6e, 0x6a, 0x5b, 0xdf, 0xe2, 0xa1,
+	0x7b, 0xa4, 0xd7, 0xf2, 0x74, 0xb1, 0x7d, 0x00, 0x92, 0x21, 0x3b, 0xd5, 0x6c, 0x6c, 0x12, 0x64,
+	0x81, 0x22, 0xbd, 0x31, 0xda, 0x81, 0xf4, 0x58, 0x7b, 0x82, 0xc7, 0x4e, 0xad, 0x58, 0xdf, 0xb8,
+	0x9d, 0x53, 0xf9, 0x88, 0xd8, 0xaa, 0xe3, 0x31, 0x66, 0xb6, 0x96, 0x56, 0xdb, 0xea, 0x11, 0xa3,
+	0x1a, 0x64, 0x2e, 0xb1, 0xed, 0x90, 0xc5, 0xd9, 0xa4, 0xca, 0xc4, 0x90, 0xc4, 0x9e, 0xf5, 0xdc,
+	0xc4, 0x76, 0xad, 0xcc, 0x62, 0x8f, 0x0e, 0x94, 0xff, 0x91, 0x20, 0x73, 0xc6, 0x6c, 0x8d, 0x44,
+	0x2b, 0x82, 0xa4, 0xa9, 0x4d, 0x44, 0xb0, 0xd2, 0xef, 0x35, 0x62, 0x55, 0x86, 0xac, 0x66, 0x0f,
+	0x2f, 0x8c, 0x4b, 0xac, 0xd3, 0x68, 0xcd, 0xaa, 0xde, 0x38, 0xbc, 0x7a, 0xa9, 0x37, 0x5e, 0xbd,
+	0xf4, 0x15, 0x56, 0x4f, 0xf9, 0x6f, 0x09, 0xd2, 0x7d, 0x6c, 0x6a, 0x66, 0x70, 0x82, 0xb9, 0xd8,
+	0x09, 0xee, 0x41, 0x6e, 0xa2, 0xbd, 0x18, 0xb8, 0x9a, 0xf3, 0xcc, 0xa1, 0xd3, 0xdb, 0x50, 0xb3,
+	0x13, 0xed, 0x45, 0x9f, 0x8c, 0xc9, 0x4a, 0x3b, 0x33, 0x67, 0x8a, 0x4d, 0xdd, 0x9b, 0x9c, 0x0f,
+	0xf8, 0x7f, 0x99, 0xdd, 0x67, 0x50, 0x6c, 0x53, 0x31, 0x2a, 0xfe, 0x6e, 0x86, 0x1d, 0x17, 0x95,
+	0x61, 0x43, 0x9b, 0x1a, 0x7c, 0x92, 0xe4, 0x13, 0x5d, 0x87, 0xa4, 0x6b, 0x75, 0x2c, 0x3a, 0xcb,
+	0xfc, 0x41, 0x96, 0x1c, 0x1d, 0xe4, 0x70, 0x52, 0x29, 0x54, 0x39, 0x80, 0x92, 0x10, 0xe0, 0x4c,
+	0x2d, 0xd3, 0xc1, 0x0b, 0x24, 0x30, 0xbf, 0x25, 0x44, 0x60, 0x28, 0x4d, 0xc8, 0xab, 0x58, 0xd3,
+	0xe3, 0x55, 0xce, 0x33, 0x7c, 0x0a, 0x05, 0xc6, 0x10, 0xab, 0x62, 0xb9, 0x91, 0x7f, 0x0a, 0xc5,
+	0x87, 0x74, 0xca, 0x6f, 0x38, 0x4b, 0xf4, 0x09, 0xe4, 0x99, 0xcf, 0xe8, 0x5d, 0x50, 0xdb, 0x88,
+	0x71, 0xf1, 0x7d, 0x72, 0x5d, 0x9c, 0x68, 0xce, 0x33, 0x15, 0x18, 0x39, 0xf9, 0x56, 0x2c, 0x28,
+	0x09, 0xed, 0xb1, 0xf6, 0xd7, 0x20, 0xc3, 0x17, 0x85, 0x4f, 0x5b, 0x0c, 0x51, 0x15, 0x32, 0x26,
+	0x7e, 0xe1, 0x0e, 0x0c, 0x9d, 0x87, 0x53, 0x9a, 0x0c, 0x8f, 0xf4, 0xe0, 0x56, 0x4d, 0x86, 0xb6,
+	0xaa, 0xf2, 0x2f, 0x12, 0x14, 0x3b, 0x74, 0x4b, 0xaf, 0xed, 0x62, 0xd4, 0x80, 0xe4, 0xc4, 0xd2,
+	0x31, 0xd5, 0x51, 0x3a, 0xd8, 0x21, 0xf3, 0x0f, 0x89, 0xd8, 0x3f, 0xb1, 0x74, 0xac, 0x52, 0x9a,
+	0x25, 0x9a, 0x3f, 0x82, 0x24, 0xa1, 0x43, 0x15, 0x28, 0x9f, 0xf4, 0x3a, 0xdd, 0xc1, 0xc3, 0xd3,
+	0xf3, 0xb3, 0x6e, 0xfb, 0xe8, 0xfe, 0x51, 0xb7, 0x53, 0xfe, 0x03, 0x94, 0x87, 0x4c, 0xbb, 0x75,
+	0xde, 0x6e, 0x75, 0xba, 0x65, 0x09, 0x15, 0x20, 0xab, 0x76, 0xcf, 0x5a, 0x6a, 0xf7, 0xb4, 0x5f,
+	0x4e, 0x28, 0x3f, 0x83, 0x92, 0x50, 0xb7, 0xcc, 0x47, 0xfc, 0xa0, 0x12, 0x3e, 0xe2, 0x43, 0xe5,
+	0x03, 0xd8, 0x7c, 0x68, 0xea, 0x57, 0x9b, 0xb1, 0x72, 0x08, 0x65, 0x9f, 0x69, 0x49, 0x60, 0xe5,
+	0x66, 0x66, 0x58, 0xad, 0x0f, 0x50, 0x5e, 0x00, 0x3a, 0xd4, 0xdc, 0xe1, 0xc5, 0xaa, 0x3d, 0xf4,
+	0x1e, 0xb9, 0xae, 0x28, 0xd2, 0xa9, 0x25, 0xea, 0x1b, 0xb7, 0xf3, 0x07, 0xd7, 0x88, 0x87, 0x43,
+	0x6c, 0xaa, 0x47, 0x82, 0x6e, 0x85, 0x16, 0xa3, 0x48, 0x48, 0xa9, 0x1a, 0x7f, 0x0d, 0x94, 0x1e,
+	0x5c, 0x0b, 0x69, 0x76, 0x66, 0x63, 0x17, 0x35, 0xbc, 0x7b, 0x5e, 0xa2, 0x11, 0x8a, 0x44, 0x84,
+	0xda, 0xd3, 0xe1, 0xfc, 0x7d, 0x3f, 0xef, 0x8e, 0xaf, 0x61, 0x2b, 0x2c, 0x30, 0xce, 0x23, 0x4d,
+	0xc8, 0xd8, 0x54, 0x9d, 0x98, 0xca, 0xb6, 0x67, 0x5f, 0xd0, 0x18, 0x55, 0x50, 0x79, 0x4e, 0x5a,
+	0xb5, 0x05, 0x63, 0x9c, 0x14, 0x62, 0xbb, 0x9a, 0x93, 0x6c, 0xee, 0x24, 0x6f, 0xfb, 0x5d, 0xd5,
+	0x49, 0x57, 0xdf, 0x96, 0x9e, 0x1f, 0x57, 0x6e, 0xf9, 0x25, 0x7e, 0x0c, 0xda, 0x1b, 0xf5, 0xe3,
+	0xaa, 0xad, 0x1d, 0xe3, 0xc7, 0x10, 0xdb, 0xd5, 0xfc, 0xf8, 0x88, 0xfb, 0xd1, 0xdb, 0xa2, 0x6f,
+	0xe0, 0xc7, 0x98, 0xad, 0x2b, 0xdc, 0xb5, 0x72, 0xf7, 0x2f, 0x71, 0x57, 0xd0, 0x2c, 0xdf, 0x5d,
+	0x7f, 0x9d, 0x82, 0x12, 0xb9, 0x35, 0x5a, 0xe3, 0x71, 0xbc, 0xaf, 0xf6, 0x20, 0x37, 0xd5, 0x46,
+	0x78, 0xe0, 0x18, 0xbf, 0x61, 0xf7, 0x78, 0x8a, 0xa4, 0x57, 0x23, 0x7c, 0x6e, 0xfc, 0x06, 0xa3,
+	0x1b, 0x00, 0x14, 0xe9, 0x5a, 0xcf, 0xb0, 0xc8, 0x55, 0x28, 0x79, 0x9f, 0x00, 0xd6, 0xca, 0xaa,
+	0x5b, 0x50, 0x12, 0xb9, 0xe7, 0x40, 0x7b, 0xea, 0x62, 0x7b, 0x8d, 0x8b, 0xbd, 0x28, 0x38, 0x5a,
+	0x84, 0x01, 0xb5, 0x61, 0xd3, 0x13, 0xf1, 0x04, 0x3f, 0xb5, 0x6c, 0xbc, 0xc6, 0x15, 0xef, 0x69,
+	0x3d, 0xa4, 0x1c, 0xe8, 0x23, 0xc8, 0xe9, 0x33, 0xcc, 0x4d, 0x58, 0x23, 0x61, 0xd6, 0x67, 0x98,
+	0x69, 0xff, 0x18, 0x80, 0x30, 0x72, 0xc5, 0x6b, 0x64, 0xcc, 0xfa, 0x0c, 0x73, 0x9d, 0xbb, 0x90,
+	0xb5, 0x6c, 0x6a, 0xf5, 0x4b, 0x9a, 0x30, 0xe7, 0xd4, 0x0c, 0x1d, 0x1f, 0xbe, 0xa4, 0x9e, 0x65,
+	0x59, 0x23, 0xd9, 0x40, 0x30, 0x9f, 0xf3, 0x56, 0x20, 0x65, 0x63, 0x4d, 0x7f, 0x49, 0xb3, 0xe1,
+	0xac, 0xca, 0x06, 0x81, 0x6c, 0xb7, 0x10, 0xca, 0x76, 0x3f, 0x85, 0x3c, 0xfd, 0x1a, 0x4c, 0x48,
+	0x30, 0xd4, 0x8a, 0x74, 0x31, 0x6e, 0x90, 0xc5, 0x08, 0x2f, 0xff, 0xfe, 0x31, 0xa1, 0x3a, 0x21,
+	0x44, 0x2a, 0x8c, 0xbd, 0x6f, 0x74, 0x0b, 0x0a, 0xce, 0x85, 0xf5, 0x7c, 0x20, 0x22, 0xb4, 0x44,
+	0x95, 0xe6, 0x09, 0xac, 0xc3, 0xa3, 0xf4, 0x13, 0x00, 0x9f, 0x19, 0xed, 0x41, 0xf5, 0xb8, 0x75,
+	0xd8, 0x3d, 0x1e, 0x9c, 0xb4, 0xfa, 0xed, 0x07, 0x73, 0x97, 0x5c, 0x06, 0x36, 0x5a, 0xc7, 0xc7,
+	0x65, 0x89, 0x7e, 0x9c, 0x3e, 0x2a, 0x27, 0x94, 0x67, 0xb0, 0xe9, 0x19, 0x12, 0x1b, 0xde, 0x37,
+	0x21, 0x45, 0x32, 0x0d, 0x11, 0xdc, 0x7e, 0x02, 0xc2, 0xc0, 0xe8, 0x6d, 0xd8, 0xa4, 0xe7, 0x4d,
+	0x24, 0x20, 0x8b, 0x04, 0x7c, 0x26, 0x82, 0x52, 0xb9, 0x07, 0x3b, 0xc7, 0x86, 0xe3, 0xfa, 0x4f,
+	0x25, 0x67, 0xfd, 0x1b, 0xf1, 0xdf, 0x24, 0x00, 0x9f, 0x11, 0xfd, 0x1c, 0x0a, 0x43, 0x36, 0xc2,
+	0xfa, 0x40, 0x73, 0x6b, 0xd2, 0xca, 0xc5, 0xcf, 0x7b, 0xf4, 0x2d, 0x97, 0xe4, 0x4c, 0x36, 0xb6,
+	0xa6, 0xd8, 0x64, 0xdc, 0x89, 0x95, 0xdc, 0x20, 0xc8, 0x69, 0x46, 0x0b, 0xc3, 0x97, 0xc3, 0x31,
+	0x1e, 0x90, 0x27, 0x3c, 0xcf, 0xb7, 0x76, 0x23, 0xbc, 0x1d, 0xfe, 0x7c, 0x57, 0x73, 0x94, 0x98,
+	0x88, 0x52, 0xbe, 0x81, 0x6a, 0xc4, 0x01, 0xb1, 0x5e, 0x7f, 0x1f, 0xf2, 0x43, 0x9f, 0x90, 0xfb,
+	0xbe, 0x44, 0xaf, 0x66, 0x0f, 0xac, 0x06, 0x49, 0x88, 0x8f, 0xa8, 0x83, 0x7b, 0x43, 0xf1, 0x7e,
+	0x5b, 0xdf, 0xc1, 0x64, 0x33, 0x39, 0xae, 0x66, 0xbb, 0xc1, 0x59, 0x2d, 0xdd, 0x4c, 0x94, 0x9a,
+	0x8c, 0xd1, 0x4f, 0x21, 0x8b, 0x4d, 0x9d, 0x31, 0x26, 0x57, 0x32, 0x66, 0xb0, 0x49, 0xdf, 0xe1,
+	0x64, 0x27, 0x8d, 0x8d, 0x89, 0xc1, 0xde, 0x13, 0x29, 0x95, 0x0d, 0x94, 0x7f, 0x94, 0x00, 0xfc,
+	0x09, 0x2c, 0x7f, 0xfa, 0x4b, 0xdf, 0xe7, 0xe9, 0x1f, 0x7c, 0xa6, 0x27, 0xd6, 0x7f, 0xa6, 0x8b,
+	0x45, 0x0c, 0x39, 0x79, 0xd9, 0x22, 0x5a, 0x3e, 0x61, 0x70, 0x11, 0x7d, 0x7e, 0x35, 0x48, 0xa2,
+	0xdc, 0x05, 0x44, 0xc4, 0x3f, 0x30, 0x1c, 0xd7, 0xb2, 0x5f, 0xae, 0xbf, 0x41, 0xbe, 0x80, 0x3c,
+	0x4d, 0xf1, 0xdb, 0x17, 0x9a, 0x39, 0xa2, 0xce, 0xa5, 0x05, 0x22, 0xce, 0xc2, 0x06, 0xe4, 0x98,
+	0xe2, 0xa7, 0x25, 0x7b, 0x17, 0xf2, 0x11, 0xa1, 0x66, 0xc7, 0x2f, 0xdb, 0xb7, 0x6c, 0xa0, 0xfc,
+	0x6b, 0x02, 0x0a, 0xdc, 0x8e, 0xae, 0xe9, 0xda, 0x2f, 0x23, 0xaf, 0xe8, 0x26, 0xa4, 0xb5, 0x21,
+	0x5d, 0x89, 0x04, 0x3d, 0xd8, 0xaa, 0x64, 0x62, 0x41, 0x8e, 0xfd, 0x16, 0x45, 0xab, 0x9c, 0x0c,
+	0xfd, 0x04, 0x32, 0x43, 0x6a, 0x1f, 0x79, 0x7f, 0x12, 0x57, 0x6c, 0x12, 0x8e, 0x80, 0xdd, 0xaa,
+	0xc0, 0x53, 0x93, 0x86, 0xae, 0x65, 0xd7, 0x92, 0xdc, 0x24, 0x32, 0x20, 0x87, 0x33, 0x4f, 0x0e,
+	0xc8, 0xe1, 0x9c, 0xa2, 0xa8, 0x1c, 0x87, 0x1c, 0xe9, 0x68, 0x1f, 0x92, 0x34, 0x0a, 0x57, 0x5f,
+	0x42, 0x94, 0x4e, 0x79, 0x08, 0x69, 0x66, 0x21, 0xda, 0x01, 0xd4, 0x6a, 0xf7, 0x8f, 0x7a, 0xa7,
+	0x0b, 0xde, 0x05, 0x6a, 0xb7, 0xd5, 0xef, 0x76, 0xca, 0x12, 0x19, 0x3c, 0x3c, 0xeb, 0xd0, 0x41,
+	0x82, 0x0c, 0x3a, 0xdd, 0xe3, 0x2e, 0x19, 0x6c, 0xb0, 0x17, 0xc3, 0x79, 0xbf, 0xa7, 0x76, 0x3b,
+	0xe5, 0xa4, 0x72, 0x0e, 0x5b, 0xa1, 0x35, 0x8c, 0x0d, 0x8f, 0x06, 0x64, 0xb0, 0xe9, 0xda, 0x86,
+	0x17, 0x1a, 0xe5, 0x79, 0x0f, 0xaa, 0x82, 0x40, 0x79, 0xc8, 0x84, 0xb6, 0x2f, 0x8c, 0xb1, 0x6e,
+	0x63, 0x73, 0xfd, 0x9d, 0x7d, 0x1d, 0x72, 0xb4, 0xa2, 0xe3, 0x18, 0x97, 0x6c, 0x63, 0x67, 0x55,
+	0x1f, 0xa0, 0x3c, 0x80, 0x4a, 0x58, 0xec, 0x9b, 0x5e, 0x03, 0xca, 0x31, 0xe4, 0x4f, 0xac, 0xcb,
+	0x2b, 0xbc, 0xeb, 0x68, 0x82, 0x63, 0x63, 0x33, 0x90, 0xa9, 0x7a, 0xf5, 0x23, 0xe5, 0x2e, 0x14,
+	0x98, 0xb4, 0x58, 0x7b, 0x2a, 0x90, 0x9a, 0x58, 0x97, 0x5e, 0xda, 0xc6, 0x06, 0x64, 0xff, 0xfc,
+	0x02, 0xbb, 0x67, 0xb6, 0x35, 0xb2, 0xb1, 0x73, 0x85, 0x0b, 0xe6, 0x19, 0x6c, 0x85, 0xf8, 0x96,
+	0xa9, 0x75, 0x2d, 0x57, 0x1b, 0x0b, 0xb5, 0x74, 0x40, 0xea, 0x2d, 0xba, 0x65, 0x62, 0x3e, 0x0d,
+	0xfa, 0x4d, 0x32, 0xcb, 0x29, 0xb6, 0x87, 0xd8, 0x74, 0x69, 0x10, 0x4b, 0xaa, 0x18, 0x2a, 0xbf,
+	0x86, 0x4a, 0x4b, 0xd7, 0x3b, 0x98, 0x56, 0x57, 0xcc, 0xe1, 0xfa, 0xdb, 0x1c, 0x29, 0x50, 0xd4,
+	0x29, 0x9b, 0x33, 0xb0, 0x4c, 0xdf, 0x6f, 0x79, 0x0e, 0xec, 0x99, 0x47, 0xba, 0xf2, 0x13, 0xd8,
+	0x9e, 0x93, 0x1e, 0x37, 0x19, 0x65, 0x00, 0x55, 0x15, 0x13, 0xc7, 0xfd, 0xbe, 0x6c, 0x79, 0x17,
+	0x6a, 0x51, 0x05, 0xb1, 0xe6, 0xfc, 0x12, 0xc0, 0xa7, 0x43, 0x35, 0xc8, 0xba, 0xd6, 0x40, 0xb7,
+	0x06, 0xde, 0xa1, 0x93, 0x26, 0x91, 0x76, 0xb4, 0x40, 0x73, 0x22, 0xaa, 0xf9, 0x88, 0x5d, 0x86,
+	0x7d, 0x6b, 0x6a, 0x8d, 0xad, 0x91, 0x31, 0xd4, 0x96, 0xa4, 0xda, 0xe1, 0x9c, 0x2f, 0x31, 0x97,
+	0xf3, 0x29, 0x7f, 0x06, 0xd5, 0x88, 0xa8, 0x37, 0xce, 0x96, 0x0e, 0xa0, 0xa0, 0x8b, 0x39, 0x1a,
+	0xde, 0x41, 0x58, 0x62, 0xcf, 0x20, 0xcf, 0x47, 0x21, 0x1a, 0xe5, 0x18, 0xca, 0x2d, 0x5d, 0xa7,
+	0x69, 0xde, 0x15, 0xae, 0x74, 0x3f, 0x29, 0xdd, 0x08, 0x26, 0xa5, 0xca, 0xcf, 0xe1, 0x5a, 0x40,
+	0x5a, 0xec, 0x44, 0x7c, 0xf6, 0x44, 0x88, 0xbd, 0x07, 0x5b, 0x6c, 0x49, 0x7f, 0x28, 0x7b, 0x3e,
+	0x87, 0x4a, 0x58, 0xe0, 0x95, 0x4d, 0xea, 0xc0, 0x35, 0xb2, 0x40, 0xab, 0x0c, 0x5a, 0xb1, 0xcc,
+	0x77, 0x79, 0x26, 0xdd, 0xb6, 0x66, 0xa6, 0xeb, 0x55, 0x50, 0xa5, 0x40, 0x05, 0xb5, 0x02, 0xa9,
+	0x21, 0x41, 0x8a, 0xbd, 0x4f, 0x07, 0xca, 0x29, 0xbb, 0xb2, 0x57, 0x5a, 0xff, 0x76, 0xc8, 0x7a,
+	0xbe, 0xe6, 0xbe, 0x46, 0x6f, 0x36, 0x7f, 0x2e, 0x41, 0xf1, 0x1c, 0x93, 0xca, 0x72, 0xfc, 0x54,
+	0x0a, 0x20, 0x7d, 0xc7, 0x2f, 0x71, 0xe9, 0xbb, 0xf0, 0x53, 0x71, 0x63, 0xee, 0xa9, 0x58, 0x85,
+	0xcc, 0xd4, 0xc6, 0x03, 0x57, 0x1b, 0xf1, 0xbb, 0x34, 0x3d, 0xb5, 0x71, 0x5f, 0x1b, 0x91, 0x47,
+	0xd0, 0xd4, 0x72, 0x5c, 0x8a, 0x61, 0x57, 0x69, 0x86, 0x8c, 0xfb, 0xda, 0x48, 0xf9, 0x07, 0x09,
+	0x0a, 0xc2, 0x04, 0xfa, 0xa2, 0x16, 0x35, 0x48, 0x69, 0x61, 0x0d, 0xb2, 0x02, 0x29, 0x67, 0x28,
+	0xd2, 0x0a, 0x49, 0x65, 0x03, 0xf4, 0x0e, 0x6c, 0xd2, 0x2e, 0xd0, 0xe0, 0xc2, 0x18, 0x5d, 0x8c,
+	0x8d, 0xd1, 0x85, 0xcb, 0xf3, 0x8b, 0x12, 0x05, 0x3f, 0x10, 0x50, 0xd4, 0x84, 0xad, 0x40, 0x99,
+	0x7d, 0xe0, 0x98, 0xc6, 0x74, 0x8a, 0x5d, 0x6e, 0x2d, 0x0a, 0xa0, 0xce, 0x19, 0x46, 0x99, 0x40,
+	0xc9, 0xb3, 0x6e, 0xc9, 0xdd, 0x1a, 0x7e, 0x94, 0xd3, 0xbb, 0x35, 0x38, 0x29, 0xef, 0x3d, 0x4e,
+	0x02, 0x83, 0x1e, 0xe3, 0x41, 0x07, 0xe6, 0x28, 0x84, 0x78, 0x50, 0xf9, 0x14, 0x52, 0xe7, 0x17,
+	0x9a, 0x8d, 0x49, 0x4c, 0xcc, 0x1c, 0x6c, 0x8b, 0x98, 0x20, 0xdf, 0xa4, 0x46, 0x61, 0x5b, 0xbc,
+	0xef, 0xc5, 0x6b, 0x14, 0x94, 0x58, 0xb5, 0xc6, 0x58, 0xa5, 0x28, 0xe5, 0x0b, 0x28, 0x30, 0xd0,
+	0xda, 0x5b, 0xe5, 0x2d, 0x48, 0x39, 0x84, 0x83, 0x27, 0xe2, 0x39, 0x5f, 0x2a, 0x83, 0x2b, 0x1d,
+	0x28, 0x72, 0x91, 0xb1, 0x0e, 0xb8, 0x05, 0x69, 0x4a, 0x2b, 0xe6, 0x1f, 0x10, 0xc2, 0x11, 0xca,
+	0x7d, 0x28, 0x3d, 0x34, 0x9d, 0xab, 0x99, 0x26, 0x7c, 0xb0, 0xe1, 0xfb, 0x40, 0xb9, 0x0f, 0x9b,
+	0x9e, 0x9c, 0xef, 0x63, 0xcf, 0x4f, 0xd9, 0x3e, 0xa6, 0xc0, 0x2b, 0xdc, 0xdd, 0x03, 0x40, 0x41,
+	0xb6, 0x65, 0x57, 0x37, 0xeb, 0x13, 0x25, 0x02, 0x7d, 0xa2, 0x80, 0x5d, 0x1b, 0x71, 0x76, 0xf5,
+	0xa0, 0xc2, 0xea, 0x87, 0xbc, 0x9f, 0x14, 0x6f, 0xda, 0x8f, 0xc9, 0x66, 0xa3, 0x34, 0xfc, 0x51,
+	0x91, 0x27, 0xd2, 0x04, 0x9b, 0xc0, 0x29, 0x1f, 0xc3, 0xf6, 0x9c, 0xc0, 0xb5, 0x3b, 0x14, 0x77,
+	0x01, 0x91, 0x27, 0xfb, 0x4a, 0x4b, 0xe6, 0xf9, 0x4e, 0x61, 0x2b, 0xc4, 0x17, 0xab, 0x70, 0xcd,
+	0x29, 0xfc, 0x8d, 0x04, 0x15, 0x56, 0x0c, 0xfc, 0x81, 0x9c, 0xf2, 0xfd, 0x3a, 0x19, 0x6d, 0xd8,
+	0x9e, 0xb3, 0xe6, 0xea, 0x0d, 0x0d, 0xe5, 0x9f, 0x24, 0xa8, 0xb0, 0xba, 0xca, 0x55, 0xdd, 0x8b,
+	0xee, 0x84, 0x4a, 0x95, 0x37, 0xfc, 0xaa, 0x66, 0x58, 0x52, 0xa0, 0x57, 0xa1, 0xdc, 0x5d, 0xbf,
+	0x23, 0x91, 0x87, 0x4c, 0x4b, 0x6d, 0x3f, 0x38, 0xfa, 0xb2, 0x5b, 0x4e, 0x28, 0x7f, 0x25, 0xc1,
+	0xf6, 0x9c, 0xec, 0xab, 0x37, 0x26, 0xd0, 0x8f, 0xa0, 0xc8, 0x3f, 0x43, 0x1d, 0xc1, 0x02, 0x07,
+	0xb2, 0xae, 0xe0, 0x92, 0x8e, 0xa7, 0x72, 0xcc, 0x1e, 0x24, 0xdc, 0x86, 0x25, 0xdb, 0xf5, 0x47,
+	0x50, 0xa4, 0x45, 0x2c, 0x4f, 0x52, 0x82, 0x4a, 0xa2, 0x95, 0xad, 0x96, 0x90, 0xf6, 0x05, 0x54,
+	0xc2, 0xd2, 0x62, 0xa7, 0xf4, 0x0e, 0x64, 0x79, 0xc4, 0x88, 0x93, 0x24, 0x14, 0x4e, 0x1e, 0x52,
+	0xf9, 0x15, 0x6c, 0xb1, 0x4d, 0xc6, 0x7a, 0xa4, 0xf1, 0x06, 0x2a, 0x90, 0x76, 0x29, 0x09, 0x0f,
+	0x4f, 0x5a, 0x2d, 0xe5, 0x4c, 0x1c, 0xa3, 0x1c, 0x8b, 0x23, 0x40, 0x08, 0x8b, 0xb5, 0x6f, 0x1d,
+	0x69, 0xbf, 0x95, 0x60, 0x8b, 0x85, 0xeb, 0x0f, 0x60, 0xdb, 0xf7, 0xdb, 0x38, 0x87, 0x62, 0x1b,
+	0xaf, 0x9c, 0x58, 0xfc, 0xbe, 0xf9, 0x08, 0xb6, 0x58, 0x40, 0xae, 0x9a, 0x8d, 0xbf, 0x6b, 0x68,
+	0x9b, 0x5a, 0x19, 0x41, 0x25, 0xcc, 0xf8, 0x7b, 0x0a, 0x64, 0xe5, 0x6d, 0x76, 0x45, 0x30, 0x35,
+	0xf1, 0xb1, 0xaa, 0x9c, 0xc0, 0x56, 0x88, 0x2e, 0xd6, 0x9e, 0x3f, 0x84, 0x0c, 0xf3, 0xbe, 0x08,
+	0xc2, 0xe0, 0xc2, 0x08, 0x94, 0xd2, 0x86, 0xc2, 0x57, 0xb4, 0xa8, 0x1b, 0xeb, 0x91, 0x5b, 0x50,
+	0x20, 0x59, 0xc8, 0x44, 0x54, 0x4e, 0x99, 0x6f, 0xf2, 0x0c, 0xc6, 0xea, 0xa6, 0xbf, 0x4b, 0x40,
+	0x91, 0x4b, 0x59, 0xd2, 0x82, 0x48, 0xba, 0x2f, 0xa7, 0x22, 0x0b, 0xd9, 0x23, 0xb6, 0x84, 0x58,
+	0xf6, 0xbb, 0x97, 0xd8, 0x74, 0xfb, 0x2f, 0xa7, 0x58, 0xa5, 0x84, 0x5e, 0x42, 0xb7, 0xb1, 0x30,
+	0xa1, 0x9b, 0xb7, 0x2a, 0x19, 0xb1, 0xca, 0xab, 0xb5, 0xa4, 0xd6, 0xac, 0xb5, 0x9c, 0x42, 0xce,
+	0xb3, 0x01, 0xc9, 0xb0, 0xd3, 0xfd, 0xb2, 0x7b, 0xda, 0x1f, 0xf4, 0x1f, 0x9d, 0x75, 0xdf, 0xa8,
+	0xe4, 0xd2, 0xd0, 0x20, 0xcd, 0x1a, 0x1a, 0xa4, 0x76, 0x73, 0xde, 0x6f, 0xf5, 0x1f, 0x9e, 0xcf,
+	0x09, 0xca, 0x42, 0xb2, 0xdf, 0xeb, 0xf4, 0xca, 0x12, 0xda, 0x84, 0xfc, 0xd1, 0xe9, 0xe0, 0x4c,
+	0xed, 0xfd, 0x42, 0xed, 0x9e, 0x9f, 0x33, 0x49, 0x87, 0xc7, 0xbd, 0xf6, 0xaf, 0x88, 0x24, 0x42,
+	0xd7, 0xe9, 0x9d, 0x76, 0xcb, 0x49, 0x54, 0x84, 0x5c, 0xbb, 0x75, 0xda, 0xee, 0x1e, 0x1f, 0x77,
+	0x3b, 0xe5, 0x54, 0xa3, 0x05, 0x39, 0xaf, 0xdd, 0x44, 0x4c, 0x3e, 0xa4, 0x35, 0xf5, 0x05, 0x67,
+	0x35, 0x40, 0xba, 0xd5, 0xef, 0x9d, 0x1c, 0xb5, 0x59, 0xf3, 0xf8, 0xac, 0xab, 0x0e, 0x8e, 0xfa,
+	0xdd, 0x93, 0x72, 0xa2, 0xf1, 0x19, 0xe4, 0xbc, 0x6c, 0x90, 0x88, 0x38, 0x7f, 0xd0, 0x52, 0xbb,
+	0x03, 0xb5, 0x77, 0xbc, 0x40, 0xc4, 0x97, 0x47, 0xdd, 0xaf, 0xba, 0x6a, 0x59, 0x22, 0xdf, 0xdd,
+	0xce, 0x51, 0xbf, 0xa7, 0x96, 0x13, 0x07, 0xff, 0x2e, 0x43, 0x9e, 0x2c, 0xcc, 0x39, 0xfb, 0x49,
+	0x19, 0x9a, 0x40, 0x9a, 0x9d, 0x43, 0x28, 0xda, 0xa6, 0x95, 0x51, 0x10, 0xc4, 0x16, 0x5e, 0xf9,
+	0xd9, 0x5f, 0xfe, 0xe7, 0xff, 0xfe, 0x2e, 0x71, 0x57, 0xc9, 0x36, 0x2f, 0xef, 0x34, 0x5d, 0xcd,
+	0xf9, 0xee, 0x9e, 0xd4, 0x78, 0xfc, 0x8e, 0xa2, 0x90, 0xa1, 0x38, 0x2a, 0x9b, 0xaf, 0xc8, 0xaa,
+	0xef, 0x7b, 0x4f, 0xa1, 0xd7, 0x82, 0x10, 0x7d, 0x0e, 0x49, 0x92, 0x35, 0xa0, 0x4d, 0xd1, 0xb3,
+	0x10, 0xaa, 0xca, 0x3e, 0x80, 0x2b, 0xda, 0xa6, 0x8a, 0x36, 0x51, 0x51, 0x28, 0x6a, 0xbe, 0x32,
+	0xf4, 0xd7, 0xe8, 0x5b, 0x48, 0xb3, 0xf3, 0x05, 0x45, 0x5b, 0xa6, 0x32, 0x0a, 0x82, 0xb8, 0x9c,
+	0x8f, 0xa9, 0x9c, 0x0f, 0x0e, 0x90, 0x2f, 0x87, 0x5a, 0x67, 0xe8, 0xaf, 0xef, 0xd1, 0xe8, 0x7c,
+	0x5c, 0x95, 0x17, 0xe1, 0xa4, 0x06, 0xba, 0x0f, 0x69, 0x76, 0x9c, 0xa0, 0x68, 0x5b, 0x51, 0x46,
+	0x41, 0x50, 0xd8, 0xe6, 0xc6, 0x9c, 0xcd, 0x5f, 0x43, 0x56, 0xf4, 0xdf, 0xd1, 0x16, 0x35, 0x31,
+	0xdc, 0xc2, 0x97, 0x2b, 0x61, 0x20, 0x97, 0x76, 0x8b, 0x4a, 0xdb, 0x53, 0x76, 0x42, 0xd2, 0xee,
+	0x89, 0x9e, 0x3c, 0xb1, 0x70, 0x00, 0xf9, 0x40, 0x3b, 0x1a, 0xed, 0x44, 0xfa, 0xd3, 0x4c, 0x7e,
+	0x35, 0x02, 0xe7, 0x2a, 0xde, 0xa2, 0x2a, 0x76, 0x95, 0x8a, 0xb7, 0x9a, 0x4f, 0x7c, 0xaa, 0xa0,
+	0x02, 0xee, 0xf3, 0x9d, 0x48, 0xe3, 0x76, 0x5e, 0xc1, 0x9c, 0xf7, 0x63, 0x14, 0x30, 0xaa, 0xa0,
+	0x02, 0xee, 0xe8, 0x9d, 0x48, 0xab, 0x73, 0x5e, 0xc1, 0x9c, 0xcb, 0x63, 0x14, 0x74, 0x3c, 0x17,
+	0x3d, 0x85, 0x0c, 0xef, 0x49, 0x21, 0x14, 0xed, 0x94, 0xc9, 0x5b, 0x21, 0x18, 0x17, 0x7a, 0x40,
+	0x85, 0xbe, 0x8b, 0xbc, 0x20, 0x7f, 0x5c, 0x47, 0x37, 0xc3, 0x11, 0xee, 0xbf, 0xfc, 0x59, 0x74,
+	0xa3, 0x09, 0x6c, 0xce, 0x75, 0x63, 0x90, 0x4c, 0x5f, 0xe4, 0x0b, 0x7b, 0x54, 0xf2, 0xde, 0x42,
+	0x5c, 0x78, 0xe5, 0xd1, 0x6e, 0x68, 0xe5, 0x9b, 0x81, 0xee, 0x8c, 0x50, 0x17, 0xe8, 0x1b, 0xf8,
+	0xea, 0xa2, 0x1d, 0x1b, 0x79, 0x6f, 0x21, 0x6e, 0xb9, 0xba, 0x40, 0x1f, 0x01, 0x7d, 0x03, 0xf9,
+	0x40, 0x0d, 0x9a, 0x2d, 0x53, 0xb4, 0xb1, 0x20, 0x57, 0x23, 0x70, 0xae, 0xe2, 0x06, 0x55, 0x51,
+	0x45, 0xdb, 0x61, 0x15, 0x17, 0x5c, 0x9e, 0x06, 0x85, 0x60, 0xd9, 0x18, 0x79, 0x72, 0xe6, 0xea,
+	0xd3, 0x72, 0x2d, 0x8a, 0xe0, 0x1a, 0x6e, 0x52, 0x0d, 0x35, 0xb4, 0x33, 0xe7, 0x33, 0x21, 0xf2,
+	0x01, 0x49, 0x8f, 0x2f, 0x31, 0x3b, 0x7a, 0x02, 0x95, 0x65, 0xb9, 0xec, 0x03, 0xc2, 0xc6, 0x2a,
+	0x28, 0xbc, 0xf1, 0x48, 0x4d, 0x89, 0x44, 0xd4, 0x9f, 0x40, 0x3e, 0x50, 0xdb, 0x65, 0xbe, 0x88,
+	0x16, 0x89, 0xe5, 0x6a, 0x04, 0xbe, 0xdc, 0xd2, 0xa9, 0x10, 0xf8, 0x0c, 0x8a, 0xa1, 0x82, 0x2b,
+	0xa2, 0x93, 0x5e, 0x54, 0xe1, 0x95, 0x77, 0x17, 0x60, 0xb8, 0x96, 0x1f, 0x53, 0x2d, 0x6f, 0x29,
+	0x72, 0x58, 0x4b, 0xb0, 0x10, 0x48, 0x26, 0xf3, 0x17, 0x12, 0x94, 0xe7, 0x4b, 0xaa, 0x68, 0x8f,
+	0x6d, 0x8a, 0x85, 0x95, 0x5c, 0xf9, 0xfa, 0x62, 0x64, 0x78, 0xeb, 0x34, 0x1a, 0xf1, 0x6a, 0x9b,
+	0xaf, 0x42, 0xd5, 0xd6, 0xd7, 0xe8, 0x02, 0x36, 0xe7, 0x0a, 0xa2, 0x7e, 0x2c, 0x47, 0x0b, 0xae,
+	0xf2, 0xde, 0x42, 0x1c, 0xd7, 0x7f, 0x9d, 0xea, 0xdf, 0x41, 0xfe, 0x79, 0xe0, 0x06, 0xc4, 0xfe,
+	0x1a, 0x72, 0x5e, 0xad, 0x12, 0x55, 0xb8, 0xf3, 0x42, 0x75, 0x3e, 0x79, 0x7b, 0x0e, 0xca, 0xe5,
+	0x2a, 0x54, 0xee, 0x75, 0xa5, 0x1a, 0x8e, 0x09, 0x4d, 0x10, 0x12, 0x5f, 0x5e, 0x40, 0x81, 0xf9,
+	0x85, 0x2b, 0xa8, 0xfa, 0x9e, 0x0a, 0xeb, 0xa8, 0x45, 0x11, 0x4b, 0x57, 0xed, 0x9e, 0x1d, 0xa0,
+	0x25, 0x9a, 0xfa, 0x00, 0x7e, 0x8d, 0x10, 0x6d, 0x0b, 0x87, 0x84, 0xb5, 0xec, 0xcc, 0x83, 0xb9,
+	0x8e, 0x2a, 0xd5, 0x71, 0x0d, 0x6d, 0x7a, 0x2e, 0xe2, 0x3f, 0x2f, 0x78, 0x00, 0x69, 0x56, 0xd0,
+	0x62, 0xf7, 0x5d, 0xa8, 0x68, 0x28, 0xa3, 0x20, 0x28, 0x56, 0x92, 0xc3, 0xf8, 0x4f, 0x44, 0x89,
+	0xab, 0xec, 0x57, 0x3f, 0xb8, 0x9c, 0x6b, 0x01, 0x48, 0xdc, 0x19, 0xce, 0x62, 0x86, 0x55, 0x4b,
+	0xc8, 0x74, 0xbf, 0x82, 0x0c, 0x2f, 0x08, 0xb1, 0x33, 0x3c, 0x5c, 0x65, 0x92, 0xb7, 0x42, 0x30,
+	0x2e, 0xf4, 0x47, 0x54, 0xe8, 0x8d, 0xc6, 0xde, 0x22, 0xa1, 0xcd, 0x57, 0xa4, 0xd0, 0xf4, 0x1a,
+	0x3d, 0x62, 0x7e, 0xa4, 0xe6, 0x04, 0xfc, 0x18, 0xaa, 0x18, 0xc9, 0x3b, 0xf3, 0xe0, 0xb8, 0x50,
+	0x0b, 0x6a, 0x40, 0xdf, 0x88, 0xdf, 0x9b, 0x8a, 0x1f, 0x0d, 0xd7, 0xfc, 0x6c, 0x2a, 0xfc, 0x88,
+	0x97, 0x77, 0x17, 0x60, 0xc2, 0x1e, 0x56, 0x0a, 0xc1, 0xdb, 0x87, 0xb8, 0xe4, 0x11, 0xfb, 0x65,
+	0xa9, 0x10, 0xbe, 0x23, 0xae, 0xb1, 0x39, 0xd1, 0xd5, 0x08, 0x9c, 0x0b, 0xde, 0xa5, 0x82, 0xb7,
+	0xd0, 0xb5, 0xf0, 0xb5, 0x46, 0xb6, 0xe3, 0xdf, 0x4a, 0xe2, 0x47, 0xa4, 0x21, 0xd3, 0x17, 0x55,
+	0x67, 0xe4, 0xdd, 0x05, 0x18, 0xae, 0xe1, 0x97, 0x54, 0x43, 0xe7, 0x60, 0x77, 0xe1, 0xc5, 0x49,
+	0x73, 0x2c, 0x51, 0xab, 0x79, 0x7c, 0x53, 0x5e, 0x42, 0x45, 0xb3, 0x84, 0x62, 0xa8, 0x44, 0xc1,
+	0x2c, 0x5a, 0x54, 0x11, 0x91, 0x77, 0x17, 0x60, 0xc2, 0x73, 0x6e, 0x2c, 0x98, 0xf3, 0x57, 0xec,
+	0x02, 0xe2, 0x1c, 0x8e, 0x7f, 0x01, 0xcd, 0xd5, 0x23, 0xe4, 0x5a, 0x14, 0xc1, 0xa5, 0x57, 0xa8,
+	0xf4, 0x12, 0x0a, 0x2d, 0x15, 0xba, 0x0f, 0x29, 0xfa, 0x72, 0x62, 0x3b, 0x21, 0xf8, 0x7a, 0x93,
+	0xaf, 0x05, 0x20, 0x5c, 0xc6, 0x0e, 0x95, 0x51, 0x46, 0x25, 0x6f, 0x43, 0x3d, 0x27, 0xf8, 0xf7,
+	0x25, 0xf4, 0x08, 0x0a, 0xc1, 0x82, 0x01, 0x33, 0x70, 0x41, 0x3d, 0x42, 0xae, 0x45, 0x11, 0x61,
+	0xe1, 0x4a, 0x9e, 0x0a, 0xa7, 0x38, 0x1a, 0x4a, 0xbf, 0x95, 0xa0, 0x10, 0x7c, 0xb3, 0x33, 0xd9,
+	0x0b, 0xea, 0x09, 0x72, 0x2d, 0x8a, 0xe0, 0xb2, 0xbb, 0x54, 0xf6, 0x67, 0x07, 0xd5, 0x80, 0xec,
+	0xe6, 0x2b, 0xf6, 0x41, 0x17, 0x91, 0x17, 0x17, 0x1e, 0x5f, 0x97, 0x63, 0x49, 0xa4, 0x06, 0xfa,
+	0x63, 0x28, 0x04, 0x1f, 0xf0, 0xcc, 0x92, 0x05, 0xb5, 0x00, 0xb9, 0x16, 0x45, 0x70, 0x4b, 0x6a,
+	0xd4, 0x12, 0xd4, 0x28, 0x87, 0xd4, 0x90, 0x35, 0x3e, 0x67, 0x39, 0x0c, 0xa3, 0x77, 0xfc, 0x1c,
+	0x26, 0xfc, 0x8a, 0x97, 0xab, 0x11, 0x38, 0x97, 0xbc, 0x45, 0x25, 0x17, 0x51, 0xd0, 0x7f, 0x87,
+	0xff, 0x25, 0xfd, 0x5d, 0xeb, 0x3f, 0x24, 0xf4, 0x0c, 0x0a, 0xe4, 0x59, 0x55, 0xe7, 0xff, 0xaa,
+	0xa3, 0x7c, 0x09, 0xbb, 0x5a, 0xdd, 0x31, 0x48, 0xbe, 0x56, 0x27, 0x65, 0x84, 0xfa, 0x44, 0x33,
+	0xb5, 0x11, 0xb6, 0xeb, 0xb4, 0x08, 0x73, 0xe1, 0xba, 0x53, 0xe7, 0x5e, 0xb3, 0x39, 0x32, 0xdc,
+	0x8b, 0xd9, 0x93, 0xfd, 0xa1, 0x35, 0x69, 0x3e, 0xd1, 0x1c, 0xfc, 0x44, 0x33, 0x75, 0xc3, 0xa5,
+	0xeb, 0x2e, 0x6f, 0x33, 0xe6, 0xcf, 0x7d, 0xf8, 0xbe, 0x8e, 0x2f, 0x0f, 0x36, 0xee, 0xec, 0xbf,
+	0xdf, 0x90, 0xa4, 0x83, 0xb2, 0x36, 0x9d, 0x8e, 0x8d, 0x21, 0xfd, 0x4d, 0x50, 0xf3, 0x5b, 0xc7,
+	0x32, 0xef, 0x45, 0x20, 0xea, 0x27, 0xb0, 0xf1, 0xe1, 0xfb, 0x1f, 0xa2, 0x0f, 0xa1, 0xa1, 0x62,
+	0x77, 0x66, 0x9b, 0x58, 0xaf, 0x3f, 0xbf, 0xc0, 0x66, 0xdd, 0xbd, 0xc0, 0x75, 0x1b, 0x3b, 0xd6,
+	0xcc, 0x1e, 0xe2, 0xba, 0x6e, 0x61, 0xa7, 0x6e, 0x5a, 0x6e, 0x1d, 0xbf, 0x30, 0x1c, 0x77, 0x1f,
+	0xa5, 0x21, 0xf9, 0xf7, 0x09, 0x29, 0xf3, 0x24, 0x4d, 0x5f, 0xde, 0x1f, 0xfc, 0xdf, 0x00, 0x46,
+	0xef, 0x76, 0xf9, 0x82, 0x34, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ToDoServiceClient is the client API for ToDoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ToDoServiceClient interface {
+	// Create new todo task
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Read todo task
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// Update todo task
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Delete todo task
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Restore deleted todo task from the trash
+	Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error)
+	// Create todo tasks in one transaction
+	BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	// Update todo tasks in one transaction
+	BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error)
+	// Delete todo tasks in one transaction
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
+	// Read all todo tasks
+	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
+	// List completion history of todo task
+	ListCompletions(ctx context.Context, in *ListCompletionsRequest, opts ...grpc.CallOption) (*ListCompletionsResponse, error)
+	// Expand occurrences of recurring todo task in the time window
+	ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*ListOccurrencesResponse, error)
+	// List change history of todo task, history is kept after the task is purged
+	ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
+	// List subtasks of todo task
+	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error)
+	// Move todo task together with its subtasks under another parent
+	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
+	// Compute progress of todo task from its subtasks
+	GetProgress(ctx context.Context, in *GetProgressRequest, opts ...grpc.CallOption) (*GetProgressResponse, error)
+	// Make todo task depend on another todo task, dependencies must not form a cycle
+	AddDependency(ctx context.Context, in *AddDependencyRequest, opts ...grpc.CallOption) (*AddDependencyResponse, error)
+	// Remove dependency of todo task on another todo task
+	RemoveDependency(ctx context.Context, in *RemoveDependencyRequest, opts ...grpc.CallOption) (*RemoveDependencyResponse, error)
+	// List todo tasks so that every task follows its prerequisites
+	ListTopological(ctx context.Context, in *ListTopologicalRequest, opts ...grpc.CallOption) (*ListTopologicalResponse, error)
+	// Add labels to todo task
+	AddLabels(ctx context.Context, in *AddLabelsRequest, opts ...grpc.CallOption) (*AddLabelsResponse, error)
+	// Remove labels from todo task
+	RemoveLabels(ctx context.Context, in *RemoveLabelsRequest, opts ...grpc.CallOption) (*RemoveLabelsResponse, error)
+	// List labels with number of todo tasks having them
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
+	// Search todo tasks by words in title and description
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Share todo task with another user, only the owner may share the task
+	Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error)
+	// Stop sharing todo task with a user
+	Unshare(ctx context.Context, in *UnshareRequest, opts ...grpc.CallOption) (*UnshareResponse, error)
+	// List users todo task is shared with
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	// Create new project
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// Read project
+	ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error)
+	// Update project
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	// Delete project, its tasks are deleted or kept in archived project
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// List projects
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	// Watch streams changes of todo tasks
+	// HTTP gateway streams the changes as newline delimited JSON
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ToDoService_WatchClient, error)
+	// Create new tenant, allowed to tenant administrators configured on server
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
+	// Update tenant e.g. suspend it or change its quota, allowed to tenant administrators
+	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
+	// Delete tenant with its tasks, projects and history, allowed to tenant administrators
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	// List tenants, allowed to tenant administrators
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+}
+
+type toDoServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewToDoServiceClient(cc *grpc.ClientConn) ToDoServiceClient {
+	return &toDoServiceClient{cc}
+}
+
+func (c *toDoServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error) {
+	out := new(UndeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Undelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error) {
+	out := new(BatchUpdateResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
+	out := new(ReadAllResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListCompletions(ctx context.Context, in *ListCompletionsRequest, opts ...grpc.CallOption) (*ListCompletionsResponse, error) {
+	out := new(ListCompletionsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListCompletions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*ListOccurrencesResponse, error) {
+	out := new(ListOccurrencesResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListOccurrences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error) {
+	out := new(ListChildrenResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) GetProgress(ctx context.Context, in *GetProgressRequest, opts ...grpc.CallOption) (*GetProgressResponse, error) {
+	out := new(GetProgressResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/GetProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) AddDependency(ctx context.Context, in *AddDependencyRequest, opts ...grpc.CallOption) (*AddDependencyResponse, error) {
+	out := new(AddDependencyResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/AddDependency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) RemoveDependency(ctx context.Context, in *RemoveDependencyRequest, opts ...grpc.CallOption) (*RemoveDependencyResponse, error) {
+	out := new(RemoveDependencyResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/RemoveDependency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListTopological(ctx context.Context, in *ListTopologicalRequest, opts ...grpc.CallOption) (*ListTopologicalResponse, error) {
+	out := new(ListTopologicalResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListTopological", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) AddLabels(ctx context.Context, in *AddLabelsRequest, opts ...grpc.CallOption) (*AddLabelsResponse, error) {
+	out := new(AddLabelsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/AddLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) RemoveLabels(ctx context.Context, in *RemoveLabelsRequest, opts ...grpc.CallOption) (*RemoveLabelsResponse, error) {
+	out := new(RemoveLabelsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/RemoveLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error) {
+	out := new(ShareResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Share", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Unshare(ctx context.Context, in *UnshareRequest, opts ...grpc.CallOption) (*UnshareResponse, error) {
+	out := new(UnshareResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/Unshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/CreateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error) {
+	out := new(ReadProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ReadProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/UpdateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ToDoService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ToDoService_serviceDesc.Streams[0], "/v1.ToDoService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toDoServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ToDoService_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type toDoServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *toDoServiceWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *toDoServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
+	out := new(CreateTenantResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/CreateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error) {
+	out := new(UpdateTenantResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/UpdateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/DeleteTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toDoServiceClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, "/v1.ToDoService/ListTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ToDoServiceServer is the server API for ToDoService service.
+type ToDoServiceServer interface {
+	// Create new todo task
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Read todo task
+	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// Update todo task
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Delete todo task
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Restore deleted todo task from the trash
+	Undelete(context.Context, *UndeleteRequest) (*UndeleteResponse, error)
+	// Create todo tasks in one transaction
+	BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error)
+	// Update todo tasks in one transaction
+	BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateResponse, error)
+	// Delete todo tasks in one transaction
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	// Read all todo tasks
+	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
+	// List completion history of todo task
+	ListCompletions(context.Context, *ListCompletionsRequest) (*ListCompletionsResponse, error)
+	// Expand occurrences of recurring todo task in the time window
+	ListOccurrences(context.Context, *ListOccurrencesRequest) (*ListOccurrencesResponse, error)
+	// List change history of todo task, history is kept after the task is purged
+	ListHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
+	// List subtasks of todo task
+	ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error)
+	// Move todo task together with its subtasks under another parent
+	Move(context.Context, *MoveRequest) (*MoveResponse, error)
+	// Compute progress of todo task from its subtasks
+	GetProgress(context.Context, *GetProgressRequest) (*GetProgressResponse, error)
+	// Make todo task depend on another todo task, dependencies must not form a cycle
+	AddDependency(context.Context, *AddDependencyRequest) (*AddDependencyResponse, error)
+	// Remove dependency of todo task on another todo task
+	RemoveDependency(context.Context, *RemoveDependencyRequest) (*RemoveDependencyResponse, error)
+	// List todo tasks so that every task follows its prerequisites
+	ListTopological(context.Context, *ListTopologicalRequest) (*ListTopologicalResponse, error)
+	// Add labels to todo task
+	AddLabels(context.Context, *AddLabelsRequest) (*AddLabelsResponse, error)
+	// Remove labels from todo task
+	RemoveLabels(context.Context, *RemoveLabelsRequest) (*RemoveLabelsResponse, error)
+	// List labels with number of todo tasks having them
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
+	// Search todo tasks by words in title and description
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Share todo task with another user, only the owner may share the task
+	Share(context.Context, *ShareRequest) (*ShareResponse, error)
+	// Stop sharing todo task with a user
+	Unshare(context.Context, *UnshareRequest) (*UnshareResponse, error)
+	// List users todo task is shared with
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	// Create new project
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// Read project
+	ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error)
+	// Update project
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	// Delete project, its tasks are deleted or kept in archived project
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// List projects
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	// Watch streams changes of todo tasks
+	// HTTP gateway streams the changes as newline delimited JSON
+	Watch(*WatchRequest, ToDoService_WatchServer) error
+	// Create new tenant, allowed to tenant administrators configured on server
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	// Update tenant e.g. suspend it or change its quota, allowed to tenant administrators
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
+	// Delete tenant with its tasks, projects and history, allowed to tenant administrators
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// List tenants, allowed to tenant administrators
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+}
+
+// UnimplementedToDoServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedToDoServiceServer struct {
+}
+
+func (*UnimplementedToDoServiceServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedToDoServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedToDoServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedToDoServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedToDoServiceServer) Undelete(ctx context.Context, req *UndeleteRequest) (*UndeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchCreate(ctx context.Context, req *BatchCreateRequest) (*BatchCreateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchUpdate(ctx context.Context, req *BatchUpdateRequest) (*BatchUpdateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
+func (*UnimplementedToDoServiceServer) BatchDelete(ctx context.Context, req *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (*UnimplementedToDoServiceServer) ReadAll(ctx context.Context, req *ReadAllRequest) (*ReadAllResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReadAll not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListCompletions(ctx context.Context, req *ListCompletionsRequest) (*ListCompletionsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListCompletions not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListOccurrences(ctx context.Context, req *ListOccurrencesRequest) (*ListOccurrencesResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListOccurrences not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListHistory(ctx context.Context, req *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListHistory not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListChildren(ctx context.Context, req *ListChildrenRequest) (*ListChildrenResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
+func (*UnimplementedToDoServiceServer) Move(ctx context.Context, req *MoveRequest) (*MoveResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (*UnimplementedToDoServiceServer) GetProgress(ctx context.Context, req *GetProgressRequest) (*GetProgressResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GetProgress not implemented")
+}
+func (*UnimplementedToDoServiceServer) AddDependency(ctx context.Context, req *AddDependencyRequest) (*AddDependencyResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method AddDependency not implemented")
+}
+func (*UnimplementedToDoServiceServer) RemoveDependency(ctx context.Context, req *RemoveDependencyRequest) (*RemoveDependencyResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RemoveDependency not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListTopological(ctx context.Context, req *ListTopologicalRequest) (*ListTopologicalResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListTopological not implemented")
+}
+func (*UnimplementedToDoServiceServer) AddLabels(ctx context.Context, req *AddLabelsRequest) (*AddLabelsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method AddLabels not implemented")
+}
+func (*UnimplementedToDoServiceServer) RemoveLabels(ctx context.Context, req *RemoveLabelsRequest) (*RemoveLabelsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RemoveLabels not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListLabels(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (*UnimplementedToDoServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedToDoServiceServer) Share(ctx context.Context, req *ShareRequest) (*ShareResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+func (*UnimplementedToDoServiceServer) Unshare(ctx context.Context, req *UnshareRequest) (*UnshareResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Unshare not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListShares(ctx context.Context, req *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (*UnimplementedToDoServiceServer) CreateProject(ctx context.Context, req *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) ReadProject(ctx context.Context, req *ReadProjectRequest) (*ReadProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReadProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) UpdateProject(ctx context.Context, req *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) DeleteProject(ctx context.Context, req *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (*UnimplementedToDoServiceServer) Watch(req *WatchRequest, srv ToDoService_WatchServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedToDoServiceServer) CreateTenant(ctx context.Context, req *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (*UnimplementedToDoServiceServer) UpdateTenant(ctx context.Context, req *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (*UnimplementedToDoServiceServer) DeleteTenant(ctx context.Context, req *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (*UnimplementedToDoServiceServer) ListTenants(ctx context.Context, req *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+
+func RegisterToDoServiceServer(s *grpc.Server, srv ToDoServiceServer) {
+	s.RegisterService(&_ToDoService_serviceDesc, srv)
+}
+
+func _ToDoService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Read(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Undelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Undelete(ctx, req.(*UndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchCreate(ctx, req.(*BatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchUpdate(ctx, req.(*BatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ReadAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ReadAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ReadAll(ctx, req.(*ReadAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListCompletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListCompletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListCompletions(ctx, req.(*ListCompletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListOccurrences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListOccurrences(ctx, req.(*ListOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListChildren(ctx, req.(*ListChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Move(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_GetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).GetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/GetProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).GetProgress(ctx, req.(*GetProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_AddDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).AddDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/AddDependency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).AddDependency(ctx, req.(*AddDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_RemoveDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).RemoveDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/RemoveDependency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).RemoveDependency(ctx, req.(*RemoveDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListTopological_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopologicalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListTopological(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Read",
+		FullMethod: "/v1.ToDoService/ListTopological",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Read(ctx, req.(*ReadRequest))
+		return srv.(ToDoServiceServer).ListTopological(ctx, req.(*ListTopologicalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
+func _ToDoService_AddLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Update(ctx, in)
+		return srv.(ToDoServiceServer).AddLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Update",
+		FullMethod: "/v1.ToDoService/AddLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Update(ctx, req.(*UpdateRequest))
+		return srv.(ToDoServiceServer).AddLabels(ctx, req.(*AddLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _ToDoService_RemoveLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).Delete(ctx, in)
+		return srv.(ToDoServiceServer).RemoveLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/Delete",
+		FullMethod: "/v1.ToDoService/RemoveLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(ToDoServiceServer).RemoveLabels(ctx, req.(*RemoveLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ToDoService_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAllRequest)
+func _ToDoService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ToDoServiceServer).ReadAll(ctx, in)
+		return srv.(ToDoServiceServer).ListLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v1.ToDoService/ReadAll",
+		FullMethod: "/v1.ToDoService/ListLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ToDoServiceServer).ReadAll(ctx, req.(*ReadAllRequest))
+		return srv.(ToDoServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Share(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Share",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Share(ctx, req.(*ShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Unshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).Unshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/Unshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).Unshare(ctx, req.(*UnshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListShares(ctx, req.(*ListSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ReadProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ReadProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ReadProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ReadProject(ctx, req.(*ReadProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/UpdateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ToDoServiceServer).Watch(m, &toDoServiceWatchServer{stream})
+}
+
+type ToDoService_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type toDoServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *toDoServiceWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ToDoService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/CreateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/UpdateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/DeleteTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToDoService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToDoServiceServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.ToDoService/ListTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToDoServiceServer).ListTenants(ctx, req.(*ListTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -906,11 +6849,133 @@ var _ToDoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ToDoService_Delete_Handler,
 		},
+		{
+			MethodName: "Undelete",
+			Handler:    _ToDoService_Undelete_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ToDoService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _ToDoService_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _ToDoService_BatchDelete_Handler,
+		},
 		{
 			MethodName: "ReadAll",
 			Handler:    _ToDoService_ReadAll_Handler,
 		},
+		{
+			MethodName: "ListCompletions",
+			Handler:    _ToDoService_ListCompletions_Handler,
+		},
+		{
+			MethodName: "ListOccurrences",
+			Handler:    _ToDoService_ListOccurrences_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _ToDoService_ListHistory_Handler,
+		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _ToDoService_ListChildren_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _ToDoService_Move_Handler,
+		},
+		{
+			MethodName: "GetProgress",
+			Handler:    _ToDoService_GetProgress_Handler,
+		},
+		{
+			MethodName: "AddDependency",
+			Handler:    _ToDoService_AddDependency_Handler,
+		},
+		{
+			MethodName: "RemoveDependency",
+			Handler:    _ToDoService_RemoveDependency_Handler,
+		},
+		{
+			MethodName: "ListTopological",
+			Handler:    _ToDoService_ListTopological_Handler,
+		},
+		{
+			MethodName: "AddLabels",
+			Handler:    _ToDoService_AddLabels_Handler,
+		},
+		{
+			MethodName: "RemoveLabels",
+			Handler:    _ToDoService_RemoveLabels_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _ToDoService_ListLabels_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ToDoService_Search_Handler,
+		},
+		{
+			MethodName: "Share",
+			Handler:    _ToDoService_Share_Handler,
+		},
+		{
+			MethodName: "Unshare",
+			Handler:    _ToDoService_Unshare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _ToDoService_ListShares_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _ToDoService_CreateProject_Handler,
+		},
+		{
+			MethodName: "ReadProject",
+			Handler:    _ToDoService_ReadProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ToDoService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ToDoService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _ToDoService_ListProjects_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _ToDoService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _ToDoService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _ToDoService_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _ToDoService_ListTenants_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ToDoService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "todo-service.proto",
 }
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strings"
+)
+
+//SchemeAPIKey is the authorization scheme of API keys e.g. "ApiKey 6f1ed002ab5595859014ebf0951522d9"
+const SchemeAPIKey = "ApiKey"
+
+//APIKeys authenticates callers by static API keys.
+//Only SHA-256 hashes of the keys are kept in memory so that looking a key up does not leak it by timing.
+type APIKeys struct {
+	subjects map[[sha256.Size]byte]string
+}
+
+//NewAPIKeys creates authenticator of API keys mapped to subjects of their owners
+func NewAPIKeys(keys map[string]string) *APIKeys {
+	a := &APIKeys{subjects: make(map[[sha256.Size]byte]string, len(keys))}
+	for key, subject := range keys {
+		a.subjects[sha256.Sum256([]byte(key))] = subject
+	}
+	return a
+}
+
+//LoadAPIKeys reads API keys from the file with "<subject> <key>" lines, empty lines and lines starting with # are skipped
+func LoadAPIKeys(path string) (*APIKeys, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open API keys file -> %s", err.Error())
+	}
+	defer f.Close()
+
+	keys := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("API keys file %s:%d: want '<subject> <key>'", path, line)
+		}
+		if _, ok := keys[fields[1]]; ok {
+			return nil, fmt.Errorf("API keys file %s:%d: key is used already", path, line)
+		}
+		keys[fields[1]] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read API keys file -> %s", err.Error())
+	}
+	return NewAPIKeys(keys), nil
+}
+
+//Authenticate returns the owner of API key sent with ApiKey scheme
+func (a *APIKeys) Authenticate(ctx context.Context, scheme string, credentials string) (*Principal, error) {
+	if !strings.EqualFold(scheme, SchemeAPIKey) {
+		return nil, ErrUnsupportedScheme
+	}
+	subject, ok := a.subjects[sha256.Sum256([]byte(credentials))]
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+	return &Principal{Subject: subject, Method: MethodAPIKey}, nil
+}
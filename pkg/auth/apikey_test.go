@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "apikeys")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		key     string
+		want    *Principal
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			content: "# API keys of the clients\nalice 6f1ed002ab5595859014ebf0951522d9\n\nbob   0be2ff5a2bd3d2e4e9d0b3a8\n",
+			key:     "0be2ff5a2bd3d2e4e9d0b3a8",
+			want:    &Principal{Subject: "bob", Method: MethodAPIKey},
+		},
+		{
+			name:    "Unknown key",
+			content: "alice 6f1ed002ab5595859014ebf0951522d9\n",
+			key:     "6f1ed002ab5595859014ebf0951522d8",
+		},
+		{
+			name:    "Missing key",
+			content: "alice\n",
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate key",
+			content: "alice secret\nbob secret\n",
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatalf("failed to write API keys file %d: %v", i, err)
+			}
+			keys, err := LoadAPIKeys(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadAPIKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got, err := keys.Authenticate(ctx, SchemeAPIKey, tt.key)
+			if tt.want == nil && err != ErrInvalidCredentials {
+				t.Errorf("APIKeys.Authenticate() = %v, %v, want %v", got, err, ErrInvalidCredentials)
+			}
+			if tt.want != nil && (err != nil || !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("APIKeys.Authenticate() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadAPIKeys(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("LoadAPIKeys() error = nil, want error of missing file")
+	}
+	if _, err := NewAPIKeys(nil).Authenticate(ctx, SchemeBearer, "token"); err != ErrUnsupportedScheme {
+		t.Errorf("APIKeys.Authenticate() error = %v, want %v", err, ErrUnsupportedScheme)
+	}
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+//ErrUnsupportedScheme is returned by an authenticator when it does not verify credentials of the scheme
+var ErrUnsupportedScheme = errors.New("unsupported authorization scheme")
+
+//ErrInvalidCredentials is returned by an authenticator when the credentials are not valid
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+//Authentication methods of principals
+const (
+	MethodAPIKey      = "api-key"
+	MethodJWT         = "jwt"
+	MethodCertificate = "certificate"
+)
+
+//Principal is the authenticated caller
+type Principal struct {
+	//Subject identifies the caller e.g. owner of API key, "sub" claim of JWT or subject of client certificate
+	Subject string
+
+	//Method is the authentication method of the caller: MethodAPIKey, MethodJWT or MethodCertificate
+	Method string
+}
+
+//Authenticator verifies credentials of the caller sent in authorization header e.g. "Bearer <token>"
+type Authenticator interface {
+	//Authenticate returns the principal of the credentials of the scheme,
+	//ErrUnsupportedScheme when it does not verify the scheme and ErrInvalidCredentials or other error when they are not valid
+	Authenticate(ctx context.Context, scheme string, credentials string) (*Principal, error)
+}
+
+//Authenticators try authenticators in order until one of them supports the scheme
+type Authenticators []Authenticator
+
+//Authenticate returns the principal of the credentials verified by the first authenticator supporting the scheme
+func (a Authenticators) Authenticate(ctx context.Context, scheme string, credentials string) (*Principal, error) {
+	for _, authenticator := range a {
+		p, err := authenticator.Authenticate(ctx, scheme, credentials)
+		if err != ErrUnsupportedScheme {
+			return p, err
+		}
+	}
+	return nil, ErrUnsupportedScheme
+}
+
+//ParseAuthorization splits value of authorization header into the scheme and the credentials, false is returned when
+//it has no credentials
+func ParseAuthorization(authorization string) (scheme string, credentials string, ok bool) {
+	parts := strings.SplitN(strings.TrimSpace(authorization), " ", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	credentials = strings.TrimSpace(parts[1])
+	return parts[0], credentials, len(credentials) > 0
+}
+
+//principalKey is the context key of the principal
+type principalKey struct{}
+
+//NewContext returns context carrying the authenticated principal
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+//FromContext returns the authenticated principal of the call, false is returned when the caller is not authenticated
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
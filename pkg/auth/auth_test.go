@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseAuthorization(t *testing.T) {
+	tests := []struct {
+		name            string
+		authorization   string
+		wantScheme      string
+		wantCredentials string
+		wantOK          bool
+	}{
+		{"Bearer", "Bearer abc.def.ghi", "Bearer", "abc.def.ghi", true},
+		{"API key", " ApiKey  secret ", "ApiKey", "secret", true},
+		{"No credentials", "Bearer ", "", "", false},
+		{"No scheme", "secret", "", "", false},
+		{"Empty", "", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, credentials, ok := ParseAuthorization(tt.authorization)
+			if ok != tt.wantOK || (ok && (scheme != tt.wantScheme || credentials != tt.wantCredentials)) {
+				t.Errorf("ParseAuthorization() = %q, %q, %v, want %q, %q, %v", scheme, credentials, ok, tt.wantScheme, tt.wantCredentials, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAuthenticators(t *testing.T) {
+	ctx := context.Background()
+	keys := NewAPIKeys(map[string]string{"secret": "alice"})
+	verifier, err := NewJWTVerifier([]*Key{{Algorithm: "HS256", Secret: []byte("key")}}, "", "")
+	if err != nil {
+		t.Fatalf("NewJWTVerifier() error = %v", err)
+	}
+	a := Authenticators{verifier, keys}
+
+	p, err := a.Authenticate(ctx, SchemeAPIKey, "secret")
+	if err != nil || !reflect.DeepEqual(p, &Principal{Subject: "alice", Method: MethodAPIKey}) {
+		t.Errorf("Authenticators.Authenticate() = %v, %v, want alice", p, err)
+	}
+	if _, err := a.Authenticate(ctx, SchemeAPIKey, "guess"); err != ErrInvalidCredentials {
+		t.Errorf("Authenticators.Authenticate() error = %v, want %v", err, ErrInvalidCredentials)
+	}
+	if _, err := a.Authenticate(ctx, "Basic", "YWxpY2U6c2VjcmV0"); err != ErrUnsupportedScheme {
+		t.Errorf("Authenticators.Authenticate() error = %v, want %v", err, ErrUnsupportedScheme)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if p, ok := FromContext(ctx); ok {
+		t.Errorf("FromContext() = %v, want no principal", p)
+	}
+	want := &Principal{Subject: "alice", Method: MethodJWT}
+	if p, ok := FromContext(NewContext(ctx, want)); !ok || p != want {
+		t.Errorf("FromContext() = %v, %v, want %v", p, ok, want)
+	}
+}
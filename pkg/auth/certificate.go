@@ -0,0 +1,21 @@
+package auth
+
+import "crypto/x509"
+
+//CertificateSubjectMetadata is the metadata key of the subject of client certificate verified by HTTP gateway,
+//it is trusted only in calls of the gateway
+const CertificateSubjectMetadata = "x-client-cert-subject"
+
+//CertificateSubject returns subject of the principal authenticated by the client certificate: the common name
+//of the certificate subject or the whole distinguished name when it has no common name
+func CertificateSubject(cert *x509.Certificate) string {
+	if len(cert.Subject.CommonName) > 0 {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
+
+//CertificatePrincipal returns principal authenticated by the verified client certificate
+func CertificatePrincipal(cert *x509.Certificate) *Principal {
+	return &Principal{Subject: CertificateSubject(cert), Method: MethodCertificate}
+}
@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+//SchemeBearer is the authorization scheme of JWT e.g. "Bearer eyJhbGciOiJIUzI1NiJ9..."
+const SchemeBearer = "Bearer"
+
+//clockSkew is the time tolerated between clocks of the token issuer and the server when times of the token are checked
+const clockSkew = time.Minute
+
+//algorithms are hash functions of supported HMAC signature algorithms
+var algorithms = map[string]func() hash.Hash{
+	"HS256": sha256.New,
+	"HS384": sha512.New384,
+	"HS512": sha512.New,
+}
+
+//Key is a secret HMAC key verifying JWT signatures
+type Key struct {
+	//ID is matched with "kid" header of the token, it may be empty when the key set has one key
+	ID string
+
+	//Algorithm is the signature algorithm the key is used with: HS256, HS384 or HS512
+	Algorithm string
+
+	//Secret is the HMAC secret
+	Secret []byte
+}
+
+//jsonWebKey is a symmetric key of JSON Web Key Set
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	K   string `json:"k"`
+}
+
+//LoadKeySet reads symmetric keys from JSON Web Key Set file e.g.
+//{"keys":[{"kty":"oct","kid":"2020-06","alg":"HS256","k":"<base64url encoded secret>"}]}
+func LoadKeySet(path string) ([]*Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key set file -> %s", err.Error())
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWT key set file -> %s", err.Error())
+	}
+
+	keys := make([]*Key, 0, len(set.Keys))
+	for i, k := range set.Keys {
+		if k.Kty != "oct" {
+			return nil, fmt.Errorf("keys[%d] of JWT key set has unsupported type '%s', only 'oct' keys are supported", i, k.Kty)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.K, "="))
+		if err != nil || len(secret) == 0 {
+			return nil, fmt.Errorf("keys[%d] of JWT key set has invalid secret", i)
+		}
+		keys = append(keys, &Key{ID: k.Kid, Algorithm: k.Alg, Secret: secret})
+	}
+	return keys, nil
+}
+
+//JWTVerifier authenticates callers by HMAC signed JSON Web Tokens sent with Bearer scheme.
+//Tokens must have "sub" and "exp" claims, "iss" and "aud" claims are checked when issuer and audience are set.
+type JWTVerifier struct {
+	keys     map[string]*Key
+	issuer   string
+	audience string
+
+	//now returns current time the token times are compared to
+	now func() time.Time
+}
+
+//NewJWTVerifier creates authenticator of tokens signed by the keys, issuer and audience are not checked when empty
+func NewJWTVerifier(keys []*Key, issuer string, audience string) (*JWTVerifier, error) {
+	v := &JWTVerifier{keys: make(map[string]*Key, len(keys)), issuer: issuer, audience: audience, now: time.Now}
+	for _, k := range keys {
+		if _, ok := algorithms[k.Algorithm]; !ok {
+			return nil, fmt.Errorf("JWT key '%s' has unsupported algorithm '%s'", k.ID, k.Algorithm)
+		}
+		if _, ok := v.keys[k.ID]; ok {
+			return nil, fmt.Errorf("JWT key ID '%s' is used already", k.ID)
+		}
+		v.keys[k.ID] = k
+	}
+	if len(v.keys) == 0 {
+		return nil, errors.New("JWT key set has no keys")
+	}
+	return v, nil
+}
+
+//header is JOSE header of the token
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+//claims are registered claims of the token checked by the verifier
+type claims struct {
+	Sub string   `json:"sub"`
+	Iss string   `json:"iss"`
+	Aud audience `json:"aud"`
+	Exp *int64   `json:"exp"`
+	Nbf *int64   `json:"nbf"`
+}
+
+//audience is "aud" claim which is either a string or an array of strings
+type audience []string
+
+//UnmarshalJSON parses both forms of audience
+func (a *audience) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*a = audience{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = audience(list)
+	return nil
+}
+
+//contains reports whether the audience contains the name
+func (a audience) contains(name string) bool {
+	for _, s := range a {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+//Authenticate returns the subject of valid token sent with Bearer scheme
+func (v *JWTVerifier) Authenticate(ctx context.Context, scheme string, credentials string) (*Principal, error) {
+	if !strings.EqualFold(scheme, SchemeBearer) {
+		return nil, ErrUnsupportedScheme
+	}
+	c, err := v.verify(credentials)
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{Subject: c.Sub, Method: MethodJWT}, nil
+}
+
+//verify checks signature and claims of the token and returns its claims
+func (v *JWTVerifier) verify(token string) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("token is not a signed JWT")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("token has invalid header -> %s", err.Error())
+	}
+	key, err := v.key(h)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("token has invalid signature encoding")
+	}
+	mac := hmac.New(algorithms[key.Algorithm], key.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.New("token has invalid signature")
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, fmt.Errorf("token has invalid claims -> %s", err.Error())
+	}
+	now := v.now()
+	switch {
+	case len(c.Sub) == 0:
+		return nil, errors.New("token has no subject")
+	case c.Exp == nil:
+		return nil, errors.New("token has no expiration time")
+	case now.After(time.Unix(*c.Exp, 0).Add(clockSkew)):
+		return nil, errors.New("token is expired")
+	case c.Nbf != nil && now.Add(clockSkew).Before(time.Unix(*c.Nbf, 0)):
+		return nil, errors.New("token is not valid yet")
+	case len(v.issuer) > 0 && c.Iss != v.issuer:
+		return nil, fmt.Errorf("token is not issued by '%s'", v.issuer)
+	case len(v.audience) > 0 && !c.Aud.contains(v.audience):
+		return nil, fmt.Errorf("token is not issued for '%s'", v.audience)
+	}
+	return &c, nil
+}
+
+//key returns the key the token is signed with, the only key of the set is used when the token has no key ID
+func (v *JWTVerifier) key(h header) (*Key, error) {
+	key, ok := v.keys[h.Kid]
+	if !ok && len(h.Kid) == 0 && len(v.keys) == 1 {
+		for _, k := range v.keys {
+			key, ok = k, true
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("token is signed by unknown key '%s'", h.Kid)
+	}
+	//algorithm is taken from the key so that the token can't choose a weaker one
+	if h.Alg != key.Algorithm {
+		return nil, fmt.Errorf("token is signed by algorithm '%s', key '%s' is used with '%s'", h.Alg, key.ID, key.Algorithm)
+	}
+	return key, nil
+}
+
+//decodeSegment decodes base64url encoded JSON segment of the token
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
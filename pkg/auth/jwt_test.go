@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+//sign returns JWT of the claims signed by HMAC algorithm with the secret
+func sign(t *testing.T, alg string, kid string, secret []byte, claims map[string]interface{}) string {
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal JWT segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	h := map[string]string{"alg": alg, "typ": "JWT"}
+	if len(kid) > 0 {
+		h["kid"] = kid
+	}
+	payload := encode(h) + "." + encode(claims)
+	hash, ok := algorithms[alg]
+	if !ok {
+		return payload + "."
+	}
+	mac := hmac.New(hash, secret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTVerifier(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 6, 20, 10, 0, 0, 0, time.UTC)
+	june, july := []byte("june secret"), []byte("july secret")
+	v, err := NewJWTVerifier([]*Key{
+		{ID: "2020-06", Algorithm: "HS256", Secret: june},
+		{ID: "2020-07", Algorithm: "HS512", Secret: july},
+	}, "https://auth.example.com", "tasq")
+	if err != nil {
+		t.Fatalf("NewJWTVerifier() error = %v", err)
+	}
+	v.now = func() time.Time { return tm }
+
+	//valid returns claims valid at tm changed by the changes
+	valid := func(changes map[string]interface{}) map[string]interface{} {
+		claims := map[string]interface{}{
+			"sub": "alice",
+			"iss": "https://auth.example.com",
+			"aud": []string{"tasq", "billing"},
+			"exp": tm.Add(time.Hour).Unix(),
+			"nbf": tm.Add(-time.Hour).Unix(),
+		}
+		for name, value := range changes {
+			if value == nil {
+				delete(claims, name)
+				continue
+			}
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"OK", sign(t, "HS256", "2020-06", june, valid(nil)), false},
+		{"Second key", sign(t, "HS512", "2020-07", july, valid(map[string]interface{}{"aud": "tasq"})), false},
+		{"Expired within clock skew", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"exp": tm.Add(-30 * time.Second).Unix()})), false},
+		{"Expired", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"exp": tm.Add(-time.Hour).Unix()})), true},
+		{"Not valid yet", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"nbf": tm.Add(time.Hour).Unix()})), true},
+		{"No expiration time", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"exp": nil})), true},
+		{"No subject", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"sub": nil})), true},
+		{"Other issuer", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"iss": "https://evil.example.com"})), true},
+		{"Other audience", sign(t, "HS256", "2020-06", june, valid(map[string]interface{}{"aud": "billing"})), true},
+		{"Wrong secret", sign(t, "HS256", "2020-06", july, valid(nil)), true},
+		{"Unknown key", sign(t, "HS256", "2020-08", june, valid(nil)), true},
+		{"No key ID with more keys", sign(t, "HS256", "", june, valid(nil)), true},
+		{"Other algorithm", sign(t, "HS512", "2020-06", june, valid(nil)), true},
+		{"Unsigned", sign(t, "none", "2020-06", nil, valid(nil)), true},
+		{"Not JWT", "secret", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Authenticate(ctx, SchemeBearer, tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JWTVerifier.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if want := (&Principal{Subject: "alice", Method: MethodJWT}); err == nil && !reflect.DeepEqual(got, want) {
+				t.Errorf("JWTVerifier.Authenticate() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	if _, err := v.Authenticate(ctx, SchemeAPIKey, "secret"); err != ErrUnsupportedScheme {
+		t.Errorf("JWTVerifier.Authenticate() error = %v, want %v", err, ErrUnsupportedScheme)
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jwks")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		want    []*Key
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			content: `{"keys":[{"kty":"oct","kid":"2020-06","alg":"HS256","k":"anVuZSBzZWNyZXQ"}]}`,
+			want:    []*Key{{ID: "2020-06", Algorithm: "HS256", Secret: []byte("june secret")}},
+		},
+		{
+			name:    "Padded secret",
+			content: `{"keys":[{"kty":"oct","alg":"HS384","k":"anVuZSBzZWNyZXQ="}]}`,
+			want:    []*Key{{Algorithm: "HS384", Secret: []byte("june secret")}},
+		},
+		{
+			name:    "RSA key",
+			content: `{"keys":[{"kty":"RSA","kid":"2020-06","alg":"RS256","n":"0vx7agoebGcQSuuPiLJXZpt","e":"AQAB"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Empty secret",
+			content: `{"keys":[{"kty":"oct","alg":"HS256","k":""}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Not JSON",
+			content: "kty=oct",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatalf("failed to write key set file: %v", err)
+			}
+			got, err := LoadKeySet(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadKeySet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadKeySet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, keys := range [][]*Key{
+		nil,
+		{{Algorithm: "RS256", Secret: []byte("secret")}},
+		{{ID: "a", Algorithm: "HS256", Secret: []byte("secret")}, {ID: "a", Algorithm: "HS512", Secret: []byte("secret")}},
+	} {
+		if _, err := NewJWTVerifier(keys, "", ""); err == nil {
+			t.Errorf("NewJWTVerifier(%v) error = nil, want error", keys)
+		}
+	}
+}
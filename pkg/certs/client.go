@@ -0,0 +1,31 @@
+package certs
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+//LoadClientConfig returns TLS configuration of client verifying server by the CA certificates of caFile or by the
+//system CA certificates when it is empty. Client sends the certificate of certFile and keyFile when they are given.
+func LoadClientConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(caFile) > 0 {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (len(certFile) > 0) != (len(keyFile) > 0) {
+		return nil, fmt.Errorf("client certificate and key must be given together")
+	}
+	if len(certFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate and key -> %s", err.Error())
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,51 @@
+package certs
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadClientConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mod := time.Now()
+	ca := issue(t, "Test CA", nil)
+	caFile, _ := ca.write(t, dir, "ca", mod)
+	certFile, keyFile := issue(t, "alice", ca, x509.ExtKeyUsageClientAuth).write(t, dir, "alice", mod)
+
+	tests := []struct {
+		name                  string
+		caFile, certFile, key string
+		wantRoots, wantCerts  bool
+		wantErr               bool
+	}{
+		{"System CA", "", "", "", false, false, false},
+		{"CA and client certificate", caFile, certFile, keyFile, true, true, false},
+		{"Certificate without key", caFile, certFile, "", false, false, true},
+		{"Not PEM CA", keyFile, "", "", false, false, true},
+		{"Missing CA", filepath.Join(dir, "missing.crt"), "", "", false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := LoadClientConfig(tt.caFile, tt.certFile, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadClientConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if (cfg.RootCAs != nil) != tt.wantRoots || (len(cfg.Certificates) > 0) != tt.wantCerts {
+				t.Errorf("LoadClientConfig() roots = %v, certificates = %d, want roots %v, certificates %v",
+					cfg.RootCAs != nil, len(cfg.Certificates), tt.wantRoots, tt.wantCerts)
+			}
+		})
+	}
+}
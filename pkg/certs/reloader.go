@@ -0,0 +1,264 @@
+package certs
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"go.uber.org/zap"
+)
+
+//fileVersion identifies the version of a file by its modification time and size
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+//Reloader serves the certificate and the key of server and verifies client certificates by the CA certificates,
+//the files are loaded again when they change so certificates are replaced without restart
+type Reloader struct {
+	//certFile and keyFile are PEM files of the certificate chain and the private key of server
+	certFile, keyFile string
+
+	//caFile is PEM file of the CA certificates verifying client certificates, client certificates are not
+	//requested when it is empty
+	caFile string
+
+	mu sync.RWMutex
+
+	//cert is the current certificate of server
+	cert *tls.Certificate
+
+	//clientCAs are the current CA certificates verifying client certificates
+	clientCAs *x509.CertPool
+
+	//own are fingerprints of every certificate served since start, HTTP gateway uses them to call gRPC server
+	own map[[sha256.Size]byte]bool
+
+	//versions are the versions of the loaded files by file name
+	versions map[string]fileVersion
+}
+
+//LoadReloader loads the certificate, the key and the CA certificates of client certificates when caFile is not empty
+func LoadReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		own:      map[[sha256.Size]byte]bool{},
+	}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+//files returns the files loaded by the reloader
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if len(r.caFile) > 0 {
+		files = append(files, r.caFile)
+	}
+	return files
+}
+
+//Reload loads the files again when one of them changed since the last load and reports whether certificates
+//are replaced. The current certificates are kept when the changed files are not valid e.g. while the certificate
+//is replaced but the key is not yet.
+func (r *Reloader) Reload() (bool, error) {
+	versions := map[string]fileVersion{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, fmt.Errorf("failed to stat TLS file -> %s", err.Error())
+		}
+		versions[file] = fileVersion{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	r.mu.RLock()
+	unchanged := r.cert != nil
+	for file, v := range versions {
+		if loaded, ok := r.versions[file]; !ok || !loaded.modTime.Equal(v.modTime) || loaded.size != v.size {
+			unchanged = false
+		}
+	}
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to load TLS certificate and key -> %s", err.Error())
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return false, fmt.Errorf("failed to parse TLS certificate -> %s", err.Error())
+	}
+	var clientCAs *x509.CertPool
+	if len(r.caFile) > 0 {
+		if clientCAs, err = loadCertPool(r.caFile); err != nil {
+			return false, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.clientCAs, r.versions = &cert, clientCAs, versions
+	r.own[sha256.Sum256(cert.Leaf.Raw)] = true
+	return true, nil
+}
+
+//Watch reloads the files every interval until the context is done
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	logger.Log.Info("watching TLS certificate files...", zap.Strings("files", r.files()), zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		reloaded, err := r.Reload()
+		if err != nil {
+			logger.Log.Error("failed to reload TLS certificates, the previous certificates are kept", zap.Error(err))
+			continue
+		}
+		if reloaded {
+			r.mu.RLock()
+			leaf := r.cert.Leaf
+			r.mu.RUnlock()
+			logger.Log.Info("TLS certificates reloaded", zap.String("subject", leaf.Subject.String()), zap.Time("notAfter", leaf.NotAfter))
+		}
+	}
+}
+
+//VerifiesClients reports whether client certificates are requested and verified
+func (r *Reloader) VerifiesClients() bool {
+	return len(r.caFile) > 0
+}
+
+//Own reports whether the certificate is one of the certificates served since start
+func (r *Reloader) Own(cert *x509.Certificate) bool {
+	return r.ownRaw(cert.Raw)
+}
+
+//ownRaw reports whether the DER encoded certificate is one of the certificates served since start
+func (r *Reloader) ownRaw(raw []byte) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.own[sha256.Sum256(raw)]
+}
+
+//certificate returns the current certificate of server
+func (r *Reloader) certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+//ServerConfig returns TLS configuration of gRPC server and HTTPS gateway serving the current certificate.
+//Client certificates are optional, certificate sent by client must be verified by the current CA certificates
+//when the reloader has them, see VerifyClient. Clients without certificate are left to API key or JWT authentication.
+func (r *Reloader) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		},
+	}
+	if r.VerifiesClients() {
+		//client certificates are verified by the CA certificates loaded last instead of the fixed ClientCAs
+		cfg.ClientAuth = tls.RequestClientCert
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return nil
+			}
+			return r.VerifyClient(rawCerts)
+		}
+	}
+	return cfg
+}
+
+//VerifyClient verifies DER encoded client certificate chain by the current CA certificates. Certificates served
+//since start are accepted too as HTTP gateway sends them, they do not need to be issued by the CA or for clients.
+func (r *Reloader) VerifyClient(rawCerts [][]byte) error {
+	if len(rawCerts) == 0 {
+		return errors.New("client certificate is required")
+	}
+	if r.ownRaw(rawCerts[0]) {
+		return nil
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse client certificate -> %s", err.Error())
+		}
+		certs[i] = cert
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	r.mu.RLock()
+	roots := r.clientCAs
+	r.mu.RUnlock()
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify client certificate -> %s", err.Error())
+	}
+	return nil
+}
+
+//GatewayConfig returns TLS configuration of HTTP gateway calling gRPC server of the same process. The gateway
+//sends the current certificate of server as its client certificate and accepts only a certificate served since
+//start, so the certificate does not need to be valid for the address the gateway dials.
+func (r *Reloader) GatewayConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//server certificate is verified by VerifyPeerCertificate instead of the name and the roots
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("gRPC server sent no certificate")
+			}
+			if !r.ownRaw(rawCerts[0]) {
+				return errors.New("gRPC server sent certificate it does not serve")
+			}
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		},
+	}
+}
+
+//loadCertPool reads PEM file of CA certificates
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificates file -> %s", err.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("failed to parse CA certificates file '%s' -> no PEM certificates found", file)
+	}
+	return pool, nil
+}
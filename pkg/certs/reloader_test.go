@@ -0,0 +1,283 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/protocol/grpc/middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//testCert is certificate with its private key issued by the test
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+//issue creates certificate of the common name signed by the issuer, certificate is self-signed CA when issuer is nil
+func issue(t *testing.T, cn string, issuer *testCert, usage ...x509.ExtKeyUsage) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  usage,
+		DNSNames:     []string{"localhost"},
+	}
+	parent, signer := template, key
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+//write writes PEM files of the certificate and its key to the directory, the files are named by the name
+func (c *testCert) write(t *testing.T, dir, name string, mod time.Time) (certFile, keyFile string) {
+	key, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	for file, block := range map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: c.cert.Raw},
+		keyFile:  {Type: "EC PRIVATE KEY", Bytes: key},
+	} {
+		if err := ioutil.WriteFile(file, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", file, err)
+		}
+		//every write is seen as change even when the file system keeps modification times in seconds
+		if err := os.Chtimes(file, mod, mod); err != nil {
+			t.Fatalf("failed to change modification time of %s: %v", file, err)
+		}
+	}
+	return certFile, keyFile
+}
+
+func TestReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mod := time.Now().Add(-time.Hour)
+	ca := issue(t, "Test CA", nil)
+	caFile, _ := ca.write(t, dir, "ca", mod)
+	first := issue(t, "server", ca, x509.ExtKeyUsageServerAuth)
+	certFile, keyFile := first.write(t, dir, "server", mod)
+
+	r, err := LoadReloader(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("LoadReloader() error = %v", err)
+	}
+	cert, err := r.ServerConfig().GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil || !cert.Leaf.Equal(first.cert) {
+		t.Errorf("ServerConfig().GetCertificate() = %v, %v, want the first certificate", cert, err)
+	}
+	if reloaded, err := r.Reload(); reloaded || err != nil {
+		t.Errorf("Reloader.Reload() = %v, %v, want false, nil for unchanged files", reloaded, err)
+	}
+
+	second := issue(t, "server", ca, x509.ExtKeyUsageServerAuth)
+	second.write(t, dir, "server", mod.Add(time.Second))
+	if reloaded, err := r.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reloader.Reload() = %v, %v, want true, nil for changed files", reloaded, err)
+	}
+	cert, err = r.ServerConfig().GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil || !cert.Leaf.Equal(second.cert) {
+		t.Errorf("ServerConfig().GetCertificate() = %v, %v, want the second certificate", cert, err)
+	}
+	//certificate of gateway connected before the reload is still accepted
+	if !r.Own(first.cert) || !r.Own(second.cert) {
+		t.Errorf("Reloader.Own() = false, want true for certificates served since start")
+	}
+
+	//certificate not matching the key does not replace the current one
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: first.cert.Raw}), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", certFile, err)
+	}
+	if err := os.Chtimes(certFile, mod.Add(2*time.Second), mod.Add(2*time.Second)); err != nil {
+		t.Fatalf("failed to change modification time of %s: %v", certFile, err)
+	}
+	if reloaded, err := r.Reload(); reloaded || err == nil {
+		t.Errorf("Reloader.Reload() = %v, %v, want false and error for mismatched key", reloaded, err)
+	}
+	if cert, _ := r.GatewayConfig().GetClientCertificate(&tls.CertificateRequestInfo{}); !cert.Leaf.Equal(second.cert) {
+		t.Errorf("GatewayConfig().GetClientCertificate() = %v, want the second certificate", cert.Leaf.Subject)
+	}
+}
+
+func TestReloader_VerifyClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mod := time.Now().Add(-time.Hour)
+	ca, other := issue(t, "Test CA", nil), issue(t, "Other CA", nil)
+	caFile, _ := ca.write(t, dir, "ca", mod)
+	server := issue(t, "server", ca, x509.ExtKeyUsageServerAuth)
+	certFile, keyFile := server.write(t, dir, "server", mod)
+
+	r, err := LoadReloader(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("LoadReloader() error = %v", err)
+	}
+	if !r.VerifiesClients() {
+		t.Errorf("Reloader.VerifiesClients() = false, want true with CA certificates")
+	}
+
+	tests := []struct {
+		name    string
+		chain   [][]byte
+		wantErr bool
+	}{
+		{"Client of CA", [][]byte{issue(t, "alice", ca, x509.ExtKeyUsageClientAuth).cert.Raw}, false},
+		{"Server itself", [][]byte{server.cert.Raw}, false},
+		{"Client of other CA", [][]byte{issue(t, "mallory", other, x509.ExtKeyUsageClientAuth).cert.Raw}, true},
+		{"Server of CA", [][]byte{issue(t, "www", ca, x509.ExtKeyUsageServerAuth).cert.Raw}, true},
+		{"Without certificate", nil, true},
+		{"Invalid certificate", [][]byte{[]byte("certificate")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.VerifyClient(tt.chain); (err != nil) != tt.wantErr {
+				t.Errorf("Reloader.VerifyClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	//clients of CA added by reload are verified without restart
+	other.write(t, dir, "ca", mod.Add(time.Second))
+	if reloaded, err := r.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reloader.Reload() = %v, %v, want true, nil for changed CA", reloaded, err)
+	}
+	if err := r.VerifyClient([][]byte{issue(t, "mallory", other, x509.ExtKeyUsageClientAuth).cert.Raw}); err != nil {
+		t.Errorf("Reloader.VerifyClient() error = %v, want nil after reload", err)
+	}
+}
+
+//principalServer answers Read with the subject of the authenticated caller as title
+type principalServer struct {
+	v1.ToDoServiceServer
+}
+
+func (principalServer) Read(ctx context.Context, req *v1.ReadRequest) (*v1.ReadResponse, error) {
+	p, _ := auth.FromContext(ctx)
+	return &v1.ReadResponse{Api: req.Api, ToDo: &v1.ToDo{Id: req.Id, Title: p.Subject}}, nil
+}
+
+func TestReloader_ServerConfigOptionalClientCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mod := time.Now().Add(-time.Hour)
+	ca, other := issue(t, "Test CA", nil), issue(t, "Other CA", nil)
+	caFile, _ := ca.write(t, dir, "ca", mod)
+	certFile, keyFile := issue(t, "server", ca, x509.ExtKeyUsageServerAuth).write(t, dir, "server", mod)
+	r, err := LoadReloader(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("LoadReloader() error = %v", err)
+	}
+
+	//server authenticates callers by client certificate or API key like the configured server does
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	opts := middleware.AddLogging(zap.NewNop(), []grpc.ServerOption{grpc.Creds(credentials.NewTLS(r.ServerConfig()))},
+		middleware.ClientCertificate(r.Own),
+		middleware.Authentication(auth.NewAPIKeys(map[string]string{"secret": "alice"})))
+	server := grpc.NewServer(opts...)
+	v1.RegisterToDoServiceServer(server, principalServer{})
+	go server.Serve(listen)
+	defer server.Stop()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	//client returns TLS configuration of client sending the certificate, no certificate is sent when it is nil
+	client := func(c *testCert) *tls.Config {
+		cfg := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if c != nil {
+			cfg.Certificates = []tls.Certificate{{Certificate: [][]byte{c.cert.Raw}, PrivateKey: c.key, Leaf: c.cert}}
+		}
+		return cfg
+	}
+
+	tests := []struct {
+		name          string
+		tls           *tls.Config
+		authorization string
+		want          string
+		code          codes.Code
+	}{
+		{"API key without certificate", client(nil), "ApiKey secret", "alice", codes.OK},
+		{"Without certificate and credentials", client(nil), "", "", codes.Unauthenticated},
+		{"Invalid API key without certificate", client(nil), "ApiKey guess", "", codes.Unauthenticated},
+		{"Certificate of CA", client(issue(t, "bob", ca, x509.ExtKeyUsageClientAuth)), "", "bob", codes.OK},
+		{"Certificate of other CA", client(issue(t, "mallory", other, x509.ExtKeyUsageClientAuth)), "ApiKey secret", "", codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := grpc.Dial(listen.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tt.tls)))
+			if err != nil {
+				t.Fatalf("failed to dial: %v", err)
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if len(tt.authorization) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tt.authorization)
+			}
+			res, err := v1.NewToDoServiceClient(conn).Read(ctx, &v1.ReadRequest{Api: "v1", Id: 1})
+			if status.Code(err) != tt.code {
+				t.Fatalf("ToDoServiceClient.Read() error = %v, wantCode %v", err, tt.code)
+			}
+			if got := res.GetToDo().GetTitle(); got != tt.want {
+				t.Errorf("ToDoServiceClient.Read() principal = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
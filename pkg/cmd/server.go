@@ -2,16 +2,30 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"flag"
 	"fmt"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"bitbucket.org/liamstask/goose/lib/goose"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/certs"
 	"github.com/basebandit/go-grpc/pkg/logger"
 	"github.com/basebandit/go-grpc/pkg/protocol/grpc"
+	"github.com/basebandit/go-grpc/pkg/protocol/grpc/middleware"
 	"github.com/basebandit/go-grpc/pkg/protocol/rest"
+	"github.com/basebandit/go-grpc/pkg/rbac"
+	"github.com/basebandit/go-grpc/pkg/reminder"
 	v1 "github.com/basebandit/go-grpc/pkg/service/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"github.com/basebandit/go-grpc/pkg/storage/sqlstore"
+	"github.com/basebandit/go-grpc/pkg/trash"
+	"go.uber.org/zap"
 )
 
 //Config is our configuration for our server
@@ -23,6 +37,12 @@ type Config struct {
 	//HTTPPort is the TCP port to listen for HTTP/REST gateway connections
 	HTTPPort string
 
+	//DBDriver is the database driver to use: mysql, postgres, sqlite3 or memory
+	DBDriver string
+
+	//DBPath is the database file used by the sqlite3 driver
+	DBPath string
+
 	//DBHost is the host of database
 	DBHost string
 
@@ -35,6 +55,9 @@ type Config struct {
 	//DBName is the name of the database
 	DBName string
 
+	//DBSSLMode is the SSL mode of the postgres connection e.g. disable, require, verify-full
+	DBSSLMode string
+
 	//DBMigrations is the migrations path of the db schema migrations
 	DBMigrations string
 
@@ -43,6 +66,73 @@ type Config struct {
 
 	//LogTimeFormat is print time format for logger e.g. 2006-01-02T15:04:05Z07:00
 	LogTimeFormat string
+
+	//StatusTransitions are allowed task status changes e.g. TODO:IN_PROGRESS,DONE;IN_PROGRESS:DONE
+	//Default transitions of the service are used when it is empty
+	StatusTransitions string
+
+	//ReminderInterval is how often due reminders are checked, 0 disables reminders
+	ReminderInterval time.Duration
+
+	//ReminderWebhook is the URL reminders are POSTed to as JSON
+	ReminderWebhook string
+
+	//ReminderWebhookTimeout is the time limit of webhook request
+	ReminderWebhookTimeout time.Duration
+
+	//ReminderCommand is the command with space separated arguments run for every reminder e.g. "/usr/local/bin/remind --urgent",
+	//arguments are not unquoted so a script is needed for shell features
+	ReminderCommand string
+
+	//TrashRetention is how long deleted tasks are kept in the trash before they are purged
+	TrashRetention time.Duration
+
+	//TrashPurgeInterval is how often the trash is purged, 0 disables purging
+	TrashPurgeInterval time.Duration
+
+	//RequireVersion makes Update and Delete fail unless client sends the version of the task it read
+	RequireVersion bool
+
+	//AuthAPIKeys is the file of API keys with "<subject> <key>" lines
+	AuthAPIKeys string
+
+	//AuthJWTKeys is the JSON Web Key Set file of HMAC keys verifying bearer tokens
+	AuthJWTKeys string
+
+	//AuthJWTIssuer is the required "iss" claim of bearer tokens, it is not checked when empty
+	AuthJWTIssuer string
+
+	//AuthJWTAudience is the required "aud" claim of bearer tokens, it is not checked when empty
+	AuthJWTAudience string
+
+	//DefaultOwner owns tasks created by callers without identity and tasks stored before tasks had owners
+	DefaultOwner string
+
+	//MultiTenant scopes every call to the tenant sent in x-tenant-id metadata (X-Tenant-ID header)
+	MultiTenant bool
+
+	//TenantAdmins are comma separated users allowed to manage tenants in multi-tenant mode
+	TenantAdmins string
+
+	//RBACPolicy is the JSON file of roles and their bindings to users, calls the policy does not allow are rejected.
+	//Every call is allowed when it is empty.
+	RBACPolicy string
+
+	//RBACReloadInterval is how often the policy file is checked for changes, 0 disables reloading
+	RBACReloadInterval time.Duration
+
+	//TLSCert is the PEM file of the certificate chain gRPC server and HTTP gateway serve, they serve plaintext when it is empty
+	TLSCert string
+
+	//TLSKey is the PEM file of the private key of the certificate
+	TLSKey string
+
+	//TLSClientCA is the PEM file of CA certificates verifying client certificates, clients which send certificate
+	//are authenticated by its subject, the others by API key or JWT
+	TLSClientCA string
+
+	//TLSReloadInterval is how often the TLS files are checked for changes, 0 disables reloading
+	TLSReloadInterval time.Duration
 }
 
 //RunServer runs gRPC server and HTTP gateway
@@ -54,13 +144,37 @@ func RunServer() error {
 	var cfg Config
 	flag.StringVar(&cfg.GRPCPort, "grpc-port", "", "gRPC port to bind")
 	flag.StringVar(&cfg.HTTPPort, "http-port", "", "HTTP port to bind")
+	flag.StringVar(&cfg.DBDriver, "db-driver", "mysql", "Database driver: mysql, postgres, sqlite3 or memory (no persistence)")
+	flag.StringVar(&cfg.DBPath, "db-path", "", "Database file for sqlite3 driver e.g. tasq.db")
 	flag.StringVar(&cfg.DBHost, "host", "", "Database host")
 	flag.StringVar(&cfg.DBUser, "user", "", "Database user")
 	flag.StringVar(&cfg.DBPassword, "password", "", "Database password")
 	flag.StringVar(&cfg.DBName, "db", "", "Database name")
+	flag.StringVar(&cfg.DBSSLMode, "sslmode", "disable", "SSL mode for postgres driver")
 	flag.IntVar(&cfg.LogLevel, "log-level", 0, "Global log level")
 	flag.StringVar(&cfg.LogTimeFormat, "log-time-format", "", "Print time format for logger e.g. 2006-01-02T15:04:05Z07:00")
 	flag.StringVar(&cfg.DBMigrations, "migrations", "", "Database schema migrations")
+	flag.StringVar(&cfg.StatusTransitions, "status-transitions", "", "Allowed task status transitions e.g. TODO:IN_PROGRESS,DONE;IN_PROGRESS:DONE")
+	flag.DurationVar(&cfg.ReminderInterval, "reminder-interval", 10*time.Second, "How often due reminders are checked, 0 disables reminders")
+	flag.StringVar(&cfg.ReminderWebhook, "reminder-webhook", "", "URL reminders are POSTed to as JSON")
+	flag.DurationVar(&cfg.ReminderWebhookTimeout, "reminder-webhook-timeout", 10*time.Second, "Time limit of reminder webhook request")
+	flag.StringVar(&cfg.ReminderCommand, "reminder-command", "", "Command run for every reminder, reminder is passed as JSON on standard input")
+	flag.DurationVar(&cfg.TrashRetention, "trash-retention", 30*24*time.Hour, "How long deleted tasks are kept in the trash")
+	flag.DurationVar(&cfg.TrashPurgeInterval, "trash-purge-interval", time.Hour, "How often the trash is purged, 0 disables purging")
+	flag.BoolVar(&cfg.RequireVersion, "require-version", false, "Require version of the task or If-Match header on Update and Delete")
+	flag.StringVar(&cfg.AuthAPIKeys, "auth-api-keys", "", "File of API keys with '<subject> <key>' lines, callers send 'Authorization: ApiKey <key>'")
+	flag.StringVar(&cfg.AuthJWTKeys, "auth-jwt-keys", "", "JSON Web Key Set file of HMAC keys verifying 'Authorization: Bearer <token>'")
+	flag.StringVar(&cfg.AuthJWTIssuer, "auth-jwt-issuer", "", "Required issuer of bearer tokens")
+	flag.StringVar(&cfg.AuthJWTAudience, "auth-jwt-audience", "", "Required audience of bearer tokens")
+	flag.StringVar(&cfg.DefaultOwner, "default-owner", "anonymous", "Owner of tasks created by callers without identity and of tasks stored without owner")
+	flag.BoolVar(&cfg.MultiTenant, "multi-tenant", false, "Scope every call to the tenant sent in X-Tenant-ID header or x-tenant-id metadata")
+	flag.StringVar(&cfg.TenantAdmins, "tenant-admins", "", "Comma separated users allowed to create, update, delete and list tenants in multi-tenant mode")
+	flag.StringVar(&cfg.RBACPolicy, "rbac-policy", "", "JSON file of RBAC roles and bindings, calls the policy does not allow are rejected")
+	flag.DurationVar(&cfg.RBACReloadInterval, "rbac-reload-interval", 10*time.Second, "How often the RBAC policy file is checked for changes, 0 disables reloading")
+	flag.StringVar(&cfg.TLSCert, "tls-cert", "", "PEM file of the certificate chain of gRPC server and HTTPS gateway, they serve plaintext without it")
+	flag.StringVar(&cfg.TLSKey, "tls-key", "", "PEM file of the private key of the TLS certificate")
+	flag.StringVar(&cfg.TLSClientCA, "tls-client-ca", "", "PEM file of CA certificates verifying optional client certificates, callers sending certificate are authenticated by its subject")
+	flag.DurationVar(&cfg.TLSReloadInterval, "tls-reload-interval", 10*time.Second, "How often the TLS files are checked for changes, 0 disables reloading")
 	flag.Parse()
 
 	if len(cfg.GRPCPort) == 0 {
@@ -71,56 +185,275 @@ func RunServer() error {
 		return fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
 	}
 
-	if len(cfg.DBMigrations) == 0 {
-		return fmt.Errorf("invalid database migrations path: '%s'", cfg.DBMigrations)
+	if len(strings.TrimSpace(cfg.DefaultOwner)) == 0 {
+		return fmt.Errorf("invalid default owner of tasks: '%s'", cfg.DefaultOwner)
+	}
+
+	if (len(cfg.TLSCert) > 0) != (len(cfg.TLSKey) > 0) {
+		return fmt.Errorf("TLS certificate and key must be given together, use -tls-cert and -tls-key")
+	}
+
+	if len(cfg.TLSClientCA) > 0 && len(cfg.TLSCert) == 0 {
+		return fmt.Errorf("client certificates are verified only by TLS server, use -tls-cert and -tls-key")
+	}
+
+	tenantAdmins := splitList(cfg.TenantAdmins)
+	if len(tenantAdmins) > 0 && !cfg.MultiTenant {
+		return fmt.Errorf("tenant administrators are allowed only in multi-tenant mode, use -multi-tenant")
 	}
 
 	if err := logger.Init(cfg.LogLevel, cfg.LogTimeFormat); err != nil {
 		return fmt.Errorf("failed to initialize logger: %v", err)
 	}
-	//Lets chek if migrations Directory path exists
-	if _, err := os.Stat(cfg.DBMigrations); os.IsNotExist(err) {
-		return fmt.Errorf("you need to provide the path to directory where your migrations are stored:  -migrations <migrations_path>")
+
+	transitions := v1.DefaultTransitions
+	if len(cfg.StatusTransitions) > 0 {
+		var err error
+		if transitions, err = v1.ParseTransitions(cfg.StatusTransitions); err != nil {
+			return fmt.Errorf("invalid status transitions: %v", err)
+		}
+	}
+
+	var certificates *certs.Reloader
+	if len(cfg.TLSCert) > 0 {
+		var err error
+		if certificates, err = certs.LoadReloader(cfg.TLSCert, cfg.TLSKey, cfg.TLSClientCA); err != nil {
+			return err
+		}
+		if cfg.TLSReloadInterval > 0 {
+			go certificates.Watch(ctx, cfg.TLSReloadInterval)
+		}
+	} else {
+		logger.Log.Warn("TLS is disabled, configure -tls-cert and -tls-key")
 	}
-	//add MySQL driver specific parameter to parse date/time
-	//Drop it for another database
-	param := "parseTime=true"
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?%s", cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBName, param)
+	interceptors, err := authInterceptors(cfg, certificates)
+	if err != nil {
+		return err
+	}
+	authenticated := len(interceptors) > 0
 
-	db, err := sql.Open("mysql", dsn)
+	repo, closeRepo, err := openRepository(cfg)
+	if err != nil {
+		return err
+	}
+	defer closeRepo()
 
+	//tasks stored before they had owners belong to the default owner
+	assigned, err := repo.AssignOwner(ctx, cfg.DefaultOwner)
 	if err != nil {
-		return fmt.Errorf("failed to open database: %v", err)
+		return fmt.Errorf("failed to assign tasks to default owner: %v", err)
+	}
+	if assigned > 0 {
+		logger.Log.Info("assigned tasks without owner to default owner", zap.String("owner", cfg.DefaultOwner), zap.Int64("tasks", assigned))
 	}
-	defer db.Close()
 
-	//Lets run our migrations first
-	if err := runMigrations(db, cfg.DBMigrations); err != nil {
-		return fmt.Errorf("failed to run migrations: %v", err)
+	opts := []v1.Option{v1.WithTransitions(transitions), v1.WithDefaultOwner(cfg.DefaultOwner)}
+	if cfg.RequireVersion {
+		opts = append(opts, v1.WithRequiredVersion())
+	}
+	//tenant is looked up after the caller is authenticated
+	if cfg.MultiTenant {
+		if len(tenantAdmins) == 0 {
+			logger.Log.Warn("nobody manages tenants, configure -tenant-admins")
+		} else if !authenticated {
+			logger.Log.Warn("tenant administrators must be authenticated, nobody manages tenants without authentication")
+		}
+		opts = append(opts, v1.WithTenantAdmins(tenantAdmins...))
+		interceptors = append(interceptors, middleware.Tenancy(repo, v1.TenantMethods...))
 	}
+	//calls are authorized last so that rejected calls of unknown tenants are not reported as denied
+	if len(cfg.RBACPolicy) > 0 {
+		if !authenticated {
+			logger.Log.Warn("RBAC policy applies only to roles of every caller while authentication is disabled")
+		}
+		enforcer, err := rbac.LoadEnforcer(cfg.RBACPolicy)
+		if err != nil {
+			return err
+		}
+		if cfg.RBACReloadInterval > 0 {
+			go enforcer.Watch(ctx, cfg.RBACReloadInterval)
+		}
+		interceptors = append(interceptors, middleware.Authorization(enforcer, v1.Resource))
+	}
+	v1API := v1.NewToDoServiceServer(repo, opts...)
 
-	v1API := v1.NewToDoServiceServer(db)
+	//run reminder scheduler
+	if cfg.ReminderInterval > 0 {
+		go reminder.NewScheduler(repo, cfg.ReminderInterval, v1.ClosedStatuses(), reminderNotifiers(cfg)...).Run(ctx)
+	}
+
+	//run trash purger
+	if cfg.TrashPurgeInterval > 0 {
+		go trash.NewPurger(repo, cfg.TrashRetention, cfg.TrashPurgeInterval).Run(ctx)
+	}
+
+	//serve TLS when certificate is configured, HTTP gateway calls gRPC server with its certificate
+	var serverTLS, gatewayTLS *tls.Config
+	if certificates != nil {
+		serverTLS, gatewayTLS = certificates.ServerConfig(), certificates.GatewayConfig()
+	}
 
 	//run HTTP/REST gateway
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, serverTLS, gatewayTLS)
 	}()
 
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort)
+	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, serverTLS, interceptors...)
+}
+
+//authInterceptors creates interceptors authenticating callers by client certificates and the configured credentials,
+//authentication is disabled when neither client certificates nor API keys nor JWT keys are configured
+func authInterceptors(cfg Config, certificates *certs.Reloader) ([]middleware.Interceptor, error) {
+	var interceptors []middleware.Interceptor
+	if certificates != nil && certificates.VerifiesClients() {
+		interceptors = append(interceptors, middleware.ClientCertificate(certificates.Own))
+	}
+
+	var authenticators auth.Authenticators
+	if len(cfg.AuthAPIKeys) > 0 {
+		keys, err := auth.LoadAPIKeys(cfg.AuthAPIKeys)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, keys)
+	}
+	if len(cfg.AuthJWTKeys) > 0 {
+		keys, err := auth.LoadKeySet(cfg.AuthJWTKeys)
+		if err != nil {
+			return nil, err
+		}
+		verifier, err := auth.NewJWTVerifier(keys, cfg.AuthJWTIssuer, cfg.AuthJWTAudience)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, verifier)
+	}
+	//callers without client certificate are rejected unless they send credentials
+	if len(authenticators) > 0 || len(interceptors) > 0 {
+		interceptors = append(interceptors, middleware.Authentication(authenticators))
+	}
+	if len(interceptors) == 0 {
+		logger.Log.Warn("authentication is disabled, configure -auth-api-keys, -auth-jwt-keys or -tls-client-ca")
+	}
+	return interceptors, nil
+}
+
+//splitList returns the non-empty items of comma separated list
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+//reminderNotifiers creates notifiers of the reminder configuration, reminders are always logged
+func reminderNotifiers(cfg Config) []reminder.Notifier {
+	notifiers := []reminder.Notifier{reminder.NewLogNotifier()}
+	if len(cfg.ReminderWebhook) > 0 {
+		notifiers = append(notifiers, reminder.NewWebhookNotifier(cfg.ReminderWebhook, cfg.ReminderWebhookTimeout))
+	}
+	if args := strings.Fields(cfg.ReminderCommand); len(args) > 0 {
+		notifiers = append(notifiers, reminder.NewCommandNotifier(args[0], args[1:]...))
+	}
+	return notifiers
 }
 
-func runMigrations(db *sql.DB, migrationsPath string) error {
+//openRepository creates ToDo repository of the configured database driver.
+//The returned function releases resources held by the repository.
+func openRepository(cfg Config) (storage.Repository, func(), error) {
+	if cfg.DBDriver == "memory" {
+		return memory.NewToDoRepository(), func() {}, nil
+	}
+
+	if len(cfg.DBMigrations) == 0 {
+		return nil, nil, fmt.Errorf("invalid database migrations path: '%s'", cfg.DBMigrations)
+	}
+
+	//Lets chek if migrations Directory path exists
+	if _, err := os.Stat(cfg.DBMigrations); os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("you need to provide the path to directory where your migrations are stored:  -migrations <migrations_path>")
+	}
+
+	dialect, migrationDialect, err := databaseDialects(cfg.DBDriver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//Lets run our migrations first
+	if err := runMigrations(db, cfg.DBDriver, migrationDialect, cfg.DBMigrations); err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed to run migrations: %v", err)
+	}
+
+	return sqlstore.NewToDoRepository(db, dialect), func() { db.Close() }, nil
+}
+
+//openDatabase opens connection pool of the configured database driver
+func openDatabase(cfg Config) (*sql.DB, error) {
+	var dsn string
+	switch cfg.DBDriver {
+	case "mysql":
+		//add MySQL driver specific parameter to parse date/time
+		param := "parseTime=true"
+
+		dsn = fmt.Sprintf("%s:%s@tcp(%s)/%s?%s", cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBName, param)
+	case "postgres":
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(cfg.DBUser, cfg.DBPassword),
+			Host:     cfg.DBHost,
+			Path:     "/" + cfg.DBName,
+			RawQuery: url.Values{"sslmode": {cfg.DBSSLMode}}.Encode(),
+		}
+		dsn = u.String()
+	case "sqlite3":
+		if len(cfg.DBPath) == 0 {
+			return nil, fmt.Errorf("invalid database file for sqlite3 driver: '%s'", cfg.DBPath)
+		}
+		//wait for the write lock instead of failing with "database is locked"
+		dsn = fmt.Sprintf("file:%s?_busy_timeout=5000", cfg.DBPath)
+	default:
+		return nil, fmt.Errorf("unsupported database driver: '%s'", cfg.DBDriver)
+	}
+
+	db, err := sql.Open(cfg.DBDriver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %v", err)
+	}
+	return db, nil
+}
+
+//databaseDialects returns repository and goose SQL dialects of the database driver
+func databaseDialects(driver string) (sqlstore.Dialect, goose.SqlDialect, error) {
+	switch driver {
+	case "mysql":
+		return sqlstore.MySQL, &goose.MySqlDialect{}, nil
+	case "postgres":
+		return sqlstore.Postgres, &goose.PostgresDialect{}, nil
+	case "sqlite3":
+		return sqlstore.SQLite3, &goose.Sqlite3Dialect{}, nil
+	}
+	return 0, nil, fmt.Errorf("unsupported database driver: '%s'", driver)
+}
 
+func runMigrations(db *sql.DB, driver string, dialect goose.SqlDialect, migrationsPath string) error {
 	// Setup the goose configuration
 	migrateConf := &goose.DBConf{
 		MigrationsDir: migrationsPath,
 		Env:           "production",
 		Driver: goose.DBDriver{
-			Name:    "mysql",
-			OpenStr: "mars:mars@/ToDo?parseTime=true,charset=utf8",
+			Name:    driver,
 			Import:  "database/sql",
-			Dialect: &goose.MySqlDialect{},
+			Dialect: dialect,
 		},
 	}
 	// Get the latest possible migration
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//authorizationMetadata is the metadata key of the caller credentials e.g. "Bearer <token>",
+//HTTP gateway sets it from Authorization header
+const authorizationMetadata = "authorization"
+
+//Interceptor is a pair of unary and stream interceptors doing the same for both kinds of calls
+type Interceptor struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+//Authentication returns interceptor which rejects calls without valid credentials,
+//principal of the authenticated caller is put in the context of the call. Callers authenticated by ClientCertificate
+//need not send credentials, the principal of the credentials replaces the one of the certificate when they do.
+func Authentication(a auth.Authenticator) Interceptor {
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, err := authenticate(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := authenticate(stream.Context(), a)
+			if err != nil {
+				return err
+			}
+			wrapped := grpc_middleware.WrapServerStream(stream)
+			wrapped.WrappedContext = ctx
+			return handler(srv, wrapped)
+		},
+	}
+}
+
+//authenticate verifies credentials sent in metadata of the call and returns context with the principal of the caller
+func authenticate(ctx context.Context, a auth.Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadata)
+	if _, ok := auth.FromContext(ctx); ok && len(values) == 0 {
+		return ctx, nil
+	}
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata is required")
+	}
+	scheme, credentials, ok := auth.ParseAuthorization(values[0])
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata has invalid format, want '<scheme> <credentials>'")
+	}
+
+	p, err := a.Authenticate(ctx, scheme, credentials)
+	if err == auth.ErrUnsupportedScheme {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization scheme '%s' is not supported", scheme)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed -> %s", err.Error())
+	}
+
+	//subject is logged with the call
+	grpc_ctxtags.Extract(ctx).Set("auth.sub", p.Subject)
+	return auth.NewContext(ctx, p), nil
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//fakeStream is server stream of Watch call carrying the context
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+//Context returns context of the call
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthentication(t *testing.T) {
+	i := Authentication(auth.NewAPIKeys(map[string]string{"secret": "alice"}))
+	incoming := func(authorization ...string) context.Context {
+		md := metadata.MD{}
+		if len(authorization) > 0 {
+			md.Set(authorizationMetadata, authorization...)
+		}
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"OK", incoming("ApiKey secret"), codes.OK},
+		{"Scheme is case insensitive", incoming("apikey secret"), codes.OK},
+		{"Invalid key", incoming("ApiKey guess"), codes.Unauthenticated},
+		{"Unsupported scheme", incoming("Basic YWxpY2U6c2VjcmV0"), codes.Unauthenticated},
+		{"Invalid format", incoming("secret"), codes.Unauthenticated},
+		{"Missing", incoming(), codes.Unauthenticated},
+		{"No metadata", context.Background(), codes.Unauthenticated},
+		{"Authenticated by certificate", auth.NewContext(incoming(), &auth.Principal{Subject: "alice", Method: auth.MethodCertificate}), codes.OK},
+		{"Credentials replace certificate", auth.NewContext(incoming("ApiKey secret"), &auth.Principal{Subject: "bob", Method: auth.MethodCertificate}), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//subject is the principal found by the handler
+			var subject string
+			unary := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if p, ok := auth.FromContext(ctx); ok {
+					subject = p.Subject
+				}
+				return req, nil
+			}
+			_, err := i.Unary(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/v1.ToDoService/Read"}, unary)
+			if status.Code(err) != tt.code {
+				t.Errorf("Authentication().Unary error = %v, wantCode %v", err, tt.code)
+			}
+			if tt.code == codes.OK && subject != "alice" {
+				t.Errorf("Authentication().Unary principal = %q, want alice", subject)
+			}
+
+			subject = ""
+			stream := func(srv interface{}, stream grpc.ServerStream) error {
+				_, err := unary(stream.Context(), nil)
+				return err
+			}
+			err = i.Stream(nil, &fakeStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: "/v1.ToDoService/Watch"}, stream)
+			if status.Code(err) != tt.code {
+				t.Errorf("Authentication().Stream error = %v, wantCode %v", err, tt.code)
+			}
+			if tt.code == codes.OK && subject != "alice" {
+				t.Errorf("Authentication().Stream principal = %q, want alice", subject)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+//ClientCertificate returns interceptor which puts principal of the client certificate in the context of the call.
+//Server must verify client certificates during TLS handshake, see certs.Reloader.ServerConfig. Gateway reports
+//certificates of HTTP gateway, the gateway calls on behalf of the subject of client certificate it sends in
+//auth.CertificateSubjectMetadata metadata. Calls without certificate are left to Authentication.
+func ClientCertificate(gateway func(cert *x509.Certificate) bool) Interceptor {
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(certificatePrincipal(ctx, gateway), req)
+		},
+		Stream: func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			wrapped := grpc_middleware.WrapServerStream(stream)
+			wrapped.WrappedContext = certificatePrincipal(stream.Context(), gateway)
+			return handler(srv, wrapped)
+		},
+	}
+}
+
+//certificatePrincipal returns context with the principal of the client certificate of the call,
+//the context is returned unchanged when the caller sent no certificate
+func certificatePrincipal(ctx context.Context, gateway func(cert *x509.Certificate) bool) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return ctx
+	}
+	cert := info.State.PeerCertificates[0]
+
+	principal := auth.CertificatePrincipal(cert)
+	if gateway(cert) {
+		//gateway itself is not a principal, HTTP client without certificate is left to Authentication
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(auth.CertificateSubjectMetadata)
+		if len(values) == 0 {
+			return ctx
+		}
+		principal = &auth.Principal{Subject: values[0], Method: auth.MethodCertificate}
+	}
+
+	//subject is logged with the call
+	grpc_ctxtags.Extract(ctx).Set("auth.sub", principal.Subject)
+	return auth.NewContext(ctx, principal)
+}
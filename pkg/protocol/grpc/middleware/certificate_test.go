@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientCertificate(t *testing.T) {
+	gatewayCert := &x509.Certificate{Raw: []byte("gateway"), Subject: pkix.Name{CommonName: "localhost"}}
+	i := ClientCertificate(func(cert *x509.Certificate) bool {
+		return bytes.Equal(cert.Raw, gatewayCert.Raw)
+	})
+	//call comes from the peer with the certificate and the metadata
+	call := func(cert *x509.Certificate, md metadata.MD) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		state := tls.ConnectionState{}
+		if cert != nil {
+			state.PeerCertificates = []*x509.Certificate{cert}
+		}
+		return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+	forwarded := metadata.Pairs(auth.CertificateSubjectMetadata, "bob")
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"Common name", call(&x509.Certificate{Raw: []byte("alice"), Subject: pkix.Name{CommonName: "alice"}}, nil), "alice"},
+		{"Distinguished name", call(&x509.Certificate{Raw: []byte("ops"), Subject: pkix.Name{Organization: []string{"Ops"}}}, nil), "O=Ops"},
+		{"Forwarded by gateway", call(gatewayCert, forwarded), "bob"},
+		{"Gateway without client certificate", call(gatewayCert, nil), ""},
+		{"Forwarded by other client", call(&x509.Certificate{Raw: []byte("alice"), Subject: pkix.Name{CommonName: "alice"}}, forwarded), "alice"},
+		{"Without certificate", call(nil, forwarded), ""},
+		{"Plaintext", metadata.NewIncomingContext(context.Background(), forwarded), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//subject is the principal found by the handler
+			var subject string
+			unary := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if p, ok := auth.FromContext(ctx); ok {
+					subject = p.Subject
+				}
+				return req, nil
+			}
+			if _, err := i.Unary(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/v1.ToDoService/Read"}, unary); err != nil {
+				t.Errorf("ClientCertificate().Unary error = %v", err)
+			}
+			if subject != tt.want {
+				t.Errorf("ClientCertificate().Unary principal = %q, want %q", subject, tt.want)
+			}
+
+			subject = ""
+			stream := func(srv interface{}, stream grpc.ServerStream) error {
+				_, err := unary(stream.Context(), nil)
+				return err
+			}
+			if err := i.Stream(nil, &fakeStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: "/v1.ToDoService/Watch"}, stream); err != nil {
+				t.Errorf("ClientCertificate().Stream error = %v", err)
+			}
+			if subject != tt.want {
+				t.Errorf("ClientCertificate().Stream principal = %q, want %q", subject, tt.want)
+			}
+		})
+	}
+}
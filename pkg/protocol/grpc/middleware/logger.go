@@ -21,7 +21,8 @@ func codeToLevel(code codes.Code) zapcore.Level {
 }
 
 //AddLogging returns grpc.Server config option that turn in logging.
-func AddLogging(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerOption {
+//Interceptors run after the logging ones in the given order so that the calls they reject are logged.
+func AddLogging(logger *zap.Logger, opts []grpc.ServerOption, interceptors ...Interceptor) []grpc.ServerOption {
 	//shared options for the logger, with a custom gRPC code to log level function.
 	o := []grpc_zap.Option{
 		grpc_zap.WithLevels(codeToLevel),
@@ -31,16 +32,22 @@ func AddLogging(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerOptio
 	grpc_zap.ReplaceGrpcLogger(logger)
 
 	// Add unary interceptor
-	opts = append(opts, grpc_middleware.WithUnaryServerChain(
+	unary := []grpc.UnaryServerInterceptor{
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_zap.UnaryServerInterceptor(logger, o...),
-	))
+	}
 
 	// Add streaminterceptor (added as an example here)
-	opts = append(opts, grpc_middleware.WithStreamServerChain(
+	stream := []grpc.StreamServerInterceptor{
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_zap.StreamServerInterceptor(logger, o...),
-	))
+	}
+
+	for _, i := range interceptors {
+		unary = append(unary, i.Unary)
+		stream = append(stream, i.Stream)
+	}
+	opts = append(opts, grpc_middleware.WithUnaryServerChain(unary...), grpc_middleware.WithStreamServerChain(stream...))
 
 	return opts
 }
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Authorization returns interceptor which rejects calls the policy of the enforcer does not allow. Caller is the
+//subject of the principal put in the context by Authentication, resource returns name of the resource the method
+//is called on, stream calls are checked before any message is received so their request is nil.
+func Authorization(e *rbac.Enforcer, resource func(method string, req interface{}) string) Interceptor {
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authorize(ctx, e, info.FullMethod, resource(info.FullMethod, req)); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorize(stream.Context(), e, info.FullMethod, resource(info.FullMethod, nil)); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		},
+	}
+}
+
+//authorize checks that the caller is allowed to call the method on the resource
+func authorize(ctx context.Context, e *rbac.Enforcer, method, resource string) error {
+	//unauthenticated callers have only the roles granted to every caller
+	subject := ""
+	if p, ok := auth.FromContext(ctx); ok {
+		subject = p.Subject
+	}
+	if !e.Allowed(subject, method, resource) {
+		return status.Errorf(codes.PermissionDenied, "'%s' is not allowed to call %s on %s", subject, method, resource)
+	}
+	return nil
+}
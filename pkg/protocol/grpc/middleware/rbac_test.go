@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorization(t *testing.T) {
+	p, err := rbac.ParsePolicy([]byte(`{
+		"roles": {
+			"reader": [{"methods": ["/v1.ToDoService/Read"]}],
+			"owner": [{"methods": ["/v1.ToDoService/Delete"], "resources": ["tasks/1"]}]
+		},
+		"bindings": {"*": ["reader"], "alice": ["owner"]}
+	}`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	//resource is the request itself
+	i := Authorization(rbac.NewEnforcer(p), func(method string, req interface{}) string {
+		resource, _ := req.(string)
+		return resource
+	})
+	as := func(subject string) context.Context {
+		return auth.NewContext(context.Background(), &auth.Principal{Subject: subject, Method: auth.MethodAPIKey})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		resource string
+		code     codes.Code
+	}{
+		{"Allowed to every caller", context.Background(), "/v1.ToDoService/Read", "tasks/2", codes.OK},
+		{"Allowed on resource", as("alice"), "/v1.ToDoService/Delete", "tasks/1", codes.OK},
+		{"Denied on other resource", as("alice"), "/v1.ToDoService/Delete", "tasks/2", codes.PermissionDenied},
+		{"Denied without role", as("bob"), "/v1.ToDoService/Delete", "tasks/1", codes.PermissionDenied},
+		{"Denied to unauthenticated", context.Background(), "/v1.ToDoService/Delete", "tasks/1", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			unary := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return req, nil
+			}
+			_, err := i.Unary(tt.ctx, tt.resource, &grpc.UnaryServerInfo{FullMethod: tt.method}, unary)
+			if status.Code(err) != tt.code {
+				t.Errorf("Authorization().Unary error = %v, wantCode %v", err, tt.code)
+			}
+			if called != (tt.code == codes.OK) {
+				t.Errorf("Authorization().Unary called handler = %v, want %v", called, tt.code == codes.OK)
+			}
+		})
+	}
+
+	//stream calls are checked before their request is received
+	stream := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+	err = i.Stream(nil, &fakeStream{ctx: as("alice")}, &grpc.StreamServerInfo{FullMethod: "/v1.ToDoService/Watch"}, stream)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Authorization().Stream error = %v, wantCode %v", err, codes.PermissionDenied)
+	}
+}
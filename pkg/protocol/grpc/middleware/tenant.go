@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/tenant"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//Tenancy returns interceptor which scopes calls to the tenant sent in metadata, calls without tenant and calls of
+//unknown or suspended tenants are rejected. Exempt methods e.g. the ones managing tenants are called outside of any tenant.
+func Tenancy(tenants storage.TenantRepository, exempt ...string) Interceptor {
+	exempted := make(map[string]bool, len(exempt))
+	for _, method := range exempt {
+		exempted[method] = true
+	}
+	return Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if exempted[info.FullMethod] {
+				return handler(ctx, req)
+			}
+			ctx, err := scope(ctx, tenants)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if exempted[info.FullMethod] {
+				return handler(srv, stream)
+			}
+			ctx, err := scope(stream.Context(), tenants)
+			if err != nil {
+				return err
+			}
+			wrapped := grpc_middleware.WrapServerStream(stream)
+			wrapped.WrappedContext = ctx
+			return handler(srv, wrapped)
+		},
+	}
+}
+
+//scope looks up the tenant sent in metadata of the call and returns context with the tenant
+func scope(ctx context.Context, tenants storage.TenantRepository) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(tenant.Metadata)
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s metadata is required", tenant.Metadata)
+	}
+	id := values[0]
+	if !tenant.ValidID(id) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s metadata has invalid tenant ID '%s'", tenant.Metadata, id)
+	}
+
+	t, err := tenants.GetTenant(ctx, id)
+	if err == storage.ErrTenantNotFound {
+		return nil, status.Errorf(codes.NotFound, "Tenant with ID='%s' is not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to select from Tenant -> %s", err.Error())
+	}
+	if t.Suspended {
+		return nil, status.Errorf(codes.PermissionDenied, "Tenant with ID='%s' is suspended", id)
+	}
+
+	//tenant is logged with the call
+	grpc_ctxtags.Extract(ctx).Set("tenant", id)
+	return tenant.NewContext(ctx, t), nil
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"github.com/basebandit/go-grpc/pkg/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenancy(t *testing.T) {
+	repo := memory.NewToDoRepository()
+	for _, tt := range []*storage.Tenant{{ID: "blue"}, {ID: "red", Suspended: true}} {
+		if err := repo.CreateTenant(context.Background(), tt); err != nil {
+			t.Fatalf("CreateTenant() error = %v", err)
+		}
+	}
+	const admin = "/v1.ToDoService/CreateTenant"
+	i := Tenancy(repo, admin)
+	incoming := func(tenants ...string) context.Context {
+		md := metadata.MD{}
+		if len(tenants) > 0 {
+			md.Set(tenant.Metadata, tenants...)
+		}
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+		want   string
+	}{
+		{"OK", incoming("blue"), "/v1.ToDoService/Read", codes.OK, "blue"},
+		{"Exempt method", incoming(), admin, codes.OK, ""},
+		{"Unknown", incoming("green"), "/v1.ToDoService/Read", codes.NotFound, ""},
+		{"Suspended", incoming("red"), "/v1.ToDoService/Read", codes.PermissionDenied, ""},
+		{"Invalid", incoming("Blue"), "/v1.ToDoService/Read", codes.InvalidArgument, ""},
+		{"Missing", incoming(), "/v1.ToDoService/Read", codes.InvalidArgument, ""},
+		{"No metadata", context.Background(), "/v1.ToDoService/Read", codes.InvalidArgument, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//scoped is the tenant found by the handler
+			var scoped string
+			unary := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if got, ok := tenant.FromContext(ctx); ok {
+					scoped = got.ID
+				}
+				return req, nil
+			}
+			_, err := i.Unary(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method}, unary)
+			if status.Code(err) != tt.code {
+				t.Errorf("Tenancy().Unary error = %v, wantCode %v", err, tt.code)
+			}
+			if scoped != tt.want {
+				t.Errorf("Tenancy().Unary tenant = %q, want %q", scoped, tt.want)
+			}
+
+			scoped = ""
+			stream := func(srv interface{}, stream grpc.ServerStream) error {
+				_, err := unary(stream.Context(), nil)
+				return err
+			}
+			err = i.Stream(nil, &fakeStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, stream)
+			if status.Code(err) != tt.code {
+				t.Errorf("Tenancy().Stream error = %v, wantCode %v", err, tt.code)
+			}
+			if scoped != tt.want {
+				t.Errorf("Tenancy().Stream tenant = %q, want %q", scoped, tt.want)
+			}
+		})
+	}
+}
@@ -2,19 +2,28 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
+	"time"
 
 	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
 	"github.com/basebandit/go-grpc/pkg/logger"
 	"github.com/basebandit/go-grpc/pkg/protocol/grpc/middleware"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-//RunServer runs gRPC service to publish ToDo service
-func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string) error {
+//shutdownTimeout is time given to running calls to finish on shutdown.
+//Watch streams don't end on their own so server is stopped when it elapses.
+const shutdownTimeout = 5 * time.Second
+
+//RunServer runs gRPC service to publish ToDo service, interceptors run after logging in the given order.
+//Server listens in plaintext when tlsConfig is nil.
+func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string, tlsConfig *tls.Config, interceptors ...middleware.Interceptor) error {
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	if err != nil {
 		return err
@@ -22,23 +31,35 @@ func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string) err
 
 	///gRPC server startup options
 	opts := []grpc.ServerOption{}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 
 	//add middleware
-	opts = middleware.AddLogging(logger.Log, opts)
+	opts = middleware.AddLogging(logger.Log, opts, interceptors...)
 
 	//register service
 	server := grpc.NewServer(opts...)
 	v1.RegisterToDoServiceServer(server, v1API)
 
 	//graceful shutdown
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 	go func() {
 		for range c {
 			//sig is ^C, handle it
 			logger.Log.Warn("shutting down gRPC server...")
 
-			server.GracefulStop()
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-time.After(shutdownTimeout):
+				server.Stop()
+			}
 
 			<-ctx.Done()
 		}
@@ -46,6 +67,6 @@ func RunServer(ctx context.Context, v1API v1.ToDoServiceServer, port string) err
 
 	//start gRPC server
 
-	logger.Log.Info("starting gRPC server...")
+	logger.Log.Info("starting gRPC server...", zap.Bool("tls", tlsConfig != nil))
 	return server.Serve(listen)
 }
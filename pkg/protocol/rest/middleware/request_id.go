@@ -9,6 +9,8 @@ import (
 	"os"
 	"strings"
 	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
 )
 
 // Code is taken from: https://github.com/go-chi/chi/blob/master/middleware/request_id.go
@@ -69,3 +71,11 @@ func GetReqID(ctx context.Context) string {
 	}
 	return ""
 }
+
+//RequestIDMetadata returns gRPC metadata which forwards the request ID of HTTP request to gRPC server
+func RequestIDMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	if id := GetReqID(r.Context()); len(id) > 0 {
+		return metadata.Pairs("x-request-id", id)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//etag sets ETag header of the response to the version of read or updated task,
+//clients send it back in If-Match header of Update and Delete
+func etag(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+	var version int64
+	switch res := m.(type) {
+	case *v1.ReadResponse:
+		version = res.GetToDo().GetVersion()
+	case *v1.UpdateResponse:
+		version = res.GetVersion()
+	}
+	if version != 0 {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+	}
+	return nil
+}
+
+//httpError replies to the request like runtime.DefaultHTTPError,
+//stale version sent in If-Match header is 412 Precondition Failed instead of 409 Conflict
+func httpError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.Aborted && len(r.Header.Get("If-Match")) > 0 {
+		w = &statusWriter{ResponseWriter: w, from: http.StatusConflict, to: http.StatusPreconditionFailed}
+	}
+	runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
+
+//statusWriter replaces status code of the response
+type statusWriter struct {
+	http.ResponseWriter
+	from, to int
+}
+
+//WriteHeader sends the replaced status code
+func (w *statusWriter) WriteHeader(code int) {
+	if code == w.from {
+		code = w.to
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -2,32 +2,49 @@ package rest
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
+	"net/textproto"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
 	"github.com/basebandit/go-grpc/pkg/logger"
 	"github.com/basebandit/go-grpc/pkg/protocol/rest/middleware"
+	"github.com/basebandit/go-grpc/pkg/tenant"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
-//RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string) error {
+//RunServer runs HTTP/REST gateway, it serves HTTPS by serverTLS and calls gRPC server by dialTLS when they are not nil
+func RunServer(ctx context.Context, grpcPort, httpPort string, serverTLS, dialTLS *tls.Config) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	runtime.HTTPError = httpError
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(middleware.RequestIDMetadata),
+		runtime.WithMetadata(clientCertificate),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithForwardResponseOption(etag),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if dialTLS != nil {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(dialTLS))}
+	}
 	if err := v1.RegisterToDoServiceHandlerFromEndpoint(ctx, mux, "localhost:"+grpcPort, opts); err != nil {
 		logger.Log.Fatal("failed to start HTTP gateway: %v", zap.String("reason", err.Error()))
 	}
 	srv := &http.Server{
-		Addr:    ":" + httpPort,
-		Handler: middleware.AddRequestID(middleware.AddLogger(logger.Log, mux)),
+		Addr:      ":" + httpPort,
+		Handler:   middleware.AddRequestID(middleware.AddLogger(logger.Log, mux)),
+		TLSConfig: serverTLS,
 	}
 
 	//graceful shutdown
@@ -43,6 +60,39 @@ func RunServer(ctx context.Context, grpcPort, httpPort string) error {
 
 		_ = srv.Shutdown(ctx)
 	}()
-	logger.Log.Info("starting HTTP/REST gateway...")
+	logger.Log.Info("starting HTTP/REST gateway...", zap.Bool("tls", serverTLS != nil))
+	if serverTLS != nil {
+		//certificate is served by the TLS configuration
+		return srv.ListenAndServeTLS("", "")
+	}
 	return srv.ListenAndServe()
 }
+
+//headerMatcher forwards X-Actor header as metadata of the caller, If-Match header as the expected version of the task
+//and X-Tenant-ID header as the tenant of the call in addition to the default headers.
+//Authorization header is always forwarded as "authorization" metadata by the gateway.
+//Subject of client certificate is set only by the gateway so clients may not send it as Grpc-Metadata- header.
+func headerMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case "X-Actor":
+		return "x-actor", true
+	case "If-Match":
+		return "if-match", true
+	case "X-Tenant-Id":
+		return tenant.Metadata, true
+	}
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if strings.EqualFold(name, auth.CertificateSubjectMetadata) {
+		return "", false
+	}
+	return name, ok
+}
+
+//clientCertificate forwards subject of the client certificate verified by HTTPS server to gRPC server which trusts
+//it in calls of the gateway
+func clientCertificate(ctx context.Context, req *http.Request) metadata.MD {
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+	return metadata.Pairs(auth.CertificateSubjectMetadata, auth.CertificateSubject(req.TLS.PeerCertificates[0]))
+}
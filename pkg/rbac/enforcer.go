@@ -0,0 +1,106 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"go.uber.org/zap"
+)
+
+//Enforcer checks calls against the current policy, the policy loaded from file is replaced when the file changes
+type Enforcer struct {
+	//file is the policy file, it is empty for policy given by NewEnforcer
+	file string
+
+	mu sync.RWMutex
+
+	//policy is the current policy
+	policy *Policy
+
+	//modTime and size identify the version of the file the policy is loaded from
+	modTime time.Time
+	size    int64
+}
+
+//NewEnforcer creates enforcer of the policy which never changes
+func NewEnforcer(p *Policy) *Enforcer {
+	return &Enforcer{policy: p}
+}
+
+//LoadEnforcer creates enforcer of the policy file, Reload and Watch replace the policy when the file changes
+func LoadEnforcer(file string) (*Enforcer, error) {
+	e := &Enforcer{file: file}
+	if _, err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+//Policy returns the current policy
+func (e *Enforcer) Policy() *Policy {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.policy
+}
+
+//Allowed reports whether the current policy allows the subject to call the method on the resource
+func (e *Enforcer) Allowed(subject, method, resource string) bool {
+	return e.Policy().Allowed(subject, method, resource)
+}
+
+//Reload loads the policy file again when it changed since the last load and reports whether the policy is replaced.
+//The current policy is kept when the changed file is not valid.
+func (e *Enforcer) Reload() (bool, error) {
+	if len(e.file) == 0 {
+		return false, nil
+	}
+	info, err := os.Stat(e.file)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat RBAC policy file -> %s", err.Error())
+	}
+
+	e.mu.RLock()
+	unchanged := e.policy != nil && info.ModTime().Equal(e.modTime) && info.Size() == e.size
+	e.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	p, err := LoadPolicy(e.file)
+	if err != nil {
+		return false, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.policy, e.modTime, e.size = p, info.ModTime(), info.Size()
+	return true, nil
+}
+
+//Watch reloads the policy file every interval until the context is done
+func (e *Enforcer) Watch(ctx context.Context, interval time.Duration) {
+	logger.Log.Info("watching RBAC policy file...", zap.String("file", e.file), zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		reloaded, err := e.Reload()
+		if err != nil {
+			logger.Log.Error("failed to reload RBAC policy, the previous policy is kept", zap.Error(err))
+			continue
+		}
+		if reloaded {
+			logger.Log.Info("RBAC policy reloaded", zap.String("file", e.file))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package rbac
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestEnforcer_Reload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rbac")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "policy.json")
+	mod := time.Now().Add(-time.Hour)
+	write := func(content string) {
+		if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write policy file: %v", err)
+		}
+		//every write is seen as change even when the file system keeps modification times in seconds
+		mod = mod.Add(time.Second)
+		if err := os.Chtimes(file, mod, mod); err != nil {
+			t.Fatalf("failed to change modification time of policy file: %v", err)
+		}
+	}
+	const deleteTask = "/v1.ToDoService/Delete"
+
+	write(`{"roles": {"reader": [{"methods": ["/v1.ToDoService/Read"]}]}, "bindings": {"contractor": ["reader"]}}`)
+	e, err := LoadEnforcer(file)
+	if err != nil {
+		t.Fatalf("LoadEnforcer() error = %v", err)
+	}
+	if e.Allowed("contractor", deleteTask, "tasks/1") {
+		t.Errorf("Enforcer.Allowed() = true, want false before reload")
+	}
+
+	if reloaded, err := e.Reload(); reloaded || err != nil {
+		t.Errorf("Enforcer.Reload() = %v, %v, want false, nil for unchanged file", reloaded, err)
+	}
+
+	write(`{"roles": {"editor": [{"methods": ["*"]}]}, "bindings": {"contractor": ["editor"]}}`)
+	if reloaded, err := e.Reload(); !reloaded || err != nil {
+		t.Fatalf("Enforcer.Reload() = %v, %v, want true, nil for changed file", reloaded, err)
+	}
+	if !e.Allowed("contractor", deleteTask, "tasks/1") {
+		t.Errorf("Enforcer.Allowed() = false, want true after reload")
+	}
+
+	//invalid policy does not replace the current one
+	write(`{"roles": {}, "bindings": {"contractor": ["editor"]}}`)
+	if reloaded, err := e.Reload(); reloaded || err == nil {
+		t.Errorf("Enforcer.Reload() = %v, %v, want false and error for invalid policy", reloaded, err)
+	}
+	if !e.Allowed("contractor", deleteTask, "tasks/1") {
+		t.Errorf("Enforcer.Allowed() = false, want true with the previous policy")
+	}
+
+	if _, err := LoadEnforcer(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadEnforcer() error = nil, want error for missing file")
+	}
+}
@@ -0,0 +1,117 @@
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+)
+
+//AnySubject binds roles to every caller including the unauthenticated ones
+const AnySubject = "*"
+
+//Permission allows calls of the methods on the resources
+type Permission struct {
+	//Methods are full gRPC method names e.g. "/v1.ToDoService/Read" or path.Match patterns of them
+	//e.g. "/v1.ToDoService/List*", "*" matches every method
+	Methods []string `json:"methods"`
+
+	//Resources are names of the resources e.g. "tasks/12" or path.Match patterns of them e.g. "tasks/*",
+	//"*" matches every resource and so does empty list
+	Resources []string `json:"resources,omitempty"`
+}
+
+//Policy maps subjects to roles and roles to permissions, everything not allowed by a permission is denied
+type Policy struct {
+	//Roles are permissions by role name
+	Roles map[string][]Permission `json:"roles"`
+
+	//Bindings are role names by subject, roles of AnySubject are granted to every caller
+	Bindings map[string][]string `json:"bindings"`
+}
+
+//ParsePolicy parses and validates policy in JSON format
+func ParsePolicy(data []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse RBAC policy -> %s", err.Error())
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+//LoadPolicy reads policy from JSON file
+func LoadPolicy(file string) (*Policy, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RBAC policy file -> %s", err.Error())
+	}
+	return ParsePolicy(data)
+}
+
+//validate checks that patterns are well-formed and bindings refer to defined roles
+func (p *Policy) validate() error {
+	for role, permissions := range p.Roles {
+		for i, perm := range permissions {
+			if len(perm.Methods) == 0 {
+				return fmt.Errorf("RBAC policy: permission %d of role '%s' has no methods", i, role)
+			}
+			for _, pattern := range append(append([]string{}, perm.Methods...), perm.Resources...) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("RBAC policy: role '%s' has invalid pattern '%s'", role, pattern)
+				}
+			}
+		}
+	}
+	for subject, roles := range p.Bindings {
+		for _, role := range roles {
+			if _, ok := p.Roles[role]; !ok {
+				return fmt.Errorf("RBAC policy: subject '%s' is bound to undefined role '%s'", subject, role)
+			}
+		}
+	}
+	return nil
+}
+
+//RolesOf returns sorted roles of the subject including the roles of every caller
+func (p *Policy) RolesOf(subject string) []string {
+	seen := map[string]bool{}
+	var roles []string
+	for _, role := range append(append([]string{}, p.Bindings[subject]...), p.Bindings[AnySubject]...) {
+		if !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+//Allowed reports whether a role of the subject allows the call of the method on the resource
+func (p *Policy) Allowed(subject, method, resource string) bool {
+	for _, role := range p.RolesOf(subject) {
+		for _, perm := range p.Roles[role] {
+			if matchAny(perm.Methods, method) && (len(perm.Resources) == 0 || matchAny(perm.Resources, resource)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//matchAny reports whether the name matches one of the patterns, "*" matches names with slashes too
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" {
+			return true
+		}
+		//patterns are validated when policy is loaded
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testPolicy = `{
+	"roles": {
+		"reader": [{"methods": ["/v1.ToDoService/Read*", "/v1.ToDoService/List*", "/v1.ToDoService/Search"]}],
+		"editor": [{"methods": ["*"], "resources": ["tasks", "tasks/*"]}],
+		"reporter": [{"methods": ["/v1.ToDoService/GetProgress"], "resources": ["tasks/1?"]}],
+		"admin": [{"methods": ["*"]}]
+	},
+	"bindings": {
+		"*": ["reader"],
+		"contractor": ["reporter", "reader"],
+		"alice": ["editor"],
+		"root": ["admin", "reader"]
+	}
+}`
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{"OK", testPolicy, false},
+		{"Empty", `{}`, false},
+		{"Invalid JSON", `{"roles": [}`, true},
+		{"Without methods", `{"roles": {"reader": [{"resources": ["tasks/*"]}]}}`, true},
+		{"Invalid pattern", `{"roles": {"reader": [{"methods": ["/v1.ToDoService/[Read"]}]}}`, true},
+		{"Undefined role", `{"roles": {"reader": [{"methods": ["*"]}]}, "bindings": {"alice": ["writer"]}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_RolesOf(t *testing.T) {
+	p, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	for subject, want := range map[string][]string{
+		"root":       {"admin", "reader"},
+		"contractor": {"reader", "reporter"},
+		"bob":        {"reader"},
+		"":           {"reader"},
+	} {
+		if got := p.RolesOf(subject); !reflect.DeepEqual(got, want) {
+			t.Errorf("Policy.RolesOf(%q) = %v, want %v", subject, got, want)
+		}
+	}
+}
+
+func TestPolicy_Allowed(t *testing.T) {
+	p, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	tests := []struct {
+		name     string
+		subject  string
+		method   string
+		resource string
+		want     bool
+	}{
+		{"Contractor reads", "contractor", "/v1.ToDoService/Read", "tasks/12", true},
+		{"Contractor lists", "contractor", "/v1.ToDoService/ListProjects", "projects", true},
+		{"Contractor may not delete", "contractor", "/v1.ToDoService/Delete", "tasks/12", false},
+		{"Contractor gets progress of resource", "contractor", "/v1.ToDoService/GetProgress", "tasks/12", true},
+		{"Contractor may not get progress of other resource", "contractor", "/v1.ToDoService/GetProgress", "tasks/2", false},
+		{"Editor deletes task", "alice", "/v1.ToDoService/Delete", "tasks/12", true},
+		{"Editor creates task", "alice", "/v1.ToDoService/Create", "tasks", true},
+		{"Editor may not delete project", "alice", "/v1.ToDoService/DeleteProject", "projects/3", false},
+		{"Admin deletes tenant", "root", "/v1.ToDoService/DeleteTenant", "tenants/blue", true},
+		{"Anonymous reads", "", "/v1.ToDoService/ReadAll", "tasks", true},
+		{"Anonymous may not update", "", "/v1.ToDoService/Update", "tasks/12", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Allowed(tt.subject, tt.method, tt.resource); got != tt.want {
+				t.Errorf("Policy.Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
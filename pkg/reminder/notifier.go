@@ -0,0 +1,193 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"go.uber.org/zap"
+)
+
+//Notification is a reminder of todo entity which came due
+type Notification struct {
+	//Key identifies the reminder, it is the same when the reminder is delivered again after a failure
+	//so receivers can drop duplicates
+	Key string `json:"key"`
+
+	//ID of todo entity
+	ID int64 `json:"id"`
+
+	//Title of todo entity
+	Title string `json:"title"`
+
+	//Description of todo entity
+	Description string `json:"description"`
+
+	//Status of todo entity
+	Status string `json:"status"`
+
+	//EstimatedTimeOfCompletion of todo entity, nil when it is not set
+	EstimatedTimeOfCompletion *time.Time `json:"estimatedTimeOfCompletion,omitempty"`
+
+	//Reminder is the date and time the reminder came due
+	Reminder time.Time `json:"reminder"`
+
+	//FiredAt is the date and time the reminder is delivered
+	FiredAt time.Time `json:"firedAt"`
+
+	//Tenant of todo entity, it is empty when server is not multi-tenant
+	Tenant string `json:"tenant,omitempty"`
+}
+
+//newNotification creates notification of todo entity reminder fired at the time
+func newNotification(td *storage.ToDo, firedAt time.Time) *Notification {
+	n := &Notification{
+		Key:         notificationKey(td.ID, td.Reminder),
+		ID:          td.ID,
+		Title:       td.Title,
+		Description: td.Description,
+		Status:      td.Status,
+		Reminder:    td.Reminder,
+		FiredAt:     firedAt,
+		Tenant:      td.Tenant,
+	}
+	if !td.EstimatedTimeOfCompletion.IsZero() {
+		etc := td.EstimatedTimeOfCompletion
+		n.EstimatedTimeOfCompletion = &etc
+	}
+	return n
+}
+
+//notificationKey returns idempotency key of the reminder of todo entity
+func notificationKey(id int64, reminder time.Time) string {
+	return fmt.Sprintf("todo-%d-%s", id, reminder.UTC().Format(time.RFC3339Nano))
+}
+
+//Notifier delivers reminders to the user
+type Notifier interface {
+	//Name identifies notifier in logs
+	Name() string
+
+	//Notify delivers the reminder, it is called again when it returns error
+	Notify(ctx context.Context, n *Notification) error
+}
+
+//logNotifier writes reminders to the structured log
+type logNotifier struct{}
+
+//NewLogNotifier creates notifier which writes every reminder as info log event
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+//Name implements Notifier interface
+func (logNotifier) Name() string {
+	return "log"
+}
+
+//Notify implements Notifier interface
+func (logNotifier) Notify(ctx context.Context, n *Notification) error {
+	logger.Log.Info("reminder",
+		zap.Int64("todo.id", n.ID),
+		zap.String("todo.title", n.Title),
+		zap.String("todo.status", n.Status),
+		zap.Time("reminder", n.Reminder),
+		zap.Time("fired_at", n.FiredAt),
+		zap.String("tenant", n.Tenant))
+	return nil
+}
+
+//webhookNotifier posts reminders to HTTP endpoint
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+//NewWebhookNotifier creates notifier which POSTs every reminder as JSON to the URL.
+//Reminder is delivered when the endpoint responds with 2xx status code, reminder delivered again after a failure
+//has the same Idempotency-Key header.
+func NewWebhookNotifier(url string, timeout time.Duration) Notifier {
+	return &webhookNotifier{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+//Name implements Notifier interface
+func (w *webhookNotifier) Name() string {
+	return "webhook"
+}
+
+//Notify implements Notifier interface
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder -> %s", err.Error())
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request -> %s", err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", n.Key)
+
+	resp, err := w.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to call webhook -> %s", err.Error())
+	}
+	defer resp.Body.Close()
+	//read the response so that connection is reused
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status '%s'", resp.Status)
+	}
+	return nil
+}
+
+//commandNotifier runs command for every reminder
+type commandNotifier struct {
+	name string
+	args []string
+}
+
+//NewCommandNotifier creates notifier which runs the command for every reminder.
+//The command reads reminder as JSON from standard input, the main fields are also passed
+//in TASQ_TODO_ID, TASQ_TODO_TITLE, TASQ_TODO_STATUS, TASQ_REMINDER and TASQ_REMINDER_KEY environment variables.
+//Reminder is delivered when the command exits with zero status.
+func NewCommandNotifier(name string, args ...string) Notifier {
+	return &commandNotifier{name: name, args: args}
+}
+
+//Name implements Notifier interface
+func (c *commandNotifier) Name() string {
+	return "command"
+}
+
+//Notify implements Notifier interface
+func (c *commandNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder -> %s", err.Error())
+	}
+
+	cmd := exec.CommandContext(ctx, c.name, c.args...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"TASQ_TODO_ID="+strconv.FormatInt(n.ID, 10),
+		"TASQ_TODO_TITLE="+n.Title,
+		"TASQ_TODO_STATUS="+n.Status,
+		"TASQ_REMINDER="+n.Reminder.Format(time.RFC3339),
+		"TASQ_REMINDER_KEY="+n.Key)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("command '%s' failed -> %s: %s", c.name, err.Error(), bytes.TrimSpace(out))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testNotification() *Notification {
+	tm := time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC)
+	return &Notification{
+		Key:                       "todo-1-2020-03-20T10:00:00Z",
+		ID:                        1,
+		Title:                     "title",
+		Description:               "description",
+		Status:                    "TODO",
+		EstimatedTimeOfCompletion: &tm,
+		Reminder:                  tm,
+		FiredAt:                   tm.Add(time.Second),
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received Notification
+	code := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("webhook received %s request of '%s'", r.Method, r.Header.Get("Content-Type"))
+		}
+		if r.Header.Get("Idempotency-Key") != "todo-1-2020-03-20T10:00:00Z" {
+			t.Errorf("webhook received Idempotency-Key '%s'", r.Header.Get("Idempotency-Key"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("webhook received invalid JSON: %v", err)
+		}
+		w.WriteHeader(code)
+	}))
+	defer server.Close()
+
+	n := testNotification()
+	notifier := NewWebhookNotifier(server.URL, time.Second)
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("webhookNotifier.Notify() error = %v", err)
+	}
+	if !reflect.DeepEqual(&received, n) {
+		t.Errorf("webhook received %v, want %v", &received, n)
+	}
+
+	code = http.StatusInternalServerError
+	if err := notifier.Notify(context.Background(), n); err == nil {
+		t.Errorf("webhookNotifier.Notify() error = nil, want error of failed webhook")
+	}
+}
+
+func TestCommandNotifier(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tasq")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "reminder")
+
+	n := testNotification()
+	notifier := NewCommandNotifier("sh", "-c", `echo "$TASQ_TODO_ID $TASQ_TODO_TITLE $TASQ_REMINDER $TASQ_REMINDER_KEY" > "$0" && cat >> "$0"`, out)
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("commandNotifier.Notify() error = %v", err)
+	}
+	b, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatalf("command did not run: %v", err)
+	}
+	lines := strings.SplitN(string(b), "\n", 2)
+	if lines[0] != "1 title 2020-03-20T10:00:00Z todo-1-2020-03-20T10:00:00Z" {
+		t.Errorf("command received environment '%s'", lines[0])
+	}
+	var received Notification
+	if err := json.Unmarshal([]byte(lines[1]), &received); err != nil || !reflect.DeepEqual(&received, n) {
+		t.Errorf("command received %s, want %v", lines[1], n)
+	}
+
+	failing := NewCommandNotifier("sh", "-c", "echo failed >&2; exit 1")
+	if err := failing.Notify(context.Background(), n); err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("commandNotifier.Notify() error = %v, want error with command output", err)
+	}
+}
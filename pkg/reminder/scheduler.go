@@ -0,0 +1,167 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	//maxAttempts is number of times notifier is called to deliver reminder
+	maxAttempts = 3
+
+	//retryDelay is the delay before the second attempt, it grows with every next attempt
+	retryDelay = time.Second
+
+	//claimLease is how long claimed reminder is not due for other schedulers, it has to outlast the delivery
+	claimLease = 5 * time.Minute
+
+	//storeTimeout limits recording the result of delivery which is recorded even when the scheduler is stopped
+	storeTimeout = 10 * time.Second
+)
+
+//Scheduler fires reminders of todo entities when they come due.
+//Every reminder is claimed in the repository before it is dispatched to notifiers and marked as delivered
+//after every notifier delivered it, so it fires once even when several servers share the database.
+//Reminder which is not delivered is released and fires again on the next check, reminder claimed by
+//a server which stopped or crashed fires again when the claim lease expires.
+type Scheduler struct {
+	repo      storage.ToDoRepository
+	notifiers []Notifier
+
+	//closed are statuses of finished todo entities which reminders are not fired
+	closed []string
+
+	//interval is how often the repository is checked for due reminders
+	interval time.Duration
+
+	//now returns current time
+	now func() time.Time
+
+	//retryDelay is the delay before the second delivery attempt
+	retryDelay time.Duration
+
+	//lease is how long claimed reminder is not due for other schedulers
+	lease time.Duration
+}
+
+//NewScheduler creates scheduler which checks the repository for due reminders every interval
+//and dispatches them through the notifiers, reminders of todo entities in closed statuses are not fired
+func NewScheduler(repo storage.ToDoRepository, interval time.Duration, closed []string, notifiers ...Notifier) *Scheduler {
+	return &Scheduler{
+		repo:       repo,
+		notifiers:  notifiers,
+		closed:     closed,
+		interval:   interval,
+		now:        time.Now,
+		retryDelay: retryDelay,
+		lease:      claimLease,
+	}
+}
+
+//Run fires due reminders until the context is done
+func (s *Scheduler) Run(ctx context.Context) {
+	logger.Log.Info("starting reminder scheduler...", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.fire(ctx); err != nil {
+			logger.Log.Error("failed to fire reminders", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Log.Info("reminder scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//fire dispatches reminders which came due since the last check
+func (s *Scheduler) fire(ctx context.Context) error {
+	now := s.now()
+	//finished task needs no reminder
+	due, err := s.repo.DueReminders(ctx, now, s.closed)
+	if err != nil {
+		return err
+	}
+
+	for _, td := range due {
+		err := s.repo.ClaimReminder(ctx, td.ID, td.Reminder, now, now.Add(s.lease))
+		if err == storage.ErrNotFound {
+			//reminder is changed, deleted or claimed by another server meanwhile
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = s.dispatch(ctx, newNotification(td, now))
+		s.settle(td, err == nil)
+		if ctx.Err() != nil {
+			//scheduler is stopped, the rest of reminders fires after restart
+			return nil
+		}
+	}
+	return nil
+}
+
+//settle marks claimed reminder of todo entity as delivered or releases it so that it fires again
+func (s *Scheduler) settle(td *storage.ToDo, delivered bool) {
+	//result is recorded even when the scheduler is stopped during the delivery
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	var err error
+	if delivered {
+		err = s.repo.MarkReminderSent(ctx, td.ID, td.Reminder, s.now())
+	} else {
+		err = s.repo.ReleaseReminder(ctx, td.ID, td.Reminder)
+	}
+	if err == storage.ErrNotFound {
+		//reminder is changed or deleted during the delivery
+		return
+	}
+	if err != nil {
+		//claim lease expires so the reminder fires again later
+		logger.Log.Error("failed to record delivery of reminder",
+			zap.Int64("todo.id", td.ID),
+			zap.Bool("delivered", delivered),
+			zap.Error(err))
+	}
+}
+
+//dispatch delivers reminder through every notifier, failed delivery is retried.
+//Error is returned when a notifier did not deliver the reminder or the context is done.
+func (s *Scheduler) dispatch(ctx context.Context, n *Notification) error {
+	var failed error
+	for _, notifier := range s.notifiers {
+		var err error
+		for attempt := 1; attempt <= maxAttempts; attempt++ {
+			if err = notifier.Notify(ctx, n); err == nil {
+				break
+			}
+			if attempt == maxAttempts {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * s.retryDelay):
+			}
+		}
+		if err != nil {
+			logger.Log.Error("failed to deliver reminder",
+				zap.String("notifier", notifier.Name()),
+				zap.Int64("todo.id", n.ID),
+				zap.Error(err))
+			failed = err
+		}
+	}
+	return failed
+}
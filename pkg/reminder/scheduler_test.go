@@ -0,0 +1,198 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"go.uber.org/zap"
+)
+
+//closed are statuses of finished todo entities
+var closed = []string{"DONE", "CANCELLED"}
+
+func TestMain(m *testing.M) {
+	logger.Log = zap.NewNop()
+	os.Exit(m.Run())
+}
+
+//fakeNotifier records delivered reminders and fails the first calls
+type fakeNotifier struct {
+	mu        sync.Mutex
+	failures  int
+	calls     int
+	delivered []int64
+	keys      []string
+}
+
+func (n *fakeNotifier) Name() string {
+	return "fake"
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, notification *Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.calls++
+	n.keys = append(n.keys, notification.Key)
+	if n.calls <= n.failures {
+		return errors.New("delivery failed")
+	}
+	n.delivered = append(n.delivered, notification.ID)
+	return nil
+}
+
+func TestSchedulerFire(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC)
+	repo := memory.NewToDoRepository()
+	for _, td := range []*storage.ToDo{
+		{Title: "due later", Status: "TODO", Reminder: tm.Add(time.Hour)},
+		{Title: "due", Status: "TODO", Reminder: tm.Add(-time.Minute)},
+		{Title: "done", Status: "DONE", Reminder: tm.Add(-time.Hour)},
+		{Title: "without reminder", Status: "TODO"},
+		{Title: "due first", Status: "IN_PROGRESS", Reminder: tm.Add(-time.Hour)},
+	} {
+		if _, err := repo.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+
+	first, second := &fakeNotifier{failures: 2}, &fakeNotifier{}
+	s := NewScheduler(repo, time.Minute, closed, first, second)
+	s.retryDelay = time.Millisecond
+	s.now = func() time.Time { return tm }
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want []int64
+	}{
+		{"Due reminders", tm, []int64{5, 2}},
+		{"Already fired", tm, []int64{5, 2}},
+		{"Later reminder", tm.Add(time.Hour), []int64{5, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.now = func() time.Time { return tt.now }
+			if err := s.fire(ctx); err != nil {
+				t.Fatalf("Scheduler.fire() error = %v", err)
+			}
+			for _, n := range []*fakeNotifier{first, second} {
+				if !reflect.DeepEqual(n.delivered, tt.want) {
+					t.Errorf("Scheduler.fire() delivered %v, want %v", n.delivered, tt.want)
+				}
+			}
+		})
+	}
+	if first.calls != 5 {
+		t.Errorf("Scheduler.fire() called failing notifier %d times, want 5", first.calls)
+	}
+
+	//changed reminder fires again
+	td, _ := repo.Get(ctx, 2)
+	td.Reminder = tm.Add(2 * time.Hour)
+	if _, err := repo.Update(ctx, td); err != nil {
+		t.Fatalf("toDoRepository.Update() error = %v", err)
+	}
+	s.now = func() time.Time { return tm.Add(3 * time.Hour) }
+	if err := s.fire(ctx); err != nil {
+		t.Fatalf("Scheduler.fire() error = %v", err)
+	}
+	if want := []int64{5, 2, 1, 2}; !reflect.DeepEqual(second.delivered, want) {
+		t.Errorf("Scheduler.fire() delivered %v, want %v", second.delivered, want)
+	}
+}
+
+func TestSchedulerFireRedelivers(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC)
+	repo := memory.NewToDoRepository()
+	for _, td := range []*storage.ToDo{
+		{Title: "failed", Status: "TODO", Reminder: tm.Add(-time.Hour)},
+		{Title: "claimed", Status: "TODO", Reminder: tm.Add(-time.Minute)},
+	} {
+		if _, err := repo.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+	//server which claimed the reminder stopped before it delivered it
+	if err := repo.ClaimReminder(ctx, 2, tm.Add(-time.Minute), tm, tm.Add(claimLease)); err != nil {
+		t.Fatalf("toDoRepository.ClaimReminder() error = %v", err)
+	}
+
+	//notifier fails every attempt of the first check
+	failing, other := &fakeNotifier{failures: maxAttempts}, &fakeNotifier{}
+	s := NewScheduler(repo, time.Minute, closed, failing, other)
+	s.retryDelay = time.Millisecond
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		want  []int64
+		calls int
+	}{
+		{"Failed delivery", tm, nil, maxAttempts},
+		{"Next check", tm.Add(time.Minute), []int64{1}, maxAttempts + 1},
+		{"Claim lease lasts", tm.Add(2 * time.Minute), []int64{1}, maxAttempts + 1},
+		{"Claim lease expired", tm.Add(claimLease), []int64{1, 2}, maxAttempts + 2},
+		{"Delivered", tm.Add(2 * claimLease), []int64{1, 2}, maxAttempts + 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.now = func() time.Time { return tt.now }
+			if err := s.fire(ctx); err != nil {
+				t.Fatalf("Scheduler.fire() error = %v", err)
+			}
+			if !reflect.DeepEqual(failing.delivered, tt.want) || failing.calls != tt.calls {
+				t.Errorf("Scheduler.fire() delivered %v in %d calls, want %v in %d calls", failing.delivered, failing.calls, tt.want, tt.calls)
+			}
+		})
+	}
+
+	//every delivery of the reminder has the same key, notifier which delivered it gets it again
+	for _, key := range failing.keys[:maxAttempts+1] {
+		if key != failing.keys[0] {
+			t.Errorf("Scheduler.fire() notified keys %v, want the same key for every attempt", failing.keys)
+		}
+	}
+	if want := []int64{1, 1, 2}; !reflect.DeepEqual(other.delivered, want) {
+		t.Errorf("Scheduler.fire() delivered %v, want %v", other.delivered, want)
+	}
+}
+
+func TestSchedulerFireConcurrently(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Now()
+	repo := memory.NewToDoRepository()
+	for i := 0; i < 10; i++ {
+		if _, err := repo.Create(ctx, &storage.ToDo{Title: time.Duration(i).String(), Reminder: tm}); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+
+	//schedulers of several servers share the repository
+	n := &fakeNotifier{}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := NewScheduler(repo, time.Minute, closed, n).fire(ctx); err != nil {
+				t.Errorf("Scheduler.fire() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(n.delivered) != 10 {
+		t.Errorf("Scheduler.fire() delivered %d reminders, want 10", len(n.delivered))
+	}
+}
@@ -0,0 +1,400 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	//titleWeight is how many times a match in title is worth more than a match in description
+	titleWeight = 2
+
+	//snippetSize is the maximum number of words of description snippet
+	snippetSize = 24
+
+	//snippetLead is the number of words shown before the first match in description snippet
+	snippetLead = 8
+
+	//ellipsis marks text cut off description snippet
+	ellipsis = "…"
+)
+
+//Document is text of todo entity indexed for search
+type Document struct {
+	//ID of todo entity
+	ID int64
+
+	//Title of todo entity
+	Title string
+
+	//Description of todo entity
+	Description string
+}
+
+//Hit is todo entity matching the query
+type Hit struct {
+	//ID of todo entity
+	ID int64
+
+	//Score is relevance of todo entity, hits with higher score match better
+	Score float64
+
+	//Title is title of todo entity with matches surrounded by highlight tags
+	Title string
+
+	//Snippet is part of description around the first match with matches surrounded by highlight tags
+	Snippet string
+}
+
+//token is a word of indexed text
+type token struct {
+	//term is lower case word
+	term string
+
+	//start and end are byte offsets of the word in the text
+	start, end int
+}
+
+//field is indexed text split into words
+type field struct {
+	text   string
+	tokens []token
+}
+
+//document is indexed todo entity, fields are title and description
+type document struct {
+	fields [2]field
+}
+
+//Index is inverted index of title and description of todo entities kept in process memory.
+//It is safe for concurrent use.
+type Index struct {
+	mu sync.RWMutex
+
+	//docs holds indexed todo entities by ID
+	docs map[int64]*document
+
+	//postings holds IDs of todo entities containing the term
+	postings map[string]map[int64]struct{}
+
+	//removed holds IDs of todo entities removed before Load so that Load does not bring them back
+	removed map[int64]bool
+
+	//loaded is set by Load
+	loaded bool
+}
+
+//NewIndex creates empty index
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[int64]*document),
+		postings: make(map[string]map[int64]struct{}),
+		removed:  make(map[int64]bool),
+	}
+}
+
+//Put indexes todo entity replacing its previous version
+func (x *Index) Put(d Document) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(d.ID)
+	delete(x.removed, d.ID)
+	x.put(d)
+}
+
+//Remove removes todo entity from the index
+func (x *Index) Remove(id int64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(id)
+	if !x.loaded {
+		x.removed[id] = true
+	}
+}
+
+//Load indexes existing todo entities. Todo entities put or removed before Load
+//are kept as they are because Put and Remove reflect later changes.
+func (x *Index) Load(docs []Document) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	for _, d := range docs {
+		if _, ok := x.docs[d.ID]; ok || x.removed[d.ID] {
+			continue
+		}
+		x.put(d)
+	}
+	x.loaded = true
+	x.removed = make(map[int64]bool)
+}
+
+//Loaded reports whether Load was called
+func (x *Index) Loaded() bool {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.loaded
+}
+
+//put adds todo entity to the index, the caller must hold the lock
+func (x *Index) put(d Document) {
+	doc := &document{fields: [2]field{
+		{text: d.Title, tokens: tokenize(d.Title)},
+		{text: d.Description, tokens: tokenize(d.Description)},
+	}}
+	x.docs[d.ID] = doc
+	for _, f := range doc.fields {
+		for _, t := range f.tokens {
+			ids, ok := x.postings[t.term]
+			if !ok {
+				ids = make(map[int64]struct{})
+				x.postings[t.term] = ids
+			}
+			ids[d.ID] = struct{}{}
+		}
+	}
+}
+
+//remove deletes todo entity from the index, the caller must hold the lock
+func (x *Index) remove(id int64) {
+	doc, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	delete(x.docs, id)
+	for _, f := range doc.fields {
+		for _, t := range f.tokens {
+			if ids, ok := x.postings[t.term]; ok {
+				delete(ids, id)
+				if len(ids) == 0 {
+					delete(x.postings, t.term)
+				}
+			}
+		}
+	}
+}
+
+//Search returns todo entities matching every clause of the query sorted by score,
+//hits with the same score are sorted by ID. Matches are surrounded by preTag and postTag.
+func (x *Index) Search(q Query, preTag, postTag string) []Hit {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	//todo entities matching all the clauses, the rarer the clause the more it adds to the score
+	var candidates map[int64]struct{}
+	idf := make([]float64, len(q))
+	for i, c := range q {
+		ids := x.candidates(c)
+		n, df := float64(len(x.docs)), float64(len(ids))
+		idf[i] = math.Log(1 + (n-df+0.5)/(df+0.5))
+		if candidates == nil {
+			candidates = ids
+			continue
+		}
+		for id := range candidates {
+			if _, ok := ids[id]; !ok {
+				delete(candidates, id)
+			}
+		}
+	}
+
+	hits := make([]Hit, 0, len(candidates))
+	for id := range candidates {
+		doc := x.docs[id]
+		var score float64
+		var spans [2][]span
+		for i, c := range q {
+			for f := range doc.fields {
+				matched := c.match(doc.fields[f].tokens)
+				if len(matched) == 0 {
+					continue
+				}
+				//repeated matches add less and less
+				count := float64(len(matched))
+				weight := 1.0
+				if f == 0 {
+					weight = titleWeight
+				}
+				score += idf[i] * weight * count / (count + 1)
+				spans[f] = append(spans[f], matched...)
+			}
+		}
+		if score == 0 {
+			//phrase words are in the todo entity but not next to each other
+			continue
+		}
+		hits = append(hits, Hit{
+			ID:      id,
+			Score:   score,
+			Title:   highlight(doc.fields[0].text, 0, len(doc.fields[0].text), spans[0], preTag, postTag),
+			Snippet: snippet(doc.fields[1], spans[1], preTag, postTag),
+		})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	return hits
+}
+
+//candidates returns IDs of todo entities containing all words of the clause, the caller must hold the lock
+func (x *Index) candidates(c Clause) map[int64]struct{} {
+	ids := make(map[int64]struct{})
+	if c.Prefix {
+		for term, posting := range x.postings {
+			if strings.HasPrefix(term, c.Terms[0]) {
+				for id := range posting {
+					ids[id] = struct{}{}
+				}
+			}
+		}
+		return ids
+	}
+
+	for id := range x.postings[c.Terms[0]] {
+		ids[id] = struct{}{}
+	}
+	for _, term := range c.Terms[1:] {
+		posting := x.postings[term]
+		for id := range ids {
+			if _, ok := posting[id]; !ok {
+				delete(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+//span is byte range of matched text
+type span struct {
+	start, end int
+}
+
+//match returns spans of the clause in the words
+func (c Clause) match(tokens []token) []span {
+	var spans []span
+	for i := 0; i+len(c.Terms) <= len(tokens); i++ {
+		if c.Prefix {
+			if strings.HasPrefix(tokens[i].term, c.Terms[0]) {
+				spans = append(spans, span{tokens[i].start, tokens[i].end})
+			}
+			continue
+		}
+		matched := true
+		for j, term := range c.Terms {
+			if tokens[i+j].term != term {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			spans = append(spans, span{tokens[i].start, tokens[i+len(c.Terms)-1].end})
+		}
+	}
+	return spans
+}
+
+//highlight returns text between start and end with spans surrounded by tags, spans must be between start and end
+func highlight(text string, start, end int, spans []span, preTag, postTag string) string {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	//overlapping spans are highlighted once
+	var merged []span
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s.start <= merged[n-1].end {
+			if s.end > merged[n-1].end {
+				merged[n-1].end = s.end
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+
+	var b strings.Builder
+	pos := start
+	for _, s := range merged {
+		b.WriteString(text[pos:s.start])
+		b.WriteString(preTag)
+		b.WriteString(text[s.start:s.end])
+		b.WriteString(postTag)
+		pos = s.end
+	}
+	b.WriteString(text[pos:end])
+	return b.String()
+}
+
+//snippet returns part of description around its first match, beginning of description is returned when it does not match
+func snippet(f field, spans []span, preTag, postTag string) string {
+	if len(f.tokens) <= snippetSize {
+		return highlight(f.text, 0, len(f.text), spans, preTag, postTag)
+	}
+
+	first := 0
+	if len(spans) > 0 {
+		firstStart := spans[0].start
+		for _, s := range spans {
+			if s.start < firstStart {
+				firstStart = s.start
+			}
+		}
+		for i, t := range f.tokens {
+			if t.start == firstStart {
+				first = i
+				break
+			}
+		}
+	}
+
+	from := first - snippetLead
+	if from < 0 {
+		from = 0
+	}
+	to := from + snippetSize
+	if to > len(f.tokens) {
+		to, from = len(f.tokens), len(f.tokens)-snippetSize
+	}
+
+	start, end := f.tokens[from].start, f.tokens[to-1].end
+	var inside []span
+	for _, s := range spans {
+		if s.start >= start && s.end <= end {
+			inside = append(inside, s)
+		}
+	}
+
+	text := highlight(f.text, start, end, inside, preTag, postTag)
+	if from > 0 {
+		text = ellipsis + text
+	}
+	if to < len(f.tokens) {
+		text += ellipsis
+	}
+	return text
+}
+
+//tokenize splits text into lower case words of letters and digits
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		word := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case word && start < 0:
+			start = i
+		case !word && start >= 0:
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
@@ -0,0 +1,142 @@
+package search
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       string
+		want    Query
+		wantErr bool
+	}{
+		{"Words", "Buy  MILK", Query{{Terms: []string{"buy"}}, {Terms: []string{"milk"}}}, false},
+		{"Phrase", `"weekly report" send`, Query{{Terms: []string{"weekly", "report"}}, {Terms: []string{"send"}}}, false},
+		{"Unterminated phrase", `send "weekly report`, Query{{Terms: []string{"send"}}, {Terms: []string{"weekly", "report"}}}, false},
+		{"Prefix", "rep*", Query{{Terms: []string{"rep"}, Prefix: true}}, false},
+		{"Prefix of split word", "e-mai*", Query{{Terms: []string{"e"}}, {Terms: []string{"mai"}, Prefix: true}}, false},
+		{"Punctuation", "milk, eggs!", Query{{Terms: []string{"milk"}}, {Terms: []string{"eggs"}}}, false},
+		{"Empty", "  ", nil, true},
+		{"No words", `* "" -`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuery(tt.q)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	x := NewIndex()
+	x.Load([]Document{
+		{ID: 1, Title: "Weekly report", Description: "Send the report to the team"},
+		{ID: 2, Title: "Buy milk", Description: "Milk and eggs for the weekly breakfast"},
+		{ID: 3, Title: "Read", Description: "Report on weekly sales, a report a day"},
+		{ID: 4, Title: "Replace tyres", Description: ""},
+	})
+
+	tests := []struct {
+		name      string
+		q         string
+		wantIDs   []int64
+		wantTitle string
+	}{
+		{"Title ranks first", "report", []int64{1, 3}, "Weekly <em>report</em>"},
+		{"All words", "weekly milk", []int64{2}, "Buy <em>milk</em>"},
+		{"Phrase", `"weekly report"`, []int64{1}, "<em>Weekly report</em>"},
+		{"Phrase words apart", `"report weekly"`, []int64{}, ""},
+		{"Prefix", "rep*", []int64{1, 4, 3}, "Weekly <em>report</em>"},
+		{"Case insensitive", "MILK", []int64{2}, "Buy <em>milk</em>"},
+		{"No match", "holiday", []int64{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.q)
+			if err != nil {
+				t.Fatalf("ParseQuery() error = %v", err)
+			}
+			hits := x.Search(q, "<em>", "</em>")
+			ids := []int64{}
+			for _, h := range hits {
+				ids = append(ids, h.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("Index.Search() = %v, want %v", ids, tt.wantIDs)
+			}
+			if len(hits) > 0 && hits[0].Title != tt.wantTitle {
+				t.Errorf("Index.Search() title = %q, want %q", hits[0].Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestIndexSnippet(t *testing.T) {
+	words := make([]string, 60)
+	for i := range words {
+		words[i] = "word"
+	}
+	words[30] = "needle"
+
+	x := NewIndex()
+	x.Put(Document{ID: 1, Title: "Haystack", Description: strings.Join(words, " ")})
+	x.Put(Document{ID: 2, Title: "Needle", Description: "Short, needle-sharp text"})
+
+	q, _ := ParseQuery("needle")
+	hits := x.Search(q, "[", "]")
+	if len(hits) != 2 {
+		t.Fatalf("Index.Search() = %v, want 2 hits", hits)
+	}
+
+	want := "…" + strings.Repeat("word ", snippetLead) + "[needle]" + strings.Repeat(" word", snippetSize-snippetLead-1) + "…"
+	if hits[1].Snippet != want {
+		t.Errorf("Index.Search() snippet = %q, want %q", hits[1].Snippet, want)
+	}
+	if want := "Short, [needle]-sharp text"; hits[0].Snippet != want {
+		t.Errorf("Index.Search() snippet = %q, want %q", hits[0].Snippet, want)
+	}
+}
+
+func TestIndexPutRemoveLoad(t *testing.T) {
+	x := NewIndex()
+	search := func(q string) []int64 {
+		query, _ := ParseQuery(q)
+		ids := []int64{}
+		for _, h := range x.Search(query, "", "") {
+			ids = append(ids, h.ID)
+		}
+		return ids
+	}
+
+	//changes made before Load are newer than the loaded todo entities
+	x.Put(Document{ID: 1, Title: "new title"})
+	x.Remove(2)
+	x.Load([]Document{{ID: 1, Title: "old title"}, {ID: 2, Title: "old title"}, {ID: 3, Title: "old title"}})
+	if !x.Loaded() {
+		t.Error("Index.Loaded() = false, want true")
+	}
+	if got := search("title"); !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Errorf("Index.Search() = %v, want [1 3]", got)
+	}
+	if got := search("old"); !reflect.DeepEqual(got, []int64{3}) {
+		t.Errorf("Index.Search() = %v, want [3]", got)
+	}
+
+	x.Put(Document{ID: 3, Title: "renamed"})
+	x.Remove(1)
+	if got := search("title"); !reflect.DeepEqual(got, []int64{}) {
+		t.Errorf("Index.Search() = %v, want []", got)
+	}
+	if got := search("renamed"); !reflect.DeepEqual(got, []int64{3}) {
+		t.Errorf("Index.Search() = %v, want [3]", got)
+	}
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+//ErrEmptyQuery is returned by ParseQuery when query has no words to search for
+var ErrEmptyQuery = errors.New("query has no words to search for")
+
+//Clause is part of the query todo entity must match
+type Clause struct {
+	//Terms are lower case words that must follow each other, a single word for word and prefix clauses
+	Terms []string
+
+	//Prefix is set when the clause matches words starting with the term
+	Prefix bool
+}
+
+//Query is a list of clauses todo entity must match all of
+type Query []Clause
+
+//ParseQuery parses query of words, "quoted phrases" and prefix* words.
+//Words are split on anything except letters and digits, a missing closing quote ends the phrase at the end of the query.
+func ParseQuery(q string) (Query, error) {
+	var query Query
+	for {
+		q = strings.TrimLeftFunc(q, unicode.IsSpace)
+		if q == "" {
+			break
+		}
+		if q[0] == '"' {
+			q = q[1:]
+			end := strings.IndexByte(q, '"')
+			if end < 0 {
+				end = len(q)
+			}
+			if terms := terms(q[:end]); len(terms) > 0 {
+				query = append(query, Clause{Terms: terms})
+			}
+			q = q[min(end+1, len(q)):]
+			continue
+		}
+
+		end := strings.IndexFunc(q, func(r rune) bool { return r == '"' || unicode.IsSpace(r) })
+		if end < 0 {
+			end = len(q)
+		}
+		word := q[:end]
+		q = q[end:]
+
+		prefix := strings.HasSuffix(word, "*")
+		terms := terms(word)
+		for i, term := range terms {
+			query = append(query, Clause{Terms: []string{term}, Prefix: prefix && i == len(terms)-1})
+		}
+	}
+	if len(query) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	return query, nil
+}
+
+//terms returns lower case words of the text
+func terms(text string) []string {
+	var terms []string
+	for _, t := range tokenize(text) {
+		terms = append(terms, t.term)
+	}
+	return terms
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,319 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//maxBatchSize is the largest number of requests of one batch
+const maxBatchSize = 1000
+
+//batch collects items of the batch and statuses of the requests which failed before they reached the repository
+type batch struct {
+	atomic bool
+
+	//items are operations of requests passed to the repository
+	items [][]*storage.BatchOp
+
+	//requests are indexes of the requests the items belong to
+	requests []int
+
+	//errs are statuses of the requests by index, nil for succeeded ones
+	errs []error
+}
+
+//newBatch checks mode and size of the batch of n requests
+func newBatch(mode v1.BatchMode, n int) (*batch, error) {
+	b := &batch{errs: make([]error, n)}
+	switch mode {
+	case v1.BatchMode_BATCH_MODE_UNSPECIFIED, v1.BatchMode_ATOMIC:
+		b.atomic = true
+	case v1.BatchMode_PER_ITEM:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "mode field has unknown value %d", mode)
+	}
+	if n > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "requests field has %d items, at most %d are allowed", n, maxBatchSize)
+	}
+	return b, nil
+}
+
+//add adds operations of the request to the batch or records its failure,
+//failure of atomic batch is returned as the status of the whole call
+func (b *batch) add(i int, ops []*storage.BatchOp, err error) error {
+	if err != nil {
+		if b.atomic {
+			return requestError(i, err)
+		}
+		b.errs[i] = err
+		return nil
+	}
+	b.items = append(b.items, ops)
+	b.requests = append(b.requests, i)
+	return nil
+}
+
+//run runs the items in the repository, errors of items are converted to statuses by itemError
+func (b *batch) run(ctx context.Context, repo storage.Repository, itemError func(i int, err error) error) error {
+	if len(b.items) == 0 {
+		return nil
+	}
+	errs, err := repo.Batch(ctx, b.items, b.atomic)
+	if err != nil {
+		return status.Errorf(codes.Unknown, "failed to run batch -> %s", err.Error())
+	}
+	for k, err := range errs {
+		if err == nil {
+			continue
+		}
+		i := b.requests[k]
+		if b.atomic {
+			return requestError(i, itemError(i, err))
+		}
+		b.errs[i] = itemError(i, err)
+	}
+	return nil
+}
+
+//succeeded reports whether the request of index i succeeded
+func (b *batch) succeeded(i int) bool {
+	return b.errs[i] == nil
+}
+
+//rpcStatus returns status of the request of index i
+func (b *batch) rpcStatus(i int) *status.Status {
+	return status.Convert(b.errs[i])
+}
+
+//requestError returns status of the failed request of atomic batch
+func requestError(i int, err error) error {
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "requests[%d]: %s", i, st.Message())
+}
+
+//BatchCreate creates todo entities in one transaction
+func (s *todoServiceServer) BatchCreate(ctx context.Context, req *v1.BatchCreateRequest) (*v1.BatchCreateResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	b, err := newBatch(req.Mode, len(req.Requests))
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	created := make([]*storage.ToDo, len(req.Requests))
+	for i, r := range req.Requests {
+		err := s.checkAPI(r.GetApi())
+		if err == nil {
+			created[i], err = s.prepareCreate(ctx, r.GetToDo(), now)
+		}
+		if err := b.add(i, []*storage.BatchOp{{Kind: storage.BatchCreate, ToDo: created[i]}}, err); err != nil {
+			return nil, err
+		}
+	}
+
+	//todo entities failing validation are not created
+	valid := 0
+	for _, std := range created {
+		if std != nil {
+			valid++
+		}
+	}
+	if err := s.checkQuota(ctx, valid); err != nil {
+		return nil, err
+	}
+
+	if err := b.run(ctx, s.repo(ctx), func(i int, err error) error { return storageError(err, 0) }); err != nil {
+		return nil, err
+	}
+
+	results := make([]*v1.BatchCreateResult, len(req.Requests))
+	for i := range req.Requests {
+		results[i] = &v1.BatchCreateResult{Status: b.rpcStatus(i).Proto()}
+		if b.succeeded(i) {
+			results[i].Id = created[i].ID
+			s.notify(ctx, v1.WatchResponse_CREATED, created[i], now)
+		}
+	}
+
+	return &v1.BatchCreateResponse{
+		Api:     apiVersion,
+		Results: results,
+	}, nil
+}
+
+//BatchUpdate updates todo entities in one transaction, every todo entity is updated at most once
+func (s *todoServiceServer) BatchUpdate(ctx context.Context, req *v1.BatchUpdateRequest) (*v1.BatchUpdateResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	b, err := newBatch(req.Mode, len(req.Requests))
+	if err != nil {
+		return nil, err
+	}
+	if err := uniqueIDs(len(req.Requests), func(i int) int64 { return req.Requests[i].GetToDo().GetId() }); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	updates := make([]*storage.BatchOp, len(req.Requests))
+	nexts := make([]*storage.BatchOp, len(req.Requests))
+	for i, r := range req.Requests {
+		ops, err := s.batchUpdateOps(ctx, r, now)
+		if err == nil {
+			updates[i] = ops[0]
+			if len(ops) > 1 {
+				nexts[i] = ops[1]
+			}
+		}
+		if err := b.add(i, ops, err); err != nil {
+			return nil, err
+		}
+	}
+
+	//next occurrences of completed todo entities count towards the quota of the tenant
+	occurrences := 0
+	for _, next := range nexts {
+		if next != nil {
+			occurrences++
+		}
+	}
+	if err := s.checkQuota(ctx, occurrences); err != nil {
+		return nil, err
+	}
+
+	if err := b.run(ctx, s.repo(ctx), func(i int, err error) error { return storageError(err, req.Requests[i].ToDo.Id) }); err != nil {
+		return nil, err
+	}
+
+	results := make([]*v1.BatchUpdateResult, len(req.Requests))
+	for i := range req.Requests {
+		results[i] = &v1.BatchUpdateResult{Status: b.rpcStatus(i).Proto()}
+		if !b.succeeded(i) {
+			continue
+		}
+		results[i].Updated = updates[i].Rows
+		s.notify(ctx, v1.WatchResponse_UPDATED, updates[i].ToDo, now)
+		if next := nexts[i]; next != nil && next.ToDo.ID != 0 {
+			results[i].NextId = next.ToDo.ID
+			s.notify(ctx, v1.WatchResponse_CREATED, next.ToDo, now)
+		}
+	}
+
+	return &v1.BatchUpdateResponse{
+		Api:     apiVersion,
+		Results: results,
+	}, nil
+}
+
+//batchUpdateOps returns update of todo entity followed by creation of its next occurrence when the update completes it
+func (s *todoServiceServer) batchUpdateOps(ctx context.Context, req *v1.UpdateRequest, now time.Time) ([]*storage.BatchOp, error) {
+	if err := s.checkAPI(req.GetApi()); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "toDo field is required")
+	}
+	std, completed, err := s.prepareUpdate(ctx, req, now)
+	if err != nil {
+		return nil, err
+	}
+	ops := []*storage.BatchOp{{Kind: storage.BatchUpdate, ToDo: std}}
+	if !completed {
+		return ops, nil
+	}
+
+	//the next occurrence already exists when the same occurrence was completed before
+	next, err := nextOccurrence(std, now)
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		ops = append(ops, &storage.BatchOp{Kind: storage.BatchCreate, ToDo: next, SkipExisting: true})
+	}
+	return ops, nil
+}
+
+//BatchDelete moves todo entities to the trash in one transaction, every todo entity is deleted at most once
+func (s *todoServiceServer) BatchDelete(ctx context.Context, req *v1.BatchDeleteRequest) (*v1.BatchDeleteResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	b, err := newBatch(req.Mode, len(req.Requests))
+	if err != nil {
+		return nil, err
+	}
+	if err := uniqueIDs(len(req.Requests), func(i int) int64 { return req.Requests[i].GetId() }); err != nil {
+		return nil, err
+	}
+
+	//deleted todo entities and changed subtasks are sent to watchers
+	now := s.now()
+	deleted := make([]*storage.ToDo, len(req.Requests))
+	subtasks := make([][]*storage.ToDo, len(req.Requests))
+	deletes := make([]*storage.BatchOp, len(req.Requests))
+	for i, r := range req.Requests {
+		err := s.checkAPI(r.GetApi())
+		var mode storage.DeleteMode
+		var version int64
+		if err == nil {
+			mode, err = deleteMode(r.GetMode())
+		}
+		if err == nil {
+			version, err = s.expectedVersion(ctx, r.GetVersion())
+		}
+		if err == nil {
+			deleted[i], subtasks[i], err = s.deleteTargets(ctx, r.GetId(), mode)
+		}
+		deletes[i] = &storage.BatchOp{Kind: storage.BatchTrash, ID: r.GetId(), Version: version, Mode: mode, DeletedAt: now}
+		if err := b.add(i, []*storage.BatchOp{deletes[i]}, err); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.run(ctx, s.repo(ctx), func(i int, err error) error { return storageError(err, req.Requests[i].Id) }); err != nil {
+		return nil, err
+	}
+
+	results := make([]*v1.BatchDeleteResult, len(req.Requests))
+	for i := range req.Requests {
+		results[i] = &v1.BatchDeleteResult{Status: b.rpcStatus(i).Proto()}
+		if b.succeeded(i) {
+			results[i].Deleted = deletes[i].Rows
+			s.notifyDelete(ctx, deleted[i], subtasks[i], deletes[i].Mode, now)
+		}
+	}
+
+	return &v1.BatchDeleteResponse{
+		Api:     apiVersion,
+		Results: results,
+	}, nil
+}
+
+//uniqueIDs checks that n requests of the batch change different todo entities,
+//requests without ID fail on their own as todo entity is not found
+func uniqueIDs(n int, id func(i int) int64) error {
+	seen := make(map[int64]int, n)
+	for i := 0; i < n; i++ {
+		if id(i) == 0 {
+			continue
+		}
+		if j, ok := seen[id(i)]; ok {
+			return status.Errorf(codes.InvalidArgument, "requests[%d] and requests[%d] change the same todo entity with ID='%d'", j, i, id(i))
+		}
+		seen[id(i)] = i
+	}
+	return nil
+}
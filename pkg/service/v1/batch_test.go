@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	rpc "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerBatch(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC))
+
+	create := func(title string, labels ...string) *v1.CreateRequest {
+		return &v1.CreateRequest{
+			Api:  apiVersion,
+			ToDo: &v1.ToDo{Title: title, EstimatedTimeOfCompletion: s.ts, Reminder: s.ts, Labels: labels},
+		}
+	}
+	//titles returns IDs of all todo entities by their titles
+	titles := func(t *testing.T) map[string]int64 {
+		res, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+		if err != nil {
+			t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+		}
+		ids := map[string]int64{}
+		for _, td := range res.ToDos {
+			ids[td.Title] = td.Id
+		}
+		return ids
+	}
+
+	t.Run("BatchCreate", func(t *testing.T) {
+		//atomic batch fails as a whole with the status of the failed request,
+		//failed requests of per item batch are skipped
+		tests := []struct {
+			name       string
+			req        *v1.BatchCreateRequest
+			want       []codes.Code
+			wantCode   codes.Code
+			wantTitles []string
+		}{
+			{
+				name:       "Atomic",
+				req:        &v1.BatchCreateRequest{Api: apiVersion, Requests: []*v1.CreateRequest{create("report"), create("groceries")}},
+				want:       []codes.Code{codes.OK, codes.OK},
+				wantTitles: []string{"groceries", "report"},
+			},
+			{
+				name:       "Invalid request",
+				req:        &v1.BatchCreateRequest{Api: apiVersion, Requests: []*v1.CreateRequest{create("taxes"), create("garden", " ")}, Mode: v1.BatchMode_ATOMIC},
+				wantCode:   codes.InvalidArgument,
+				wantTitles: []string{"groceries", "report"},
+			},
+			{
+				name:       "Title taken",
+				req:        &v1.BatchCreateRequest{Api: apiVersion, Requests: []*v1.CreateRequest{create("taxes"), create("report")}, Mode: v1.BatchMode_ATOMIC},
+				wantCode:   codes.AlreadyExists,
+				wantTitles: []string{"groceries", "report"},
+			},
+			{
+				name:       "Title taken in batch",
+				req:        &v1.BatchCreateRequest{Api: apiVersion, Requests: []*v1.CreateRequest{create("taxes"), create("taxes")}, Mode: v1.BatchMode_ATOMIC},
+				wantCode:   codes.AlreadyExists,
+				wantTitles: []string{"groceries", "report"},
+			},
+			{
+				name: "Per item",
+				req: &v1.BatchCreateRequest{
+					Api:      apiVersion,
+					Requests: []*v1.CreateRequest{create("taxes"), create("report"), create("garden", " "), {Api: "v2"}},
+					Mode:     v1.BatchMode_PER_ITEM,
+				},
+				want:       []codes.Code{codes.OK, codes.AlreadyExists, codes.InvalidArgument, codes.Unimplemented},
+				wantTitles: []string{"groceries", "report", "taxes"},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.BatchCreate(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.BatchCreate() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err != nil && !strings.Contains(err.Error(), "requests[1]") {
+					t.Errorf("toDoServiceServer.BatchCreate() error = %v, want the failed request", err)
+				}
+				if err == nil && !reflect.DeepEqual(batchCodes(got.Results), tt.want) {
+					t.Errorf("toDoServiceServer.BatchCreate() = %v, want codes %v", got.Results, tt.want)
+				}
+				for _, r := range got.GetResults() {
+					if codes.Code(r.Status.Code) == codes.OK && r.Id == 0 {
+						t.Errorf("toDoServiceServer.BatchCreate() = %v, want IDs of created todo entities", got.Results)
+					}
+				}
+				left := []string{}
+				for title := range titles(t) {
+					left = append(left, title)
+				}
+				sort.Strings(left)
+				if !reflect.DeepEqual(left, tt.wantTitles) {
+					t.Errorf("toDoServiceServer.BatchCreate() left %v, want %v", left, tt.wantTitles)
+				}
+			})
+		}
+	})
+	ids := titles(t)
+	report, groceries, taxes := ids["report"], ids["groceries"], ids["taxes"]
+
+	t.Run("BatchUpdate", func(t *testing.T) {
+		//completed recurring todo entity is followed by the next occurrence
+		if _, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:  apiVersion,
+			ToDo: &v1.ToDo{Id: groceries, Title: "groceries", Status: v1.Status_TODO, EstimatedTimeOfCompletion: s.ts, Reminder: s.ts, Recurrence: "FREQ=WEEKLY"},
+		}); err != nil {
+			t.Fatalf("toDoServiceServer.Update() error = %v", err)
+		}
+		requests := []*v1.UpdateRequest{
+			{Api: apiVersion, ToDo: &v1.ToDo{Id: report, Title: "weekly report", Status: v1.Status_TODO, EstimatedTimeOfCompletion: s.ts, Reminder: s.ts}},
+			{Api: apiVersion, ToDo: &v1.ToDo{Id: groceries, Status: v1.Status_DONE}, UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}}},
+			{Api: apiVersion, ToDo: &v1.ToDo{Id: 42, Title: "missing"}},
+		}
+
+		tests := []struct {
+			name      string
+			req       *v1.BatchUpdateRequest
+			want      []codes.Code
+			wantCode  codes.Code
+			wantTitle string
+		}{
+			{
+				name:      "Atomic",
+				req:       &v1.BatchUpdateRequest{Api: apiVersion, Requests: requests},
+				wantCode:  codes.NotFound,
+				wantTitle: "report",
+			},
+			{
+				name:      "Per item",
+				req:       &v1.BatchUpdateRequest{Api: apiVersion, Requests: requests, Mode: v1.BatchMode_PER_ITEM},
+				want:      []codes.Code{codes.OK, codes.OK, codes.NotFound},
+				wantTitle: "weekly report",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.BatchUpdate(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.BatchUpdate() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if read, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: report}); err != nil || read.ToDo.Title != tt.wantTitle {
+					t.Errorf("toDoServiceServer.Read() = %v, %v, want title %q", read, err, tt.wantTitle)
+				}
+				if err != nil {
+					return
+				}
+				if r := got.Results; !reflect.DeepEqual(batchCodes(r), tt.want) || r[0].Updated != 1 || r[1].NextId == 0 {
+					t.Fatalf("toDoServiceServer.BatchUpdate() = %v, want codes %v", r, tt.want)
+				}
+				if read, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: got.Results[1].NextId}); err != nil || read.ToDo.Recurrence != "FREQ=WEEKLY" {
+					t.Errorf("toDoServiceServer.Read() = %v, %v, want the next occurrence", read, err)
+				}
+			})
+		}
+	})
+
+	t.Run("BatchDelete", func(t *testing.T) {
+		requests := []*v1.DeleteRequest{{Api: apiVersion, Id: taxes}, {Api: apiVersion, Id: 42}}
+		tests := []struct {
+			name     string
+			req      *v1.BatchDeleteRequest
+			want     []codes.Code
+			wantCode codes.Code
+			wantRead codes.Code
+		}{
+			{
+				name:     "Atomic",
+				req:      &v1.BatchDeleteRequest{Api: apiVersion, Requests: requests},
+				wantCode: codes.NotFound,
+				wantRead: codes.OK,
+			},
+			{
+				name:     "Per item",
+				req:      &v1.BatchDeleteRequest{Api: apiVersion, Requests: requests, Mode: v1.BatchMode_PER_ITEM},
+				want:     []codes.Code{codes.OK, codes.NotFound},
+				wantRead: codes.NotFound,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.BatchDelete(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.BatchDelete() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && (!reflect.DeepEqual(batchCodes(got.Results), tt.want) || got.Results[0].Deleted != 1) {
+					t.Errorf("toDoServiceServer.BatchDelete() = %v, want codes %v", got.Results, tt.want)
+				}
+				if _, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: taxes}); status.Code(err) != tt.wantRead {
+					t.Errorf("toDoServiceServer.Read() error = %v, wantCode %v", err, tt.wantRead)
+				}
+			})
+		}
+	})
+
+	t.Run("Invalid batch", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			call     func() error
+			wantCode codes.Code
+		}{
+			{
+				name: "Same todo entity twice",
+				call: func() error {
+					_, err := s.BatchDelete(ctx, &v1.BatchDeleteRequest{Api: apiVersion, Requests: []*v1.DeleteRequest{{Id: report}, {Id: report}}})
+					return err
+				},
+				wantCode: codes.InvalidArgument,
+			},
+			{
+				name: "Too many requests",
+				call: func() error {
+					_, err := s.BatchCreate(ctx, &v1.BatchCreateRequest{Api: apiVersion, Requests: make([]*v1.CreateRequest, maxBatchSize+1)})
+					return err
+				},
+				wantCode: codes.InvalidArgument,
+			},
+			{
+				name: "Unknown mode",
+				call: func() error {
+					_, err := s.BatchUpdate(ctx, &v1.BatchUpdateRequest{Api: apiVersion, Mode: 42})
+					return err
+				},
+				wantCode: codes.InvalidArgument,
+			},
+			{
+				name: "Unsupported API",
+				call: func() error {
+					_, err := s.BatchCreate(ctx, &v1.BatchCreateRequest{Api: "v2"})
+					return err
+				},
+				wantCode: codes.Unimplemented,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.call(); status.Code(err) != tt.wantCode {
+					t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+}
+
+//batchCodes returns status codes of the results of batch requests in their order
+func batchCodes(results interface{}) []codes.Code {
+	list := reflect.ValueOf(results)
+	got := []codes.Code{}
+	for i := 0; i < list.Len(); i++ {
+		r := list.Index(i).Interface().(interface{ GetStatus() *rpc.Status })
+		got = append(got, codes.Code(r.GetStatus().GetCode()))
+	}
+	return got
+}
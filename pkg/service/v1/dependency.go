@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//ClosedStatuses returns stored statuses of finished todo entities which do not block dependent todo entities
+//and need no reminder. Cancelled todo entity is never going to be completed so it is finished too.
+func ClosedStatuses() []string {
+	return []string{statusToStorage(v1.Status_DONE), statusToStorage(v1.Status_CANCELLED)}
+}
+
+//isClosed reports whether todo entity in the status does not block dependent todo entities
+func isClosed(st v1.Status) bool {
+	return st == v1.Status_DONE || st == v1.Status_CANCELLED
+}
+
+//checkPrerequisites returns FailedPrecondition when todo entity has open prerequisites
+func (s *todoServiceServer) checkPrerequisites(ctx context.Context, id int64) error {
+	prerequisites, err := s.repo(ctx).Prerequisites(ctx, id)
+	if err != nil {
+		return storageError(err, id)
+	}
+
+	var open []string
+	for _, p := range prerequisites {
+		if !isClosed(statusFromStorage(p.Status)) {
+			open = append(open, strconv.FormatInt(p.ID, 10))
+		}
+	}
+	if len(open) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "ToDo with ID='%d' can't be completed before its prerequisites %s", id, strings.Join(open, ", "))
+	}
+	return nil
+}
+
+//AddDependency makes todo entity depend on another todo entity
+func (s *todoServiceServer) AddDependency(ctx context.Context, req *v1.AddDependencyRequest) (*v1.AddDependencyResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	//the caller changes the dependent todo entity and has to read the prerequisite
+	if _, err := s.get(ctx, req.Id, editAccess); err != nil {
+		return nil, err
+	}
+	prerequisite, err := s.repo(ctx).Get(ctx, req.DependsOnId)
+	if err == storage.ErrNotFound {
+		return nil, storageError(storage.ErrPrerequisiteNotFound, req.Id)
+	}
+	if err != nil {
+		return nil, storageError(err, req.DependsOnId)
+	}
+	if err := s.checkAccess(ctx, prerequisite, viewAccess); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo(ctx).AddDependency(ctx, req.Id, req.DependsOnId); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	return &v1.AddDependencyResponse{
+		Api: apiVersion,
+	}, nil
+}
+
+//RemoveDependency removes dependency of todo entity on another todo entity
+func (s *todoServiceServer) RemoveDependency(ctx context.Context, req *v1.RemoveDependencyRequest) (*v1.RemoveDependencyResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(ctx, req.Id, editAccess); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo(ctx).RemoveDependency(ctx, req.Id, req.DependsOnId); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	return &v1.RemoveDependencyResponse{
+		Api: apiVersion,
+	}, nil
+}
+
+//ListTopological reads todo entities ordered so that every todo entity follows its prerequisites
+func (s *todoServiceServer) ListTopological(ctx context.Context, req *v1.ListTopologicalRequest) (*v1.ListTopologicalResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.ProjectId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "project_id must not be negative, got %d", req.ProjectId)
+	}
+	if req.ProjectId != 0 {
+		if _, err := s.repo(ctx).GetProject(ctx, req.ProjectId); err != nil {
+			return nil, projectError(err, req.ProjectId)
+		}
+	}
+
+	stds, err := s.repo(ctx).List(ctx, storage.ListOptions{ProjectID: req.ProjectId, VisibleTo: s.user(ctx)})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to select from ToDo -> %s", err.Error())
+	}
+	deps, err := s.repo(ctx).Dependencies(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to select from ToDoDependency -> %s", err.Error())
+	}
+
+	//only dependencies between listed todo entities are ordered,
+	//prerequisites in other projects or of other users don't change the order
+	listed := make(map[int64]*storage.ToDo, len(stds))
+	for _, std := range stds {
+		listed[std.ID] = std
+	}
+	blockers := map[int64]int{}
+	dependents := map[int64][]int64{}
+	edges := []*v1.Dependency{}
+	for _, d := range deps {
+		if listed[d.ToDoID] == nil || listed[d.DependsOnID] == nil {
+			continue
+		}
+		blockers[d.ToDoID]++
+		dependents[d.DependsOnID] = append(dependents[d.DependsOnID], d.ToDoID)
+		edges = append(edges, &v1.Dependency{ToDoId: d.ToDoID, DependsOnId: d.DependsOnID})
+	}
+
+	//Kahn's algorithm, todo entities which are ready at the same time go by ID
+	var ready []int64
+	for _, std := range stds {
+		if blockers[std.ID] == 0 {
+			ready = append(ready, std.ID)
+		}
+	}
+	list := make([]*v1.ToDo, 0, len(stds))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return ready[i] < ready[j] })
+		id := ready[0]
+		ready = ready[1:]
+
+		td, err := toProto(listed[id])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+
+		for _, dependent := range dependents[id] {
+			if blockers[dependent]--; blockers[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(list) != len(stds) {
+		//repository rejects cycles so it happens only when the data is modified bypassing the service
+		return nil, status.Error(codes.Internal, "dependencies of ToDo form a cycle")
+	}
+
+	return &v1.ListTopologicalResponse{
+		Api:          apiVersion,
+		ToDos:        list,
+		Dependencies: edges,
+	}, nil
+}
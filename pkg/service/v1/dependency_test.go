@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerDependencies(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC))
+
+	announce := s.mustCreate(t, ctx, &v1.ToDo{Title: "announce"})
+	deploy := s.mustCreate(t, ctx, &v1.ToDo{Title: "deploy"})
+	review := s.mustCreate(t, ctx, &v1.ToDo{Title: "review", Status: v1.Status_IN_PROGRESS})
+	docs := s.mustCreate(t, ctx, &v1.ToDo{Title: "docs", Status: v1.Status_CANCELLED})
+
+	t.Run("AddDependency", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.AddDependencyRequest
+			wantCode codes.Code
+		}{
+			{"Deploy after review", &v1.AddDependencyRequest{Api: apiVersion, Id: deploy, DependsOnId: review}, codes.OK},
+			{"Announce after deploy", &v1.AddDependencyRequest{Api: apiVersion, Id: announce, DependsOnId: deploy}, codes.OK},
+			{"Announce after docs", &v1.AddDependencyRequest{Api: apiVersion, Id: announce, DependsOnId: docs}, codes.OK},
+			{"Exists", &v1.AddDependencyRequest{Api: apiVersion, Id: deploy, DependsOnId: review}, codes.AlreadyExists},
+			{"Cycle", &v1.AddDependencyRequest{Api: apiVersion, Id: review, DependsOnId: announce}, codes.FailedPrecondition},
+			{"Itself", &v1.AddDependencyRequest{Api: apiVersion, Id: review, DependsOnId: review}, codes.FailedPrecondition},
+			{"Missing prerequisite", &v1.AddDependencyRequest{Api: apiVersion, Id: review, DependsOnId: 42}, codes.NotFound},
+			{"Missing task", &v1.AddDependencyRequest{Api: apiVersion, Id: 42, DependsOnId: review}, codes.NotFound},
+			{"Unsupported API", &v1.AddDependencyRequest{Api: "v1000", Id: deploy, DependsOnId: review}, codes.Unimplemented},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.AddDependency(ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.AddDependency() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ListTopological", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			req              *v1.ListTopologicalRequest
+			want             []int64
+			wantDependencies []*v1.Dependency
+			wantCode         codes.Code
+		}{
+			{
+				name:             "Prerequisites first",
+				req:              &v1.ListTopologicalRequest{Api: apiVersion},
+				want:             []int64{review, deploy, docs, announce},
+				wantDependencies: []*v1.Dependency{{ToDoId: announce, DependsOnId: deploy}, {ToDoId: announce, DependsOnId: docs}, {ToDoId: deploy, DependsOnId: review}},
+			},
+			{
+				name:     "Project not found",
+				req:      &v1.ListTopologicalRequest{Api: apiVersion, ProjectId: 42},
+				wantCode: codes.NotFound,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ListTopological(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ListTopological() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				if !reflect.DeepEqual(toDoIDs(got.ToDos), tt.want) || !reflect.DeepEqual(got.Dependencies, tt.wantDependencies) {
+					t.Errorf("toDoServiceServer.ListTopological() = %v, %v, want %v, %v", toDoIDs(got.ToDos), got.Dependencies, tt.want, tt.wantDependencies)
+				}
+			})
+		}
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		//prerequisites are completed first
+		tests := []struct {
+			name      string
+			id        int64
+			wantCode  codes.Code
+			wantReady []int64
+		}{
+			{"Open prerequisite", deploy, codes.FailedPrecondition, []int64{review}},
+			{"Without prerequisites", review, codes.OK, []int64{deploy}},
+			{"Done prerequisite", deploy, codes.OK, []int64{announce}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := s.Update(ctx, &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: tt.id, Status: v1.Status_DONE},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+				})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Update() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				ready, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion, Ready: true})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+				}
+				if got := toDoIDs(ready.ToDos); !reflect.DeepEqual(got, tt.wantReady) {
+					t.Errorf("toDoServiceServer.ReadAll() ready = %v, want %v", got, tt.wantReady)
+				}
+			})
+		}
+	})
+
+	t.Run("RemoveDependency", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.RemoveDependencyRequest
+			wantCode codes.Code
+		}{
+			{"Not found", &v1.RemoveDependencyRequest{Api: apiVersion, Id: announce, DependsOnId: review}, codes.NotFound},
+			{"OK", &v1.RemoveDependencyRequest{Api: apiVersion, Id: deploy, DependsOnId: review}, codes.OK},
+			{"Removed", &v1.RemoveDependencyRequest{Api: apiVersion, Id: deploy, DependsOnId: review}, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.RemoveDependency(ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.RemoveDependency() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+}
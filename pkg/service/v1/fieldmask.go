@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"strings"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Fields of todo entity Update is able to change.
+//Names are field mask paths normalized by normalizePath.
+const (
+	fieldTitle                     = "title"
+	fieldDescription               = "description"
+	fieldStatus                    = "status"
+	fieldEstimatedTimeOfCompletion = "estimatedtimeofcompletion"
+	fieldReminder                  = "reminder"
+	fieldRecurrence                = "recurrence"
+	fieldProjectID                 = "projectid"
+)
+
+//allFields are fields replaced by Update without field mask
+var allFields = []string{
+	fieldTitle,
+	fieldDescription,
+	fieldStatus,
+	fieldEstimatedTimeOfCompletion,
+	fieldReminder,
+	fieldRecurrence,
+	fieldProjectID,
+}
+
+//normalizePath makes field mask path independent of naming style.
+//Gateway infers Go names from PATCH body e.g. "EstimatedTimeOfCompletion",
+//clients send proto names e.g. "estimated_time_of_completion" or JSON names e.g. "toDo.reminder".
+func normalizePath(path string) string {
+	path = strings.ToLower(strings.Replace(path, "_", "", -1))
+	return strings.TrimPrefix(path, "todo.")
+}
+
+//updateFields returns fields listed in update mask, all fields are returned when mask is empty
+func updateFields(mask *field_mask.FieldMask) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return allFields, nil
+	}
+
+	var fields []string
+	for _, path := range mask.GetPaths() {
+		field := normalizePath(path)
+		switch field {
+		case "id", "actualtimeofcompletion", "createdat", "updatedat", "parentid", "labels", "version", "owner":
+			//ID identifies todo entity to update, the times are set by server, parent is changed by Move,
+			//labels by AddLabels and RemoveLabels, version is checked rather than updated and owner is never changed,
+			//gateway adds them to the mask when client sends them back in PATCH body
+			continue
+		case "*":
+			return allFields, nil
+		case fieldTitle, fieldDescription, fieldStatus, fieldEstimatedTimeOfCompletion, fieldReminder, fieldRecurrence, fieldProjectID:
+			fields = append(fields, field)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask contains unsupported field '%s'", path)
+		}
+	}
+	return fields, nil
+}
+
+//applyFields copies fields of todo entity received from client into the stored todo entity
+func applyFields(std *storage.ToDo, td *v1.ToDo, fields []string) error {
+	var err error
+	for _, field := range fields {
+		switch field {
+		case fieldTitle:
+			std.Title = td.Title
+		case fieldDescription:
+			std.Description = td.Description
+		case fieldStatus:
+			if _, ok := v1.Status_name[int32(td.Status)]; !ok {
+				return status.Errorf(codes.InvalidArgument, "status field has unknown value %d", td.Status)
+			}
+			std.Status = statusToStorage(td.Status)
+		case fieldEstimatedTimeOfCompletion:
+			std.EstimatedTimeOfCompletion, err = requiredTime("estimatedTimeOfCompletion", td.EstimatedTimeOfCompletion)
+		case fieldReminder:
+			std.Reminder, err = requiredTime("reminder", td.Reminder)
+		case fieldRecurrence:
+			std.Recurrence, err = normalizeRecurrence(td.Recurrence)
+		case fieldProjectID:
+			std.ProjectID = td.ProjectId
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//requiredTime converts timestamp of the field which can't be unset
+func requiredTime(name string, ts *timestamp.Timestamp) (time.Time, error) {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s field has invalid format -> %s", name, err.Error())
+	}
+	return t, nil
+}
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//actorMetadata is the metadata key of the caller recorded in the history of changes and owning created todo entities
+	//when authentication is disabled, HTTP gateway sets it from X-Actor header
+	actorMetadata = "x-actor"
+
+	//requestIDMetadata is the metadata key of the request ID recorded in the history of changes,
+	//HTTP gateway sets it to the ID of HTTP request
+	requestIDMetadata = "x-request-id"
+
+	//anonymousActor is recorded when the caller is not known
+	anonymousActor = "anonymous"
+)
+
+//historyActions are history actions of the changes sent to watchers
+var historyActions = map[v1.WatchResponse_EventType]storage.HistoryAction{
+	v1.WatchResponse_CREATED: storage.HistoryCreated,
+	v1.WatchResponse_UPDATED: storage.HistoryUpdated,
+	v1.WatchResponse_DELETED: storage.HistoryDeleted,
+}
+
+//historyFields are names of the recorded fields in the order changes are listed
+var historyFields = []string{
+	"title", "description", "status", "estimatedTimeOfCompletion", "actualTimeOfCompletion", "reminder",
+	"recurrence", "projectId", "parentId", "labels", "deletedAt", "owner",
+}
+
+//snapshot formats the recorded fields of todo entity as they are in its JSON representation, unset fields are left out
+func snapshot(std *storage.ToDo) map[string]string {
+	fields := map[string]string{
+		"title":       std.Title,
+		"description": std.Description,
+		"recurrence":  std.Recurrence,
+		"labels":      strings.Join(std.Labels, ","),
+		"owner":       std.Owner,
+	}
+	if st := statusFromStorage(std.Status); st != v1.Status_STATUS_UNSPECIFIED {
+		fields["status"] = st.String()
+	}
+	for name, t := range map[string]time.Time{
+		"estimatedTimeOfCompletion": std.EstimatedTimeOfCompletion,
+		"actualTimeOfCompletion":    std.ActualTimeOfCompletion,
+		"reminder":                  std.Reminder,
+		"deletedAt":                 std.DeletedAt,
+	} {
+		if !t.IsZero() {
+			fields[name] = t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	for name, id := range map[string]int64{"projectId": std.ProjectID, "parentId": std.ParentID} {
+		if id != 0 {
+			fields[name] = strconv.FormatInt(id, 10)
+		}
+	}
+	for name, value := range fields {
+		if len(value) == 0 {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
+
+//changes lists fields which differ in the snapshots before and after the change
+func changes(before, after map[string]string) []*v1.FieldChange {
+	list := []*v1.FieldChange{}
+	for _, name := range historyFields {
+		if before[name] != after[name] {
+			list = append(list, &v1.FieldChange{Field: name, Before: before[name], After: after[name]})
+		}
+	}
+	return list
+}
+
+//caller returns the actor and the request ID sent by the caller in metadata,
+//subject of the authenticated caller is the actor when authentication is enabled
+func caller(ctx context.Context) (actor string, requestID string) {
+	actor = anonymousActor
+	p, authenticated := auth.FromContext(ctx)
+	if authenticated {
+		actor = p.Subject
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return actor, ""
+	}
+	if v := md.Get(actorMetadata); !authenticated && len(v) > 0 && len(v[0]) > 0 {
+		actor = v[0]
+	}
+	if v := md.Get(requestIDMetadata); len(v) > 0 {
+		requestID = v[0]
+	}
+	return actor, requestID
+}
+
+//record stores the change of todo entity made by the call in the history.
+//The change is already made so failure to record it is only logged.
+func (s *todoServiceServer) record(ctx context.Context, action storage.HistoryAction, std *storage.ToDo, tm time.Time) {
+	actor, requestID := caller(ctx)
+	e := &storage.HistoryEntry{
+		ToDoID:    std.ID,
+		Action:    action,
+		Snapshot:  snapshot(std),
+		Actor:     actor,
+		RequestID: requestID,
+		CreatedAt: tm,
+	}
+	if _, err := s.repo(ctx).AddHistory(ctx, e); err != nil {
+		logger.Log.Error("failed to record change of todo entity", zap.Int64("id", std.ID), zap.String("action", string(action)), zap.Error(err))
+	}
+}
+
+//ListHistory lists changes of todo entity, every change is compared to the previous one
+func (s *todoServiceServer) ListHistory(ctx context.Context, req *v1.ListHistoryRequest) (*v1.ListHistoryResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo(ctx).History(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	if err := s.checkHistoryAccess(ctx, req.Id, entries); err != nil {
+		return nil, err
+	}
+
+	list := make([]*v1.HistoryEntry, 0, len(entries))
+	before := map[string]string{}
+	for _, e := range entries {
+		tm, err := timestampProto("time", e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &v1.HistoryEntry{
+			Id:        e.ID,
+			Action:    v1.HistoryEntry_Action(v1.HistoryEntry_Action_value[string(e.Action)]),
+			Changes:   changes(before, e.Snapshot),
+			Actor:     e.Actor,
+			RequestId: e.RequestID,
+			Time:      tm,
+		})
+		before = e.Snapshot
+	}
+
+	return &v1.ListHistoryResponse{
+		Api:     apiVersion,
+		Entries: list,
+	}, nil
+}
+
+//checkHistoryAccess returns PermissionDenied unless the caller may read todo entity with the history entries.
+//History of purged todo entity is read only by its last owner, history of todo entity changed before the history
+//was recorded is empty.
+func (s *todoServiceServer) checkHistoryAccess(ctx context.Context, id int64, entries []*storage.HistoryEntry) error {
+	std, err := s.repo(ctx).Get(ctx, id)
+	if err == storage.ErrNotFound {
+		std, err = s.repo(ctx).Trashed(ctx, id)
+	}
+	switch {
+	case err == nil:
+		return s.checkAccess(ctx, std, viewAccess)
+	case err != storage.ErrNotFound:
+		return storageError(err, id)
+	case len(entries) == 0:
+		return storageError(err, id)
+	}
+
+	//changes recorded before todo entities had owners belong to the default owner
+	owner := entries[len(entries)-1].Snapshot["owner"]
+	if len(owner) == 0 {
+		owner = s.defaultOwner
+	}
+	if owner != s.user(ctx) {
+		return status.Errorf(codes.PermissionDenied, "caller may not read history of ToDo with ID='%d' of another user", id)
+	}
+	return nil
+}
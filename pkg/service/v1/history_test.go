@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerHistory(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	alice := metadata.NewIncomingContext(ctx, metadata.Pairs(actorMetadata, "alice", requestIDMetadata, "host/abc-000001"))
+	//authenticated caller can't pose as another actor
+	bob := auth.NewContext(metadata.NewIncomingContext(ctx, metadata.Pairs(actorMetadata, "mallory")), &auth.Principal{Subject: "bob", Method: auth.MethodJWT})
+
+	var id int64
+	deletedAt := "2020-06-01T10:00:00Z"
+	tests := []struct {
+		name string
+		call func() error
+		want *v1.HistoryEntry
+	}{
+		{
+			name: "Create",
+			call: func() (err error) {
+				id, err = s.create(alice, &v1.ToDo{Title: "report", Labels: []string{"work"}})
+				return err
+			},
+			want: &v1.HistoryEntry{Id: 1, Action: v1.HistoryEntry_CREATED, Actor: "alice", RequestId: "host/abc-000001", Time: s.ts, Changes: []*v1.FieldChange{
+				{Field: "title", After: "report"},
+				{Field: "status", After: "TODO"},
+				{Field: "estimatedTimeOfCompletion", After: "2020-06-01T10:00:00Z"},
+				{Field: "reminder", After: "2020-06-01T10:00:00Z"},
+				{Field: "labels", After: "work"},
+				{Field: "owner", After: "alice"},
+			}},
+		},
+		{
+			name: "Update by authenticated editor",
+			call: func() error {
+				if _, err := s.Share(alice, &v1.ShareRequest{Api: apiVersion, Id: id, Share: &v1.Share{User: "bob", Role: v1.ShareRole_EDITOR}}); err != nil {
+					return err
+				}
+				_, err := s.Update(bob, &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: id, Title: "weekly report", Status: v1.Status_IN_PROGRESS},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"title", "status"}},
+				})
+				return err
+			},
+			want: &v1.HistoryEntry{Id: 2, Action: v1.HistoryEntry_UPDATED, Actor: "bob", Time: s.ts, Changes: []*v1.FieldChange{
+				{Field: "title", Before: "report", After: "weekly report"},
+				{Field: "status", Before: "TODO", After: "IN_PROGRESS"},
+			}},
+		},
+		{
+			name: "Delete",
+			call: func() error {
+				_, err := s.Delete(alice, &v1.DeleteRequest{Api: apiVersion, Id: id})
+				return err
+			},
+			want: &v1.HistoryEntry{Id: 3, Action: v1.HistoryEntry_DELETED, Actor: "alice", RequestId: "host/abc-000001", Time: s.ts, Changes: []*v1.FieldChange{
+				{Field: "deletedAt", After: deletedAt},
+			}},
+		},
+		{
+			name: "Undelete",
+			call: func() error {
+				_, err := s.Undelete(alice, &v1.UndeleteRequest{Api: apiVersion, Id: id})
+				return err
+			},
+			want: &v1.HistoryEntry{Id: 4, Action: v1.HistoryEntry_RESTORED, Actor: "alice", RequestId: "host/abc-000001", Time: s.ts, Changes: []*v1.FieldChange{
+				{Field: "deletedAt", Before: deletedAt},
+			}},
+		},
+	}
+	var want []*v1.HistoryEntry
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			want = append(want, tt.want)
+			got, err := s.ListHistory(alice, &v1.ListHistoryRequest{Api: apiVersion, Id: id})
+			if err != nil {
+				t.Fatalf("toDoServiceServer.ListHistory() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Entries, want) {
+				t.Errorf("toDoServiceServer.ListHistory() = %v, want %v", got.Entries, want)
+			}
+		})
+	}
+
+	t.Run("ListHistory", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			req      *v1.ListHistoryRequest
+			wantCode codes.Code
+		}{
+			{"Shared", bob, &v1.ListHistoryRequest{Api: apiVersion, Id: id}, codes.OK},
+			{"Not found", alice, &v1.ListHistoryRequest{Api: apiVersion, Id: 42}, codes.NotFound},
+			{"Unsupported API", alice, &v1.ListHistoryRequest{Api: "v2", Id: id}, codes.Unimplemented},
+			{"Other user", ctx, &v1.ListHistoryRequest{Api: apiVersion, Id: id}, codes.PermissionDenied},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.ListHistory(tt.ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.ListHistory() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//maxLabelLength is the maximum length of label name in bytes, it is the size of Label.Name column
+const maxLabelLength = 100
+
+//normalizeLabels trims labels and returns them sorted without duplicates, nil is returned for no labels
+func normalizeLabels(name string, labels []string) ([]string, error) {
+	seen := make(map[string]bool, len(labels))
+	var list []string
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		switch {
+		case len(l) == 0:
+			return nil, status.Errorf(codes.InvalidArgument, "%s field contains empty label", name)
+		case len(l) > maxLabelLength:
+			return nil, status.Errorf(codes.InvalidArgument, "%s field contains label longer than %d bytes", name, maxLabelLength)
+		case seen[l]:
+			continue
+		}
+		seen[l] = true
+		list = append(list, l)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
+//AddLabels adds labels to todo entity
+func (s *todoServiceServer) AddLabels(ctx context.Context, req *v1.AddLabelsRequest) (*v1.AddLabelsResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	labels, err := normalizeLabels("labels", req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(ctx, req.Id, editAccess); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if err := s.repo(ctx).AddLabels(ctx, req.Id, labels, now); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	std, err := s.repo(ctx).Get(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	s.notify(ctx, v1.WatchResponse_UPDATED, std, now)
+
+	return &v1.AddLabelsResponse{
+		Api:    apiVersion,
+		Labels: std.Labels,
+	}, nil
+}
+
+//RemoveLabels removes labels from todo entity
+func (s *todoServiceServer) RemoveLabels(ctx context.Context, req *v1.RemoveLabelsRequest) (*v1.RemoveLabelsResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	labels, err := normalizeLabels("labels", req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(ctx, req.Id, editAccess); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if err := s.repo(ctx).RemoveLabels(ctx, req.Id, labels, now); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	std, err := s.repo(ctx).Get(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	s.notify(ctx, v1.WatchResponse_UPDATED, std, now)
+
+	return &v1.RemoveLabelsResponse{
+		Api:    apiVersion,
+		Labels: std.Labels,
+	}, nil
+}
+
+//ListLabels reads labels in use with number of todo entities having them
+func (s *todoServiceServer) ListLabels(ctx context.Context, req *v1.ListLabelsRequest) (*v1.ListLabelsResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.ProjectId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "project_id must not be negative, got %d", req.ProjectId)
+	}
+	if req.ProjectId != 0 {
+		if _, err := s.repo(ctx).GetProject(ctx, req.ProjectId); err != nil {
+			return nil, projectError(err, req.ProjectId)
+		}
+	}
+
+	//only todo entities the caller may read are counted
+	counts, err := s.repo(ctx).ListLabels(ctx, req.ProjectId, s.user(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to select from ToDoLabel -> %s", err.Error())
+	}
+
+	list := make([]*v1.LabelCount, 0, len(counts))
+	for _, c := range counts {
+		list = append(list, &v1.LabelCount{Name: c.Name, Count: c.Count})
+	}
+	return &v1.ListLabelsResponse{
+		Api:    apiVersion,
+		Labels: list,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerLabels(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 5, 10, 10, 0, 0, 0, time.UTC))
+
+	report := s.mustCreate(t, ctx, &v1.ToDo{Title: "report", Labels: []string{" work ", "urgent", "work"}})
+	groceries := s.mustCreate(t, ctx, &v1.ToDo{Title: "groceries", Labels: []string{"home"}})
+
+	t.Run("Create", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			labels   []string
+			wantCode codes.Code
+		}{
+			{"Blank label", []string{" "}, codes.InvalidArgument},
+			{"Too long label", []string{strings.Repeat("x", maxLabelLength+1)}, codes.InvalidArgument},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.create(ctx, &v1.ToDo{Title: "invalid", Labels: tt.labels}); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.Create() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("AddLabels", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.AddLabelsRequest
+			want     []string
+			wantCode codes.Code
+		}{
+			{"OK", &v1.AddLabelsRequest{Api: apiVersion, Id: groceries, Labels: []string{"urgent"}}, []string{"home", "urgent"}, codes.OK},
+			{"Not found", &v1.AddLabelsRequest{Api: apiVersion, Id: 42, Labels: []string{"urgent"}}, nil, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.AddLabels(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.AddLabels() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && !reflect.DeepEqual(got.Labels, tt.want) {
+					t.Errorf("toDoServiceServer.AddLabels() = %v, want %v", got.Labels, tt.want)
+				}
+			})
+		}
+	})
+
+	//labels are not changed by update
+	if _, err := s.Update(ctx, &v1.UpdateRequest{
+		Api:  apiVersion,
+		ToDo: &v1.ToDo{Id: groceries, Title: "groceries", Status: v1.Status_TODO, EstimatedTimeOfCompletion: s.ts, Reminder: s.ts},
+	}); err != nil {
+		t.Fatalf("toDoServiceServer.Update() error = %v", err)
+	}
+
+	t.Run("Read", func(t *testing.T) {
+		tests := []struct {
+			name string
+			id   int64
+			want []string
+		}{
+			{"Trimmed and sorted", report, []string{"urgent", "work"}},
+			{"Kept by update", groceries, []string{"home", "urgent"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: tt.id})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.Read() error = %v", err)
+				}
+				if !reflect.DeepEqual(got.ToDo.Labels, tt.want) {
+					t.Errorf("toDoServiceServer.Read() labels = %v, want %v", got.ToDo.Labels, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("ReadAll", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.ReadAllRequest
+			want     []int64
+			wantCode codes.Code
+		}{
+			{"All labels", &v1.ReadAllRequest{Api: apiVersion, Labels: []string{"urgent", "home"}}, []int64{groceries}, codes.OK},
+			{"Any label", &v1.ReadAllRequest{Api: apiVersion, Labels: []string{"work", "home"}, LabelMatch: v1.ReadAllRequest_ANY}, []int64{report, groceries}, codes.OK},
+			{"Explicit all", &v1.ReadAllRequest{Api: apiVersion, Labels: []string{"work", "home"}, LabelMatch: v1.ReadAllRequest_ALL}, []int64{}, codes.OK},
+			{"Unknown match", &v1.ReadAllRequest{Api: apiVersion, Labels: []string{"work"}, LabelMatch: 42}, nil, codes.InvalidArgument},
+			{"Empty label", &v1.ReadAllRequest{Api: apiVersion, Labels: []string{""}}, nil, codes.InvalidArgument},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ReadAll(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && !reflect.DeepEqual(toDoIDs(got.ToDos), tt.want) {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, want %v", toDoIDs(got.ToDos), tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("ListLabels", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.ListLabelsRequest
+			want     []*v1.LabelCount
+			wantCode codes.Code
+		}{
+			{
+				name: "Counts",
+				req:  &v1.ListLabelsRequest{Api: apiVersion},
+				want: []*v1.LabelCount{{Name: "home", Count: 1}, {Name: "urgent", Count: 2}, {Name: "work", Count: 1}},
+			},
+			{
+				name:     "Project not found",
+				req:      &v1.ListLabelsRequest{Api: apiVersion, ProjectId: 42},
+				wantCode: codes.NotFound,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ListLabels(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ListLabels() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && !reflect.DeepEqual(got.Labels, tt.want) {
+					t.Errorf("toDoServiceServer.ListLabels() = %v, want %v", got.Labels, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("RemoveLabels", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.RemoveLabelsRequest
+			want     []string
+			wantCode codes.Code
+		}{
+			{"All labels", &v1.RemoveLabelsRequest{Api: apiVersion, Id: report, Labels: []string{"urgent", "work"}}, []string{}, codes.OK},
+			{"Unsupported API", &v1.RemoveLabelsRequest{Api: "v1000", Id: report}, nil, codes.Unimplemented},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.RemoveLabels(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.RemoveLabels() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && len(got.Labels) != len(tt.want) {
+					t.Errorf("toDoServiceServer.RemoveLabels() = %v, want %v", got.Labels, tt.want)
+				}
+			})
+		}
+	})
+}
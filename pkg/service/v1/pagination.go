@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//defaultPageSize is ReadAll page size used when client does not set it
+	defaultPageSize = 100
+
+	//maxPageSize is the largest page ReadAll returns
+	maxPageSize = 1000
+)
+
+//pageToken is the content of ReadAll page token.
+//It holds the sort key of the last todo entity returned on the previous page.
+type pageToken struct {
+	//Query is fingerprint of filters and sort order of the request issued the token
+	Query string `json:"q"`
+
+	//ID of the last todo entity on the previous page
+	ID int64 `json:"id"`
+
+	//Key is the sort field value of the last todo entity on the previous page
+	Key string `json:"k,omitempty"`
+}
+
+//listOptions converts ReadAll request to repository list options, page size and fingerprint of the query
+func listOptions(req *v1.ReadAllRequest) (storage.ListOptions, int, string, error) {
+	var opts storage.ListOptions
+	var err error
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return opts, 0, "", status.Errorf(codes.InvalidArgument, "page_size must not be negative, got %d", pageSize)
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	opts.SortBy, opts.Descending, err = parseOrderBy(req.OrderBy)
+	if err != nil {
+		return opts, 0, "", err
+	}
+
+	opts.Status = statusToStorage(req.Status)
+	if req.ProjectId < 0 {
+		return opts, 0, "", status.Errorf(codes.InvalidArgument, "project_id must not be negative, got %d", req.ProjectId)
+	}
+	opts.ProjectID = req.ProjectId
+	if req.Ready {
+		opts.Ready, opts.Closed = true, ClosedStatuses()
+	}
+	if opts.Labels, err = normalizeLabels("labels", req.Labels); err != nil {
+		return opts, 0, "", err
+	}
+	switch req.LabelMatch {
+	case v1.ReadAllRequest_LABEL_MATCH_UNSPECIFIED, v1.ReadAllRequest_ALL:
+	case v1.ReadAllRequest_ANY:
+		opts.AnyLabel = true
+	default:
+		return opts, 0, "", status.Errorf(codes.InvalidArgument, "label_match field has unknown value %d", req.LabelMatch)
+	}
+	if opts.ReminderFrom, err = filterTime("reminder_after", req.ReminderAfter); err != nil {
+		return opts, 0, "", err
+	}
+	if opts.ReminderTo, err = filterTime("reminder_before", req.ReminderBefore); err != nil {
+		return opts, 0, "", err
+	}
+	if opts.DueFrom, err = filterTime("due_after", req.DueAfter); err != nil {
+		return opts, 0, "", err
+	}
+	if opts.DueTo, err = filterTime("due_before", req.DueBefore); err != nil {
+		return opts, 0, "", err
+	}
+	opts.ShowDeleted = req.ShowDeleted
+
+	fingerprint := queryFingerprint(opts)
+	if len(req.PageToken) > 0 {
+		opts.After, err = decodePageToken(req.PageToken, opts.SortBy, fingerprint)
+		if err != nil {
+			return opts, 0, "", err
+		}
+	}
+	return opts, pageSize, fingerprint, nil
+}
+
+//parseOrderBy parses order_by value e.g. "title desc"
+func parseOrderBy(orderBy string) (storage.SortField, bool, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return storage.SortByID, false, nil
+	}
+
+	var descending bool
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			descending = true
+		default:
+			return "", false, status.Errorf(codes.InvalidArgument, "order_by direction must be 'asc' or 'desc', got '%s'", parts[1])
+		}
+	}
+	if len(parts) > 2 {
+		return "", false, status.Errorf(codes.InvalidArgument, "order_by must be a single field optionally followed by direction, got '%s'", orderBy)
+	}
+
+	field := storage.SortField(parts[0])
+	switch field {
+	case storage.SortByID, storage.SortByTitle, storage.SortByStatus, storage.SortByEstimatedTimeOfCompletion, storage.SortByReminder:
+		return field, descending, nil
+	}
+	return "", false, status.Errorf(codes.InvalidArgument, "unsupported order_by field '%s'", parts[0])
+}
+
+//filterTime converts optional filter timestamp, nil is converted to zero time
+func filterTime(name string, ts *timestamp.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s field has invalid format -> %s", name, err.Error())
+	}
+	return t, nil
+}
+
+//queryFingerprint identifies filters and sort order so that page token is not reused with another query
+func queryFingerprint(opts storage.ListOptions) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q|%d|%t|%q|%t|%d|%d|%d|%d|%s|%t|%t",
+		opts.Status, opts.ProjectID, opts.Ready, opts.Labels, opts.AnyLabel,
+		opts.ReminderFrom.UnixNano(), opts.ReminderTo.UnixNano(),
+		opts.DueFrom.UnixNano(), opts.DueTo.UnixNano(),
+		opts.SortBy, opts.Descending, opts.ShowDeleted)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+//encodePageToken creates page token continuing after the todo entity
+func encodePageToken(last *storage.ToDo, sortBy storage.SortField, fingerprint string) string {
+	tok := pageToken{Query: fingerprint, ID: last.ID}
+	switch sortBy {
+	case storage.SortByTitle:
+		tok.Key = last.Title
+	case storage.SortByStatus:
+		tok.Key = last.Status
+	case storage.SortByEstimatedTimeOfCompletion:
+		tok.Key = last.EstimatedTimeOfCompletion.UTC().Format(time.RFC3339Nano)
+	case storage.SortByReminder:
+		tok.Key = last.Reminder.UTC().Format(time.RFC3339Nano)
+	}
+
+	b, _ := json.Marshal(tok)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+//decodePageToken returns the last todo entity of the previous page stored in the token
+func decodePageToken(token string, sortBy storage.SortField, fingerprint string) (*storage.ToDo, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "page_token is malformed")
+	}
+
+	var tok pageToken
+	if err := json.Unmarshal(b, &tok); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "page_token is malformed")
+	}
+	if tok.Query != fingerprint {
+		return nil, status.Error(codes.InvalidArgument, "page_token does not match filters and order_by of the request")
+	}
+
+	last := &storage.ToDo{ID: tok.ID}
+	switch sortBy {
+	case storage.SortByTitle:
+		last.Title = tok.Key
+	case storage.SortByStatus:
+		last.Status = tok.Key
+	case storage.SortByEstimatedTimeOfCompletion, storage.SortByReminder:
+		t, err := time.Parse(time.RFC3339Nano, tok.Key)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "page_token is malformed")
+		}
+		last.EstimatedTimeOfCompletion, last.Reminder = t, t
+	}
+	return last, nil
+}
@@ -0,0 +1,279 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Fields of project UpdateProject is able to change
+const (
+	fieldName     = "name"
+	fieldArchived = "archived"
+)
+
+//allProjectFields are fields replaced by UpdateProject without field mask
+var allProjectFields = []string{fieldName, fieldDescription, fieldArchived}
+
+//projectUpdateFields returns project fields listed in update mask, all fields are returned when mask is empty
+func projectUpdateFields(mask *field_mask.FieldMask) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return allProjectFields, nil
+	}
+
+	var fields []string
+	for _, path := range mask.GetPaths() {
+		field := strings.TrimPrefix(strings.ToLower(strings.Replace(path, "_", "", -1)), "project.")
+		switch field {
+		case "id", "createdat", "updatedat":
+			//ID identifies project to update and the times are set by server
+			continue
+		case "*":
+			return allProjectFields, nil
+		case fieldName, fieldDescription, fieldArchived:
+			fields = append(fields, field)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask contains unsupported field '%s'", path)
+		}
+	}
+	return fields, nil
+}
+
+//projectError converts error returned by the repository for project to gRPC status error
+func projectError(err error, id int64) error {
+	switch err {
+	case storage.ErrProjectNotFound:
+		return status.Errorf(codes.NotFound, "Project with ID='%d' is not found", id)
+	case storage.ErrProjectAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case storage.ErrProjectNotEmpty:
+		return status.Errorf(codes.FailedPrecondition, "Project with ID='%d' has tasks, delete it in CASCADE or ARCHIVE mode", id)
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+//checkProject checks that todo entity can be added to the project, 0 means no project
+func (s *todoServiceServer) checkProject(ctx context.Context, id int64) error {
+	if id == 0 {
+		return nil
+	}
+	p, err := s.repo(ctx).GetProject(ctx, id)
+	if err != nil {
+		return projectError(err, id)
+	}
+	if p.Archived {
+		return status.Errorf(codes.FailedPrecondition, "Project with ID='%d' is archived", id)
+	}
+	return nil
+}
+
+//CreateProject creates a new project
+func (s *todoServiceServer) CreateProject(ctx context.Context, req *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetProject().GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name field is required")
+	}
+
+	now := s.now()
+	id, err := s.repo(ctx).CreateProject(ctx, &storage.Project{
+		Name:        req.Project.Name,
+		Description: req.Project.Description,
+		Archived:    req.Project.Archived,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return nil, projectError(err, 0)
+	}
+
+	return &v1.CreateProjectResponse{
+		Api: apiVersion,
+		Id:  id,
+	}, nil
+}
+
+//ReadProject reads project
+func (s *todoServiceServer) ReadProject(ctx context.Context, req *v1.ReadProjectRequest) (*v1.ReadProjectResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	sp, err := s.repo(ctx).GetProject(ctx, req.Id)
+	if err != nil {
+		return nil, projectError(err, req.Id)
+	}
+
+	p, err := projectToProto(sp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReadProjectResponse{
+		Api:     apiVersion,
+		Project: p,
+	}, nil
+}
+
+//UpdateProject updates project
+func (s *todoServiceServer) UpdateProject(ctx context.Context, req *v1.UpdateProjectRequest) (*v1.UpdateProjectResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.Project == nil {
+		return nil, status.Error(codes.InvalidArgument, "project field is required")
+	}
+
+	fields, err := projectUpdateFields(req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := s.repo(ctx).GetProject(ctx, req.Project.Id)
+	if err != nil {
+		return nil, projectError(err, req.Project.Id)
+	}
+	for _, field := range fields {
+		switch field {
+		case fieldName:
+			if len(req.Project.Name) == 0 {
+				return nil, status.Error(codes.InvalidArgument, "name field is required")
+			}
+			sp.Name = req.Project.Name
+		case fieldDescription:
+			sp.Description = req.Project.Description
+		case fieldArchived:
+			sp.Archived = req.Project.Archived
+		}
+	}
+	sp.UpdatedAt = s.now()
+
+	rows, err := s.repo(ctx).UpdateProject(ctx, sp)
+	if err != nil {
+		return nil, projectError(err, req.Project.Id)
+	}
+
+	return &v1.UpdateProjectResponse{
+		Api:     apiVersion,
+		Updated: rows,
+	}, nil
+}
+
+//DeleteProject deletes or archives project
+func (s *todoServiceServer) DeleteProject(ctx context.Context, req *v1.DeleteProjectRequest) (*v1.DeleteProjectResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	switch req.Mode {
+	case v1.DeleteProjectRequest_ARCHIVE:
+		sp, err := s.repo(ctx).GetProject(ctx, req.Id)
+		if err != nil {
+			return nil, projectError(err, req.Id)
+		}
+		sp.Archived = true
+		sp.UpdatedAt = s.now()
+		if _, err := s.repo(ctx).UpdateProject(ctx, sp); err != nil {
+			return nil, projectError(err, req.Id)
+		}
+		return &v1.DeleteProjectResponse{
+			Api:      apiVersion,
+			Archived: true,
+		}, nil
+
+	case v1.DeleteProjectRequest_MODE_UNSPECIFIED, v1.DeleteProjectRequest_CASCADE:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "mode field has unknown value %d", req.Mode)
+	}
+
+	//deleted todo entities are sent to watchers, todo entities of other users are not deleted
+	cascade := req.Mode == v1.DeleteProjectRequest_CASCADE
+	var stds []*storage.ToDo
+	if cascade {
+		all, err := s.repo(ctx).List(ctx, storage.ListOptions{ProjectID: req.Id, ShowDeleted: true})
+		if err != nil {
+			return nil, storageError(err, 0)
+		}
+		user := s.user(ctx)
+		for _, std := range all {
+			if std.Owner != user {
+				return nil, status.Errorf(codes.PermissionDenied, "Project with ID='%d' has tasks of other users, they have to be deleted by their owners first", req.Id)
+			}
+			if std.DeletedAt.IsZero() {
+				stds = append(stds, std)
+			}
+		}
+	}
+
+	tasks, err := s.repo(ctx).DeleteProject(ctx, req.Id, cascade)
+	if err != nil {
+		return nil, projectError(err, req.Id)
+	}
+	now := s.now()
+	for _, std := range stds {
+		s.notify(ctx, v1.WatchResponse_DELETED, std, now)
+	}
+
+	return &v1.DeleteProjectResponse{
+		Api:          apiVersion,
+		Deleted:      1,
+		DeletedTasks: tasks,
+	}, nil
+}
+
+//ListProjects reads all projects
+func (s *todoServiceServer) ListProjects(ctx context.Context, req *v1.ListProjectsRequest) (*v1.ListProjectsResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	sps, err := s.repo(ctx).ListProjects(ctx, req.ShowArchived)
+	if err != nil {
+		return nil, projectError(err, 0)
+	}
+
+	list := []*v1.Project{}
+	for _, sp := range sps {
+		p, err := projectToProto(sp)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+
+	return &v1.ListProjectsResponse{
+		Api:      apiVersion,
+		Projects: list,
+	}, nil
+}
+
+//projectToProto converts stored project to its API representation
+func projectToProto(sp *storage.Project) (*v1.Project, error) {
+	var err error
+	p := &v1.Project{
+		Id:          sp.ID,
+		Name:        sp.Name,
+		Description: sp.Description,
+		Archived:    sp.Archived,
+	}
+	if p.CreatedAt, err = timestampProto("createdAt", sp.CreatedAt); err != nil {
+		return nil, err
+	}
+	if p.UpdatedAt, err = timestampProto("updatedAt", sp.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
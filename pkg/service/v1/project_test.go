@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerProjects(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 4, 10, 10, 0, 0, 0, time.UTC)
+	s := newTestServer(tm)
+
+	work, err := s.CreateProject(ctx, &v1.CreateProjectRequest{Api: apiVersion, Project: &v1.Project{Name: "work", Description: "tasks of the team"}})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.CreateProject() error = %v", err)
+	}
+	home, err := s.CreateProject(ctx, &v1.CreateProjectRequest{Api: apiVersion, Project: &v1.Project{Name: "home"}})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.CreateProject() error = %v", err)
+	}
+
+	t.Run("CreateProject", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.CreateProjectRequest
+			wantCode codes.Code
+		}{
+			{"OK", &v1.CreateProjectRequest{Api: apiVersion, Project: &v1.Project{Name: "other"}}, codes.OK},
+			{"Name exists", &v1.CreateProjectRequest{Api: apiVersion, Project: &v1.Project{Name: "work"}}, codes.AlreadyExists},
+			{"Name missing", &v1.CreateProjectRequest{Api: apiVersion, Project: &v1.Project{}}, codes.InvalidArgument},
+			{"Unsupported API", &v1.CreateProjectRequest{Api: "v1000", Project: &v1.Project{Name: "next"}}, codes.Unimplemented},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.CreateProject(ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.CreateProject() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ReadProject", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			id       int64
+			want     *v1.Project
+			wantCode codes.Code
+		}{
+			{"OK", work.Id, &v1.Project{Name: "work", Description: "tasks of the team"}, codes.OK},
+			{"Not found", 42, nil, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ReadProject(ctx, &v1.ReadProjectRequest{Api: apiVersion, Id: tt.id})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ReadProject() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				createdAt, _ := ptypes.Timestamp(got.Project.CreatedAt)
+				if got.Project.Name != tt.want.Name || got.Project.Description != tt.want.Description || !createdAt.Equal(tm) {
+					t.Errorf("toDoServiceServer.ReadProject() = %v, want %v", got.Project, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		//titles are unique in a project
+		tests := []struct {
+			name      string
+			title     string
+			projectID int64
+			wantCode  codes.Code
+		}{
+			{"Without project", "report", 0, codes.OK},
+			{"In project", "report", work.Id, codes.OK},
+			{"Other title", "review", work.Id, codes.OK},
+			{"Other project", "report", home.Id, codes.OK},
+			{"Title exists", "report", work.Id, codes.AlreadyExists},
+			{"Project not found", "task", 42, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.create(ctx, &v1.ToDo{Title: tt.title, ProjectId: tt.projectID}); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.Create() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ReadAll", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			projectID int64
+			want      []string
+			wantCode  codes.Code
+		}{
+			{"Project", work.Id, []string{"report", "review"}, codes.OK},
+			{"Project not found", 42, nil, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion, ProjectId: tt.projectID})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				if len(got.ToDos) != len(tt.want) {
+					t.Fatalf("toDoServiceServer.ReadAll() = %v, want %v", got.ToDos, tt.want)
+				}
+				for i, td := range got.ToDos {
+					if td.Title != tt.want[i] || td.ProjectId != tt.projectID {
+						t.Errorf("toDoServiceServer.ReadAll() = %v, want %v", got.ToDos, tt.want)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("Archive", func(t *testing.T) {
+		//archived project accepts no new tasks
+		if _, err := s.UpdateProject(ctx, &v1.UpdateProjectRequest{
+			Api:        apiVersion,
+			Project:    &v1.Project{Id: home.Id, Archived: true},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"archived"}},
+		}); err != nil {
+			t.Fatalf("toDoServiceServer.UpdateProject() error = %v", err)
+		}
+
+		tests := []struct {
+			name     string
+			call     func() error
+			wantCode codes.Code
+		}{
+			{
+				name: "Create in archived project",
+				call: func() error {
+					_, err := s.create(ctx, &v1.ToDo{Title: "shopping", ProjectId: home.Id})
+					return err
+				},
+				wantCode: codes.FailedPrecondition,
+			},
+			{
+				name: "Move to archived project",
+				call: func() error {
+					_, err := s.Update(ctx, &v1.UpdateRequest{
+						Api:        apiVersion,
+						ToDo:       &v1.ToDo{Id: 1, ProjectId: home.Id},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"project_id"}},
+					})
+					return err
+				},
+				wantCode: codes.FailedPrecondition,
+			},
+			{
+				name: "Move to project with the title",
+				call: func() error {
+					_, err := s.Update(ctx, &v1.UpdateRequest{
+						Api:        apiVersion,
+						ToDo:       &v1.ToDo{Id: 1, ProjectId: work.Id},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"ProjectId"}},
+					})
+					return err
+				},
+				wantCode: codes.AlreadyExists,
+			},
+			{
+				name: "Rename to existing name",
+				call: func() error {
+					_, err := s.UpdateProject(ctx, &v1.UpdateProjectRequest{
+						Api:        apiVersion,
+						Project:    &v1.Project{Id: home.Id, Name: "work"},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"project.name"}},
+					})
+					return err
+				},
+				wantCode: codes.AlreadyExists,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.call(); status.Code(err) != tt.wantCode {
+					t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ListProjects", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *v1.ListProjectsRequest
+			want []string
+		}{
+			{"Not archived", &v1.ListProjectsRequest{Api: apiVersion}, []string{"other", "work"}},
+			{"Sorted by name", &v1.ListProjectsRequest{Api: apiVersion, ShowArchived: true}, []string{"home", "other", "work"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ListProjects(ctx, tt.req)
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ListProjects() error = %v", err)
+				}
+				if len(got.Projects) != len(tt.want) {
+					t.Fatalf("toDoServiceServer.ListProjects() = %v, want %v", got.Projects, tt.want)
+				}
+				for i, p := range got.Projects {
+					if p.Name != tt.want[i] {
+						t.Errorf("toDoServiceServer.ListProjects() = %v, want %v", got.Projects, tt.want)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("DeleteProject", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			req       *v1.DeleteProjectRequest
+			want      *v1.DeleteProjectResponse
+			wantCode  codes.Code
+			wantToDos int
+		}{
+			{
+				name:      "Not empty",
+				req:       &v1.DeleteProjectRequest{Api: apiVersion, Id: work.Id},
+				wantCode:  codes.FailedPrecondition,
+				wantToDos: 4,
+			},
+			{
+				name:      "Archive",
+				req:       &v1.DeleteProjectRequest{Api: apiVersion, Id: work.Id, Mode: v1.DeleteProjectRequest_ARCHIVE},
+				want:      &v1.DeleteProjectResponse{Api: apiVersion, Archived: true},
+				wantToDos: 4,
+			},
+			{
+				name:      "Cascade",
+				req:       &v1.DeleteProjectRequest{Api: apiVersion, Id: work.Id, Mode: v1.DeleteProjectRequest_CASCADE},
+				want:      &v1.DeleteProjectResponse{Api: apiVersion, Deleted: 1, DeletedTasks: 2},
+				wantToDos: 2,
+			},
+			{
+				name:      "Not found",
+				req:       &v1.DeleteProjectRequest{Api: apiVersion, Id: work.Id, Mode: v1.DeleteProjectRequest_CASCADE},
+				wantCode:  codes.NotFound,
+				wantToDos: 2,
+			},
+			{
+				name:      "Unknown mode",
+				req:       &v1.DeleteProjectRequest{Api: apiVersion, Id: home.Id, Mode: 42},
+				wantCode:  codes.InvalidArgument,
+				wantToDos: 2,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.DeleteProject(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.DeleteProject() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && (got.Deleted != tt.want.Deleted || got.DeletedTasks != tt.want.DeletedTasks || got.Archived != tt.want.Archived) {
+					t.Errorf("toDoServiceServer.DeleteProject() = %v, want %v", got, tt.want)
+				}
+				list, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+				if err != nil || len(list.ToDos) != tt.wantToDos {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, %v, want %d tasks", list, err, tt.wantToDos)
+				}
+			})
+		}
+	})
+}
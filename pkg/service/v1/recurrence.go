@@ -0,0 +1,459 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Frequencies of recurrence rule
+const (
+	freqDaily   = "DAILY"
+	freqWeekly  = "WEEKLY"
+	freqMonthly = "MONTHLY"
+)
+
+const (
+	//defaultOccurrences is number of occurrences ListOccurrences returns when client does not set limit
+	defaultOccurrences = 100
+
+	//maxOccurrences is the largest number of occurrences ListOccurrences returns
+	maxOccurrences = 1000
+
+	//defaultOccurrenceWindow is ListOccurrences time window used when client does not set end_time
+	defaultOccurrenceWindow = 30 * 24 * time.Hour
+)
+
+//maxEmptyPeriods is number of consecutive periods without occurrence after which
+//the rule is considered to produce no more occurrences
+const maxEmptyPeriods = 1000
+
+//weekdays maps iCalendar weekday names to weekdays
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+//weekdayNames are iCalendar names of weekdays indexed by time.Weekday
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+//byDay is BYDAY entry of recurrence rule e.g. "-1FR" is the last Friday of month
+type byDay struct {
+	//n is the ordinal of weekday in month, 0 means every weekday
+	n int
+
+	weekday time.Weekday
+}
+
+//recurrence is parsed iCalendar recurrence rule (RFC 5545 RRULE) subset
+//supported by the service: daily, weekly and monthly rules with BYDAY, COUNT and UNTIL.
+//The first occurrence is estimated time of completion of the task (DTSTART).
+type recurrence struct {
+	freq     string
+	interval int
+	byDay    []byDay
+	wkst     time.Weekday
+
+	//count is total number of occurrences including the first one, 0 when it is not limited
+	count int
+
+	//until is the last possible occurrence, zero when it is not limited
+	until time.Time
+}
+
+//parseRecurrence parses RRULE value e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=10"
+func parseRecurrence(rule string) (*recurrence, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	r := &recurrence{interval: 1, wkst: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || len(kv[1]) == 0 {
+			return nil, fmt.Errorf("recurrence part '%s' must be in 'NAME=VALUE' format", part)
+		}
+		name, value := strings.ToUpper(strings.TrimSpace(kv[0])), strings.ToUpper(strings.TrimSpace(kv[1]))
+		if seen[name] {
+			return nil, fmt.Errorf("recurrence part '%s' is defined more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch value {
+			case freqDaily, freqWeekly, freqMonthly:
+				r.freq = value
+			default:
+				err = fmt.Errorf("unsupported recurrence frequency '%s'", value)
+			}
+		case "INTERVAL":
+			r.interval, err = positive(name, value)
+		case "COUNT":
+			r.count, err = positive(name, value)
+		case "UNTIL":
+			r.until, err = parseUntil(value)
+		case "BYDAY":
+			r.byDay, err = parseByDay(value)
+		case "WKST":
+			wkst, ok := weekdays[value]
+			if !ok {
+				err = fmt.Errorf("unknown weekday '%s' of WKST", value)
+			}
+			r.wkst = wkst
+		default:
+			err = fmt.Errorf("unsupported recurrence part '%s'", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.freq) == 0 {
+		return nil, fmt.Errorf("recurrence FREQ is required")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("recurrence can't have both COUNT and UNTIL")
+	}
+	for _, d := range r.byDay {
+		if d.n != 0 && r.freq != freqMonthly {
+			return nil, fmt.Errorf("ordinal weekday of BYDAY is supported by MONTHLY recurrence only")
+		}
+	}
+	return r, nil
+}
+
+//normalizeRecurrence validates recurrence received from client and returns it in canonical form
+func normalizeRecurrence(rule string) (string, error) {
+	if len(strings.TrimSpace(rule)) == 0 {
+		return "", nil
+	}
+	r, err := parseRecurrence(rule)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "recurrence field is invalid -> %s", err.Error())
+	}
+	return r.String(), nil
+}
+
+//occurrenceDate is the date suffix occurrenceTitle adds to the title
+var occurrenceDate = regexp.MustCompile(` \(\d{4}-\d{2}-\d{2}\)$`)
+
+//occurrenceTitle returns unique title of the occurrence e.g. "Water plants (2020-03-27)",
+//the date of the previous occurrence is replaced
+func occurrenceTitle(title string, estimatedTimeOfCompletion time.Time) string {
+	return occurrenceDate.ReplaceAllString(title, "") + " (" + estimatedTimeOfCompletion.Format("2006-01-02") + ")"
+}
+
+//storedRecurrence parses recurrence of stored todo entity,
+//todo entity which does not repeat occurs once
+func storedRecurrence(std *storage.ToDo) (*recurrence, error) {
+	if len(std.Recurrence) == 0 {
+		return &recurrence{freq: freqDaily, interval: 1, wkst: time.Monday, count: 1}, nil
+	}
+	r, err := parseRecurrence(std.Recurrence)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "stored recurrence '%s' is invalid -> %s", std.Recurrence, err.Error())
+	}
+	return r, nil
+}
+
+//nextOccurrence returns the occurrence of recurring todo entity following it
+//or nil when todo entity does not repeat any more
+func nextOccurrence(std *storage.ToDo, now time.Time) (*storage.ToDo, error) {
+	if std.EstimatedTimeOfCompletion.IsZero() {
+		return nil, nil
+	}
+	r, err := storedRecurrence(std)
+	if err != nil {
+		return nil, err
+	}
+
+	etc, rest, ok := r.next(std.EstimatedTimeOfCompletion)
+	if !ok {
+		return nil, nil
+	}
+	next := &storage.ToDo{
+		Title:                     occurrenceTitle(std.Title, etc),
+		Description:               std.Description,
+		Status:                    statusToStorage(v1.Status_TODO),
+		EstimatedTimeOfCompletion: etc,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		ProjectID:                 std.ProjectID,
+		ParentID:                  std.ParentID,
+		Labels:                    std.Labels,
+		Owner:                     std.Owner,
+	}
+	//reminder keeps its distance from estimated time of completion
+	if !std.Reminder.IsZero() {
+		next.Reminder = std.Reminder.Add(etc.Sub(std.EstimatedTimeOfCompletion))
+	}
+	//the last occurrence of the series does not repeat
+	if _, _, ok := rest.next(etc); ok {
+		next.Recurrence = rest.String()
+	}
+	return next, nil
+}
+
+//occurrenceWindow returns time window and maximum number of occurrences requested by ListOccurrences
+func occurrenceWindow(req *v1.ListOccurrencesRequest, now time.Time) (time.Time, time.Time, int, error) {
+	start, end := now, time.Time{}
+	var err error
+	if req.StartTime != nil {
+		if start, err = ptypes.Timestamp(req.StartTime); err != nil {
+			return start, end, 0, status.Errorf(codes.InvalidArgument, "start_time field has invalid format -> %s", err.Error())
+		}
+	}
+	end = start.Add(defaultOccurrenceWindow)
+	if req.EndTime != nil {
+		if end, err = ptypes.Timestamp(req.EndTime); err != nil {
+			return start, end, 0, status.Errorf(codes.InvalidArgument, "end_time field has invalid format -> %s", err.Error())
+		}
+	}
+	if !end.After(start) {
+		return start, end, 0, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return start, end, 0, status.Errorf(codes.InvalidArgument, "limit must not be negative, got %d", limit)
+	case limit == 0:
+		limit = defaultOccurrences
+	case limit > maxOccurrences:
+		limit = maxOccurrences
+	}
+	return start, end, limit, nil
+}
+
+//positive parses positive integer value of recurrence part
+func positive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("recurrence %s must be positive integer", name)
+	}
+	return n, nil
+}
+
+//parseUntil parses UNTIL as UTC date-time e.g. "20200131T235959Z" or date e.g. "20200131"
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		//date includes occurrences of the whole day
+		return t.Add(24*time.Hour - time.Nanosecond), nil
+	}
+	return time.Time{}, fmt.Errorf("recurrence UNTIL '%s' must be UTC date-time e.g. 20200131T235959Z or date e.g. 20200131", value)
+}
+
+//parseByDay parses comma separated weekdays with optional ordinal e.g. "MO,2TU,-1FR"
+func parseByDay(value string) ([]byDay, error) {
+	var days []byDay
+	for _, s := range strings.Split(value, ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("unknown weekday '%s' of BYDAY", s)
+		}
+		weekday, ok := weekdays[s[len(s)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday '%s' of BYDAY", s)
+		}
+		d := byDay{weekday: weekday}
+		if ordinal := s[:len(s)-2]; len(ordinal) > 0 {
+			n, err := strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid ordinal weekday '%s' of BYDAY", s)
+			}
+			d.n = n
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
+
+//String formats the rule as RRULE value
+func (r *recurrence) String() string {
+	parts := []string{"FREQ=" + r.freq}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, 0, len(r.byDay))
+		for _, d := range r.byDay {
+			day := weekdayNames[d.weekday]
+			if d.n != 0 {
+				day = strconv.Itoa(d.n) + day
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.wkst != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.wkst])
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+//occurrences returns occurrences of the series starting at start which are within [from, to),
+//at most limit occurrences are returned
+func (r *recurrence) occurrences(start, from, to time.Time, limit int) []time.Time {
+	var list []time.Time
+	n := 0
+	//add appends occurrence in the window and reports whether the series goes on
+	add := func(t time.Time) bool {
+		if (r.count > 0 && n >= r.count) || (!r.until.IsZero() && t.After(r.until)) || !t.Before(to) {
+			return false
+		}
+		n++
+		if !t.Before(from) {
+			list = append(list, t)
+		}
+		return len(list) < limit
+	}
+
+	//the first occurrence is always start even if it does not match the rule
+	if !add(start) {
+		return list
+	}
+	for period, empty := 0, 0; empty < maxEmptyPeriods; period++ {
+		found := false
+		for _, t := range r.period(start, period) {
+			if !t.After(start) {
+				continue
+			}
+			found = true
+			if !add(t) {
+				return list
+			}
+		}
+		if found {
+			empty = 0
+		} else {
+			empty++
+		}
+	}
+	return list
+}
+
+//next returns the occurrence following start and the rule of the series continuing from it.
+//False is returned when the series ends with start.
+func (r *recurrence) next(start time.Time) (time.Time, *recurrence, bool) {
+	list := r.occurrences(start, start.Add(time.Nanosecond), maxTime, 1)
+	if len(list) == 0 {
+		return time.Time{}, nil, false
+	}
+	rest := *r
+	if rest.count > 0 {
+		rest.count--
+	}
+	return list[0], &rest, true
+}
+
+//maxTime is used as the open end of time window
+var maxTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+//period returns candidate occurrences of the period with the index sorted by time,
+//periods are days, weeks or months of the interval counted from the one containing start
+func (r *recurrence) period(start time.Time, index int) []time.Time {
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hh, mm, ss, start.Nanosecond(), start.Location())
+	}
+
+	var list []time.Time
+	switch r.freq {
+	case freqDaily:
+		t := at(y, m, d+index*r.interval)
+		if len(r.byDay) == 0 || r.hasWeekday(t.Weekday()) {
+			list = append(list, t)
+		}
+	case freqWeekly:
+		//first day of the week containing start
+		offset := (int(start.Weekday()) - int(r.wkst) + 7) % 7
+		weekStart := at(y, m, d-offset+7*index*r.interval)
+		if len(r.byDay) == 0 {
+			return []time.Time{weekStart.AddDate(0, 0, offset)}
+		}
+		for _, day := range r.byDay {
+			list = append(list, weekStart.AddDate(0, 0, (int(day.weekday)-int(r.wkst)+7)%7))
+		}
+	case freqMonthly:
+		first := at(y, m+time.Month(index*r.interval), 1)
+		days := daysIn(first.Year(), first.Month())
+		if len(r.byDay) == 0 {
+			//months without the day of start are skipped
+			if d <= days {
+				list = append(list, at(first.Year(), first.Month(), d))
+			}
+			return list
+		}
+		for _, day := range r.byDay {
+			//the first day of month which is the weekday
+			firstDay := 1 + (int(day.weekday)-int(first.Weekday())+7)%7
+			switch {
+			case day.n == 0:
+				for md := firstDay; md <= days; md += 7 {
+					list = append(list, at(first.Year(), first.Month(), md))
+				}
+			case day.n > 0:
+				if md := firstDay + 7*(day.n-1); md <= days {
+					list = append(list, at(first.Year(), first.Month(), md))
+				}
+			default:
+				last := firstDay + 7*((days-firstDay)/7)
+				if md := last + 7*(day.n+1); md >= 1 {
+					list = append(list, at(first.Year(), first.Month(), md))
+				}
+			}
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Before(list[j]) })
+	return dedupe(list)
+}
+
+//hasWeekday reports whether the weekday is listed in BYDAY
+func (r *recurrence) hasWeekday(weekday time.Weekday) bool {
+	for _, d := range r.byDay {
+		if d.weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+//daysIn returns number of days in the month
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+//dedupe removes repeated times from sorted list
+func dedupe(list []time.Time) []time.Time {
+	out := list[:0]
+	for i, t := range list {
+		if i == 0 || !t.Equal(list[i-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{"Daily", "FREQ=DAILY", "FREQ=DAILY", false},
+		{"Canonical form", "RRULE:freq=weekly;byday=mo,fr;interval=1;wkst=su;count=3", "FREQ=WEEKLY;BYDAY=MO,FR;WKST=SU;COUNT=3", false},
+		{"Monthly ordinal weekday", "FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,-1FR", "FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,-1FR", false},
+		{"Until date", "FREQ=DAILY;UNTIL=20200131", "FREQ=DAILY;UNTIL=20200131T235959Z", false},
+		{"Until date-time", "FREQ=DAILY;UNTIL=20200131T100000Z", "FREQ=DAILY;UNTIL=20200131T100000Z", false},
+		{"Missing FREQ", "COUNT=3", "", true},
+		{"Yearly", "FREQ=YEARLY", "", true},
+		{"Unsupported part", "FREQ=DAILY;BYHOUR=10", "", true},
+		{"Repeated part", "FREQ=DAILY;FREQ=WEEKLY", "", true},
+		{"COUNT and UNTIL", "FREQ=DAILY;COUNT=2;UNTIL=20200131", "", true},
+		{"Zero interval", "FREQ=DAILY;INTERVAL=0", "", true},
+		{"Unknown weekday", "FREQ=WEEKLY;BYDAY=XX", "", true},
+		{"Ordinal weekday of weekly rule", "FREQ=WEEKLY;BYDAY=1MO", "", true},
+		{"Invalid UNTIL", "FREQ=DAILY;UNTIL=tomorrow", "", true},
+		{"Malformed", "FREQ", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRecurrence(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRecurrence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && r.String() != tt.want {
+				t.Errorf("parseRecurrence() = %s, want %s", r, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	//Wednesday
+	start := time.Date(2020, 1, 1, 9, 30, 0, 0, time.UTC)
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2020, m, d, 9, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		limit int
+		want  []time.Time
+	}{
+		{"Daily", "FREQ=DAILY;INTERVAL=2", start, start, 3, []time.Time{day(1, 1), day(1, 3), day(1, 5)}},
+		{"Daily on weekdays", "FREQ=DAILY;BYDAY=MO,FR", start, start, 3, []time.Time{day(1, 1), day(1, 3), day(1, 6)}},
+		{"Weekly", "FREQ=WEEKLY", start, start, 2, []time.Time{day(1, 1), day(1, 8)}},
+		{"Weekly on weekdays", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", start, start, 4, []time.Time{day(1, 1), day(1, 2), day(1, 13), day(1, 16)}},
+		{"Week starting on Sunday", "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,WE;WKST=SU", start, start, 3, []time.Time{day(1, 1), day(1, 12), day(1, 15)}},
+		{"Monthly skips short months", "FREQ=MONTHLY", day(1, 31), day(1, 31), 3, []time.Time{day(1, 31), day(3, 31), day(5, 31)}},
+		{"Monthly last Friday", "FREQ=MONTHLY;BYDAY=-1FR", start, start, 3, []time.Time{day(1, 1), day(1, 31), day(2, 28)}},
+		{"Monthly second Tuesday", "FREQ=MONTHLY;INTERVAL=3;BYDAY=2TU", start, start, 3, []time.Time{day(1, 1), day(1, 14), day(4, 14)}},
+		{"Count", "FREQ=DAILY;COUNT=2", start, start, 10, []time.Time{day(1, 1), day(1, 2)}},
+		{"Until", "FREQ=WEEKLY;UNTIL=20200115T093000Z", start, start, 10, []time.Time{day(1, 1), day(1, 8), day(1, 15)}},
+		{"Window", "FREQ=DAILY;COUNT=10", start, day(1, 9), 10, []time.Time{day(1, 9), day(1, 10)}},
+		{"Window after the end", "FREQ=DAILY;COUNT=10", start, day(2, 1), 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("parseRecurrence() error = %v", err)
+			}
+			got := r.occurrences(tt.start, tt.from, maxTime, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("recurrence.occurrences() = %v, want %v", got, tt.want)
+			}
+
+			//the series continued from every occurrence gives the same occurrences
+			var chain []time.Time
+			for occurrence, rest, ok := tt.start, r, true; ok && len(chain) < tt.limit; occurrence, rest, ok = rest.next(occurrence) {
+				if !occurrence.Before(tt.from) {
+					chain = append(chain, occurrence)
+				}
+			}
+			if !reflect.DeepEqual(chain, tt.want) {
+				t.Errorf("recurrence.next() chain = %v, want %v", chain, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDoServiceServerRecurrence(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2020, 1, 1, 9, 30, 0, 0, time.UTC)
+	s := NewToDoServiceServer(memory.NewToDoRepository(), fixedClock(start))
+
+	etc, _ := ptypes.TimestampProto(start)
+	reminder, _ := ptypes.TimestampProto(start.Add(-time.Hour))
+	created, err := s.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:                     "water plants",
+			EstimatedTimeOfCompletion: etc,
+			Reminder:                  reminder,
+			Recurrence:                "freq=weekly;byday=we,sa;count=3",
+		},
+	})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.Create() error = %v", err)
+	}
+	if _, err := s.Create(ctx, &v1.CreateRequest{
+		Api:  apiVersion,
+		ToDo: &v1.ToDo{Title: "invalid", EstimatedTimeOfCompletion: etc, Reminder: etc, Recurrence: "FREQ=HOURLY"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("toDoServiceServer.Create() error = %v, wantCode %v", err, codes.InvalidArgument)
+	}
+
+	//upcoming occurrences keep distance of reminder
+	res, err := s.ListOccurrences(ctx, &v1.ListOccurrencesRequest{Api: apiVersion, Id: created.Id})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.ListOccurrences() error = %v", err)
+	}
+	var occurrences []time.Time
+	for _, o := range res.Occurrences {
+		etc, _ := ptypes.Timestamp(o.EstimatedTimeOfCompletion)
+		reminder, _ := ptypes.Timestamp(o.Reminder)
+		if etc.Sub(reminder) != time.Hour {
+			t.Errorf("toDoServiceServer.ListOccurrences() reminder = %v of %v", reminder, etc)
+		}
+		occurrences = append(occurrences, etc)
+	}
+	want := []time.Time{start, start.AddDate(0, 0, 3), start.AddDate(0, 0, 7)}
+	if !reflect.DeepEqual(occurrences, want) {
+		t.Errorf("toDoServiceServer.ListOccurrences() = %v, want %v", occurrences, want)
+	}
+
+	//complete occurrences one by one
+	id := created.Id
+	for i, w := range []struct {
+		title      string
+		recurrence string
+	}{
+		{"water plants (2020-01-04)", "FREQ=WEEKLY;BYDAY=WE,SA;COUNT=2"},
+		{"water plants (2020-01-08)", ""},
+	} {
+		updated, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:        apiVersion,
+			ToDo:       &v1.ToDo{Id: id, Status: v1.Status_DONE},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+		})
+		if err != nil || updated.NextId == 0 {
+			t.Fatalf("toDoServiceServer.Update() = %v, %v, want the next occurrence", updated, err)
+		}
+		id = updated.NextId
+
+		next, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: id})
+		if err != nil {
+			t.Fatalf("toDoServiceServer.Read() error = %v", err)
+		}
+		etc, _ := ptypes.Timestamp(next.ToDo.EstimatedTimeOfCompletion)
+		reminder, _ := ptypes.Timestamp(next.ToDo.Reminder)
+		if next.ToDo.Title != w.title || next.ToDo.Recurrence != w.recurrence || next.ToDo.Status != v1.Status_TODO ||
+			!etc.Equal(want[i+1]) || !reminder.Equal(want[i+1].Add(-time.Hour)) {
+			t.Errorf("toDoServiceServer.Update() created %v, want '%s' at %v repeating by '%s'", next.ToDo, w.title, want[i+1], w.recurrence)
+		}
+	}
+
+	//the last occurrence does not repeat
+	updated, err := s.Update(ctx, &v1.UpdateRequest{
+		Api:        apiVersion,
+		ToDo:       &v1.ToDo{Id: id, Status: v1.Status_DONE},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+	})
+	if err != nil || updated.NextId != 0 {
+		t.Errorf("toDoServiceServer.Update() = %v, %v, want no next occurrence", updated, err)
+	}
+
+	//completing occurrence again does not create its next occurrence twice
+	for _, st := range []v1.Status{v1.Status_TODO, v1.Status_DONE} {
+		updated, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:        apiVersion,
+			ToDo:       &v1.ToDo{Id: created.Id, Status: st},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+		})
+		if err != nil || updated.NextId != 0 {
+			t.Errorf("toDoServiceServer.Update() = %v, %v, want no next occurrence", updated, err)
+		}
+	}
+	all, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+	if err != nil || len(all.ToDos) != 3 {
+		t.Errorf("toDoServiceServer.ReadAll() = %v, %v, want 3 occurrences", all, err)
+	}
+}
+
+func TestToDoServiceServerListOccurrences(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := memory.NewToDoRepository()
+	id, _ := repo.Create(ctx, &storage.ToDo{Title: "once", EstimatedTimeOfCompletion: now.Add(time.Hour), Owner: anonymousActor})
+	s := NewToDoServiceServer(repo, fixedClock(now))
+
+	ts := func(t time.Time) *timestamp.Timestamp {
+		ts, _ := ptypes.TimestampProto(t)
+		return ts
+	}
+	tests := []struct {
+		name     string
+		req      *v1.ListOccurrencesRequest
+		want     int
+		wantCode codes.Code
+	}{
+		{"Not repeating", &v1.ListOccurrencesRequest{Api: apiVersion, Id: id}, 1, codes.OK},
+		{"Window before the task", &v1.ListOccurrencesRequest{Api: apiVersion, Id: id, EndTime: ts(now.Add(time.Minute))}, 0, codes.OK},
+		{"Empty window", &v1.ListOccurrencesRequest{Api: apiVersion, Id: id, StartTime: ts(now), EndTime: ts(now)}, 0, codes.InvalidArgument},
+		{"Negative limit", &v1.ListOccurrencesRequest{Api: apiVersion, Id: id, Limit: -1}, 0, codes.InvalidArgument},
+		{"Not Found", &v1.ListOccurrencesRequest{Api: apiVersion, Id: 42}, 0, codes.NotFound},
+		{"Unsupported API", &v1.ListOccurrencesRequest{Api: "v1000", Id: id}, 0, codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ListOccurrences(ctx, tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("toDoServiceServer.ListOccurrences() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err == nil && len(got.Occurrences) != tt.want {
+				t.Errorf("toDoServiceServer.ListOccurrences() = %v, want %d occurrences", got.Occurrences, tt.want)
+			}
+		})
+	}
+}
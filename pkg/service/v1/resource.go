@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+)
+
+//Collections of resources the methods are called on
+const (
+	resourceTasks    = "tasks"
+	resourceProjects = "projects"
+	resourceTenants  = "tenants"
+)
+
+//Resource returns name of the resource the method is called on e.g. "tasks/12" for Read of the task with ID 12,
+//"projects/3" for UpdateProject of the project with ID 3. Calls on no single resource e.g. ReadAll and Create
+//return name of the collection e.g. "tasks".
+func Resource(method string, req interface{}) string {
+	name := path.Base(method)
+	collection := resourceTasks
+	switch {
+	case strings.HasSuffix(name, "Tenant"), strings.HasSuffix(name, "Tenants"):
+		collection = resourceTenants
+	case strings.HasSuffix(name, "Project"), strings.HasSuffix(name, "Projects"):
+		collection = resourceProjects
+	}
+
+	id := ""
+	switch r := req.(type) {
+	case interface{ GetId() int64 }:
+		if r.GetId() != 0 {
+			id = fmt.Sprint(r.GetId())
+		}
+	case interface{ GetId() string }:
+		id = r.GetId()
+	case interface{ GetToDo() *v1.ToDo }:
+		if r.GetToDo().GetId() != 0 {
+			id = fmt.Sprint(r.GetToDo().GetId())
+		}
+	case interface{ GetProject() *v1.Project }:
+		if r.GetProject().GetId() != 0 {
+			id = fmt.Sprint(r.GetProject().GetId())
+		}
+	case interface{ GetTenant() *v1.Tenant }:
+		id = r.GetTenant().GetId()
+	}
+
+	if len(id) == 0 {
+		return collection
+	}
+	return collection + "/" + id
+}
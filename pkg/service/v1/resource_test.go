@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"testing"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+)
+
+func TestResource(t *testing.T) {
+	tests := []struct {
+		method string
+		req    interface{}
+		want   string
+	}{
+		{"/v1.ToDoService/Read", &v1.ReadRequest{Id: 12}, "tasks/12"},
+		{"/v1.ToDoService/Update", &v1.UpdateRequest{ToDo: &v1.ToDo{Id: 12}}, "tasks/12"},
+		{"/v1.ToDoService/Create", &v1.CreateRequest{ToDo: &v1.ToDo{Title: "report"}}, "tasks"},
+		{"/v1.ToDoService/ReadAll", &v1.ReadAllRequest{}, "tasks"},
+		{"/v1.ToDoService/AddLabels", &v1.AddLabelsRequest{Id: 7}, "tasks/7"},
+		{"/v1.ToDoService/ReadProject", &v1.ReadProjectRequest{Id: 3}, "projects/3"},
+		{"/v1.ToDoService/UpdateProject", &v1.UpdateProjectRequest{Project: &v1.Project{Id: 3}}, "projects/3"},
+		{"/v1.ToDoService/ListProjects", &v1.ListProjectsRequest{}, "projects"},
+		{"/v1.ToDoService/DeleteTenant", &v1.DeleteTenantRequest{Id: "blue"}, "tenants/blue"},
+		{"/v1.ToDoService/UpdateTenant", &v1.UpdateTenantRequest{Tenant: &v1.Tenant{Id: "blue"}}, "tenants/blue"},
+		{"/v1.ToDoService/ListTenants", &v1.ListTenantsRequest{}, "tenants"},
+		{"/v1.ToDoService/Watch", nil, "tasks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := Resource(tt.method, tt.req); got != tt.want {
+				t.Errorf("Resource(%q, %v) = %q, want %q", tt.method, tt.req, got, tt.want)
+			}
+		})
+	}
+}
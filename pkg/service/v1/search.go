@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/search"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//defaultSearchSize is the number of Search results used when client does not set page size
+	defaultSearchSize = 20
+
+	//maxSearchSize is the largest number of results Search returns
+	maxSearchSize = 100
+
+	//searchLoadSize is the number of hits which todo entities are read by one query
+	searchLoadSize = 500
+
+	//defaultPreTag and defaultPostTag surround matches in Search highlights when client does not set them
+	defaultPreTag, defaultPostTag = "<em>", "</em>"
+)
+
+//Search reads todo entities matching words in title and description, the best match first.
+//The index is kept in memory of the server process, it sees changes made through this server only.
+func (s *todoServiceServer) Search(ctx context.Context, req *v1.SearchRequest) (*v1.SearchResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	query, err := search.ParseQuery(req.Q)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "q field has no words to search for")
+	}
+
+	size := int(req.PageSize)
+	switch {
+	case size < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must not be negative, got %d", size)
+	case size == 0:
+		size = defaultSearchSize
+	case size > maxSearchSize:
+		size = maxSearchSize
+	}
+
+	preTag, postTag := req.PreTag, req.PostTag
+	if preTag == "" {
+		preTag = defaultPreTag
+	}
+	if postTag == "" {
+		postTag = defaultPostTag
+	}
+
+	//todo entities are searched within the tenant of the call
+	sp := s.space(ctx)
+	if err := sp.loadIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	hits := sp.index.Search(query, preTag, postTag)
+	stds, err := s.readable(ctx, sp, hits)
+	if err != nil {
+		return nil, err
+	}
+	total := 0
+	results := make([]*v1.SearchResult, 0, size)
+	for _, hit := range hits {
+		//todo entities the caller may not read and those deleted by another server process are not found
+		std, ok := stds[hit.ID]
+		if !ok {
+			continue
+		}
+		total++
+		if len(results) == size {
+			continue
+		}
+		td, err := toProto(std)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &v1.SearchResult{
+			ToDo:               td,
+			Score:              hit.Score,
+			TitleHighlight:     hit.Title,
+			DescriptionSnippet: hit.Snippet,
+		})
+	}
+
+	return &v1.SearchResponse{
+		Api:       apiVersion,
+		Results:   results,
+		TotalSize: int32(total),
+	}, nil
+}
+
+//readable reads todo entities of the hits owned by or shared with the caller by IDs,
+//todo entities of many hits are read by one query for every searchLoadSize hits
+func (s *todoServiceServer) readable(ctx context.Context, sp *space, hits []search.Hit) (map[int64]*storage.ToDo, error) {
+	user := s.user(ctx)
+	stds := make(map[int64]*storage.ToDo, len(hits))
+	for start := 0; start < len(hits); start += searchLoadSize {
+		end := start + searchLoadSize
+		if end > len(hits) {
+			end = len(hits)
+		}
+		ids := make([]int64, 0, end-start)
+		for _, hit := range hits[start:end] {
+			ids = append(ids, hit.ID)
+		}
+		list, err := sp.repo.List(ctx, storage.ListOptions{IDs: ids, VisibleTo: user})
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "failed to select from ToDo -> %s", err.Error())
+		}
+		for _, std := range list {
+			stds[std.ID] = std
+		}
+	}
+	return stds, nil
+}
+
+//loadIndex indexes todo entities stored in the repository of the space unless it is already done
+func (sp *space) loadIndex(ctx context.Context) error {
+	sp.indexLoad.Lock()
+	defer sp.indexLoad.Unlock()
+
+	if sp.index.Loaded() {
+		return nil
+	}
+	list, err := sp.repo.List(ctx, storage.ListOptions{})
+	if err != nil {
+		return status.Errorf(codes.Unknown, "failed to select from ToDo -> %s", err.Error())
+	}
+	docs := make([]search.Document, 0, len(list))
+	for _, std := range list {
+		docs = append(docs, document(std))
+	}
+	sp.index.Load(docs)
+	return nil
+}
+
+//document converts todo entity to its searchable text
+func document(std *storage.ToDo) search.Document {
+	return search.Document{
+		ID:          std.ID,
+		Title:       std.Title,
+		Description: std.Description,
+	}
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerSearch(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+	s := newTestServer(tm)
+
+	//todo entity stored without the server is found by the index loaded on the first search
+	stored, err := s.root.Create(ctx, &storage.ToDo{Title: "Quarterly report", Description: "Numbers for the board", Status: "TODO", CreatedAt: tm, UpdatedAt: tm, Owner: anonymousActor})
+	if err != nil {
+		t.Fatalf("toDoRepository.Create() error = %v", err)
+	}
+	weekly := s.mustCreate(t, ctx, &v1.ToDo{Title: "Weekly report", Description: "Send the report to the team"})
+	milk := s.mustCreate(t, ctx, &v1.ToDo{Title: "Buy milk", Description: "Milk and eggs for the weekly breakfast"})
+
+	tests := []struct {
+		name      string
+		req       *v1.SearchRequest
+		want      []int64
+		wantTotal int32
+		wantCode  codes.Code
+	}{
+		{"Word", &v1.SearchRequest{Api: apiVersion, Q: "report"}, []int64{weekly, stored}, 2, codes.OK},
+		{"Phrase", &v1.SearchRequest{Api: apiVersion, Q: `"weekly report"`}, []int64{weekly}, 1, codes.OK},
+		{"Prefix", &v1.SearchRequest{Api: apiVersion, Q: "week*"}, []int64{weekly, milk}, 2, codes.OK},
+		{"Page size", &v1.SearchRequest{Api: apiVersion, Q: "report", PageSize: 1}, []int64{weekly}, 2, codes.OK},
+		{"No match", &v1.SearchRequest{Api: apiVersion, Q: "holiday"}, []int64{}, 0, codes.OK},
+		{"No words", &v1.SearchRequest{Api: apiVersion, Q: " * "}, nil, 0, codes.InvalidArgument},
+		{"Negative page size", &v1.SearchRequest{Api: apiVersion, Q: "report", PageSize: -1}, nil, 0, codes.InvalidArgument},
+		{"Unsupported API", &v1.SearchRequest{Api: "v2", Q: "report"}, nil, 0, codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Search(ctx, tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("toDoServiceServer.Search() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if ids := searchIDs(got); !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("toDoServiceServer.Search() = %v, want %v", ids, tt.want)
+			}
+			if got.TotalSize != tt.wantTotal {
+				t.Errorf("toDoServiceServer.Search() total_size = %v, want %v", got.TotalSize, tt.wantTotal)
+			}
+		})
+	}
+
+	t.Run("Highlight", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			req         *v1.SearchRequest
+			wantTitle   string
+			wantSnippet string
+		}{
+			{"Tags", &v1.SearchRequest{Api: apiVersion, Q: "milk", PreTag: "[", PostTag: "]"}, "Buy [milk]", "[Milk] and eggs for the weekly breakfast"},
+			{"Description only", &v1.SearchRequest{Api: apiVersion, Q: "eggs", PreTag: "<", PostTag: ">"}, "Buy milk", "Milk and <eggs> for the weekly breakfast"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Search(ctx, tt.req)
+				if err != nil || len(got.Results) != 1 {
+					t.Fatalf("toDoServiceServer.Search() = %v, %v, want one result", got, err)
+				}
+				if r := got.Results[0]; r.TitleHighlight != tt.wantTitle || r.DescriptionSnippet != tt.wantSnippet || r.Score <= 0 {
+					t.Errorf("toDoServiceServer.Search() = %v, want %q, %q", r, tt.wantTitle, tt.wantSnippet)
+				}
+			})
+		}
+	})
+
+	t.Run("Index follows changes", func(t *testing.T) {
+		if _, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:  apiVersion,
+			ToDo: &v1.ToDo{Id: milk, Title: "Buy bread", Status: v1.Status_TODO, EstimatedTimeOfCompletion: s.ts, Reminder: s.ts},
+		}); err != nil {
+			t.Fatalf("toDoServiceServer.Update() error = %v", err)
+		}
+		if _, err := s.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: stored}); err != nil {
+			t.Fatalf("toDoServiceServer.Delete() error = %v", err)
+		}
+
+		tests := []struct {
+			name string
+			q    string
+			want []int64
+		}{
+			{"Old title", "milk", []int64{}},
+			{"New title", "bread", []int64{milk}},
+			{"Deleted", "report", []int64{weekly}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Search(ctx, &v1.SearchRequest{Api: apiVersion, Q: tt.q})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.Search() error = %v", err)
+				}
+				if ids := searchIDs(got); !reflect.DeepEqual(ids, tt.want) {
+					t.Errorf("toDoServiceServer.Search(%q) = %v, want %v", tt.q, ids, tt.want)
+				}
+			})
+		}
+	})
+}
+
+//searchIDs returns IDs of the todo entities found in the order of results
+func searchIDs(res *v1.SearchResponse) []int64 {
+	ids := []int64{}
+	for _, r := range res.Results {
+		ids = append(ids, r.ToDo.Id)
+	}
+	return ids
+}
+
+func TestToDoServiceServerSearchReadsHitsInBatches(t *testing.T) {
+	ctx := context.Background()
+	//every todo entity matches, the caller may read those with even IDs
+	n := 2*searchLoadSize + 1
+	var loads [][]int64
+	repo := &fakeRepository{
+		list: func(opts storage.ListOptions) ([]*storage.ToDo, error) {
+			list := []*storage.ToDo{}
+			if len(opts.IDs) == 0 {
+				for id := int64(1); id <= int64(n); id++ {
+					list = append(list, &storage.ToDo{ID: id, Title: "report", Owner: anonymousActor})
+				}
+				return list, nil
+			}
+			loads = append(loads, opts.IDs)
+			if opts.VisibleTo != anonymousActor {
+				t.Errorf("toDoRepository.List() visible to %q, want %q", opts.VisibleTo, anonymousActor)
+			}
+			for _, id := range opts.IDs {
+				if id%2 == 0 {
+					list = append(list, &storage.ToDo{ID: id, Title: "report", Owner: anonymousActor})
+				}
+			}
+			return list, nil
+		},
+	}
+	s := NewToDoServiceServer(repo, WithDefaultOwner(anonymousActor))
+
+	res, err := s.Search(ctx, &v1.SearchRequest{Api: apiVersion, Q: "report", PageSize: 5})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.Search() error = %v", err)
+	}
+	if len(res.Results) != 5 || res.TotalSize != int32(n/2) {
+		t.Errorf("toDoServiceServer.Search() = %d results of %d, want 5 of %d", len(res.Results), res.TotalSize, n/2)
+	}
+	//todo entities are read by one query for every searchLoadSize hits, not one by one
+	if len(loads) != 3 || len(loads[0]) != searchLoadSize || len(loads[2]) != 1 {
+		t.Errorf("toDoServiceServer.Search() read %d batches, want 3 batches of at most %d hits", len(loads), searchLoadSize)
+	}
+}
@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//maxUserLength is the maximum length of user name in bytes, it is the size of ToDo.Owner column
+const maxUserLength = 200
+
+//access is what the caller may do with todo entity, greater access includes the lesser ones
+type access int
+
+const (
+	//viewAccess allows to read todo entity, its subtasks, completions and history
+	viewAccess access = iota + 1
+
+	//editAccess allows to change todo entity, its labels, dependencies and subtasks
+	editAccess
+
+	//ownAccess allows to delete, restore and share todo entity
+	ownAccess
+)
+
+//accessVerbs describe access in errors
+var accessVerbs = map[access]string{
+	viewAccess: "read",
+	editAccess: "change",
+	ownAccess:  "delete, restore or share",
+}
+
+//roleAccess is access granted by share roles
+var roleAccess = map[storage.ShareRole]access{
+	storage.ShareViewer: viewAccess,
+	storage.ShareEditor: editAccess,
+}
+
+//WithDefaultOwner sets the user owning todo entities created by callers without identity,
+//todo entities stored before they had owners are assigned to the same user
+func WithDefaultOwner(owner string) Option {
+	return func(s *todoServiceServer) {
+		s.defaultOwner = owner
+	}
+}
+
+//user returns the user the caller acts as: the actor of the call or the default owner when the actor is not known
+func (s *todoServiceServer) user(ctx context.Context) string {
+	actor, _ := caller(ctx)
+	if actor == anonymousActor {
+		return s.defaultOwner
+	}
+	return actor
+}
+
+//access returns access of the user to todo entity, 0 when the user may not even read it
+func (s *todoServiceServer) access(ctx context.Context, std *storage.ToDo, user string) (access, error) {
+	if std.Owner == user {
+		return ownAccess, nil
+	}
+	role, err := s.repo(ctx).ShareRole(ctx, std.ID, user)
+	if err != nil {
+		return 0, storageError(err, std.ID)
+	}
+	return roleAccess[role], nil
+}
+
+//checkAccess returns PermissionDenied unless the caller has at least the access to todo entity
+func (s *todoServiceServer) checkAccess(ctx context.Context, std *storage.ToDo, need access) error {
+	granted, err := s.access(ctx, std, s.user(ctx))
+	if err != nil {
+		return err
+	}
+	if granted < need {
+		return status.Errorf(codes.PermissionDenied, "caller may not %s ToDo with ID='%d' of another user", accessVerbs[need], std.ID)
+	}
+	return nil
+}
+
+//get reads todo entity by ID the caller has at least the access to
+func (s *todoServiceServer) get(ctx context.Context, id int64, need access) (*storage.ToDo, error) {
+	std, err := s.repo(ctx).Get(ctx, id)
+	if err != nil {
+		return nil, storageError(err, id)
+	}
+	if err := s.checkAccess(ctx, std, need); err != nil {
+		return nil, err
+	}
+	return std, nil
+}
+
+//visible returns todo entities the caller may read
+func (s *todoServiceServer) visible(ctx context.Context, stds []*storage.ToDo) ([]*storage.ToDo, error) {
+	user := s.user(ctx)
+	list := make([]*storage.ToDo, 0, len(stds))
+	for _, std := range stds {
+		granted, err := s.access(ctx, std, user)
+		if err != nil {
+			return nil, err
+		}
+		if granted >= viewAccess {
+			list = append(list, std)
+		}
+	}
+	return list, nil
+}
+
+//Share shares todo entity with another user
+func (s *todoServiceServer) Share(ctx context.Context, req *v1.ShareRequest) (*v1.ShareResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.Share == nil {
+		return nil, status.Error(codes.InvalidArgument, "share field is required")
+	}
+	user, err := normalizeUser("share.user", req.Share.User)
+	if err != nil {
+		return nil, err
+	}
+	var role storage.ShareRole
+	switch req.Share.Role {
+	case v1.ShareRole_VIEWER:
+		role = storage.ShareViewer
+	case v1.ShareRole_EDITOR:
+		role = storage.ShareEditor
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "share.role field must be VIEWER or EDITOR, got %s", req.Share.Role)
+	}
+
+	std, err := s.get(ctx, req.Id, ownAccess)
+	if err != nil {
+		return nil, err
+	}
+	if user == std.Owner {
+		return nil, status.Errorf(codes.InvalidArgument, "ToDo with ID='%d' can't be shared with its owner", req.Id)
+	}
+	if err := s.repo(ctx).Share(ctx, req.Id, user, role); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	shares, err := s.shares(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ShareResponse{
+		Api:    apiVersion,
+		Shares: shares,
+	}, nil
+}
+
+//Unshare stops sharing todo entity with a user, the owner revokes access of anybody and other users their own access
+func (s *todoServiceServer) Unshare(ctx context.Context, req *v1.UnshareRequest) (*v1.UnshareResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	user, err := normalizeUser("user", req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	std, err := s.repo(ctx).Get(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	self := user == s.user(ctx)
+	if !self {
+		if err := s.checkAccess(ctx, std, ownAccess); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo(ctx).Unshare(ctx, req.Id, user); err == storage.ErrShareNotFound {
+		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not shared with '%s'", req.Id, user)
+	} else if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	//user who left the todo entity may not see the remaining shares
+	res := &v1.UnshareResponse{Api: apiVersion, Shares: []*v1.Share{}}
+	if !self {
+		if res.Shares, err = s.shares(ctx, req.Id); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+//ListShares reads users todo entity is shared with
+func (s *todoServiceServer) ListShares(ctx context.Context, req *v1.ListSharesRequest) (*v1.ListSharesResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	std, err := s.get(ctx, req.Id, viewAccess)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.shares(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListSharesResponse{
+		Api:    apiVersion,
+		Owner:  std.Owner,
+		Shares: shares,
+	}, nil
+}
+
+//shares reads shares of todo entity
+func (s *todoServiceServer) shares(ctx context.Context, id int64) ([]*v1.Share, error) {
+	stored, err := s.repo(ctx).Shares(ctx, id)
+	if err != nil {
+		return nil, storageError(err, id)
+	}
+	list := make([]*v1.Share, 0, len(stored))
+	for _, sh := range stored {
+		list = append(list, &v1.Share{
+			User: sh.User,
+			Role: v1.ShareRole(v1.ShareRole_value[string(sh.Role)]),
+		})
+	}
+	return list, nil
+}
+
+//normalizeUser trims user name of the field and checks it fits the database
+func normalizeUser(name string, user string) (string, error) {
+	user = strings.TrimSpace(user)
+	switch {
+	case len(user) == 0:
+		return "", status.Errorf(codes.InvalidArgument, "%s field is required", name)
+	case len(user) > maxUserLength:
+		return "", status.Errorf(codes.InvalidArgument, "%s field is longer than %d bytes", name, maxUserLength)
+	}
+	return user, nil
+}
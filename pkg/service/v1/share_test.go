@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerShare(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 6, 20, 10, 0, 0, 0, time.UTC), WithDefaultOwner("nobody"))
+
+	as := func(actor string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(actorMetadata, actor))
+	}
+	alice, bob, carol := as("alice"), as("bob"), as("carol")
+	//authenticated caller owns tasks by the subject
+	authenticated := auth.NewContext(carol, &auth.Principal{Subject: "alice", Method: auth.MethodAPIKey})
+
+	report := s.mustCreate(t, alice, &v1.ToDo{Title: "report"})
+	groceries := s.mustCreate(t, bob, &v1.ToDo{Title: "groceries"})
+	//titles are unique among tasks of one owner
+	anonymous := s.mustCreate(t, ctx, &v1.ToDo{Title: "report"})
+
+	read := func(ctx context.Context, id int64) error {
+		_, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: id})
+		return err
+	}
+	update := func(ctx context.Context, id int64) error {
+		_, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:        apiVersion,
+			ToDo:       &v1.ToDo{Id: id, Description: "shared"},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"description"}},
+		})
+		return err
+	}
+	share := func(ctx context.Context, id int64, user string, role v1.ShareRole) error {
+		_, err := s.Share(ctx, &v1.ShareRequest{Api: apiVersion, Id: id, Share: &v1.Share{User: user, Role: role}})
+		return err
+	}
+
+	t.Run("Read", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			ctx       context.Context
+			id        int64
+			wantOwner string
+		}{
+			{"Owner", alice, report, "alice"},
+			{"Authenticated owner", authenticated, report, "alice"},
+			{"Default owner", ctx, anonymous, "nobody"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Read(tt.ctx, &v1.ReadRequest{Api: apiVersion, Id: tt.id})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.Read() error = %v", err)
+				}
+				if got.ToDo.Owner != tt.wantOwner {
+					t.Errorf("toDoServiceServer.Read() owner = %q, want %q", got.ToDo.Owner, tt.wantOwner)
+				}
+			})
+		}
+	})
+
+	t.Run("Access", func(t *testing.T) {
+		//viewer reads the task but may not change it, editor changes the task but may not delete or share it
+		tests := []struct {
+			name     string
+			call     func() error
+			wantCode codes.Code
+		}{
+			{"Read of other user", func() error { return read(bob, report) }, codes.PermissionDenied},
+			{"Update of other user", func() error { return update(bob, report) }, codes.PermissionDenied},
+			{"Share of other user", func() error { return share(bob, report, "bob", v1.ShareRole_EDITOR) }, codes.PermissionDenied},
+			{"Share with owner", func() error { return share(alice, report, "alice", v1.ShareRole_VIEWER) }, codes.InvalidArgument},
+			{"Share without role", func() error { return share(alice, report, "bob", v1.ShareRole_SHARE_ROLE_UNSPECIFIED) }, codes.InvalidArgument},
+			{"Share without user", func() error { return share(alice, report, " ", v1.ShareRole_VIEWER) }, codes.InvalidArgument},
+			{"Too long user", func() error { return share(alice, report, strings.Repeat("x", maxUserLength+1), v1.ShareRole_VIEWER) }, codes.InvalidArgument},
+			{"Share of missing task", func() error { return share(alice, 42, "bob", v1.ShareRole_VIEWER) }, codes.NotFound},
+			{"Share without share", func() error {
+				_, err := s.Share(alice, &v1.ShareRequest{Api: apiVersion, Id: report})
+				return err
+			}, codes.InvalidArgument},
+			{"Unsupported API", func() error {
+				_, err := s.ListShares(alice, &v1.ListSharesRequest{Api: "v2", Id: report})
+				return err
+			}, codes.Unimplemented},
+			{"Share with viewer", func() error { return share(alice, report, "bob", v1.ShareRole_VIEWER) }, codes.OK},
+			{"Read of viewer", func() error { return read(bob, report) }, codes.OK},
+			{"Update of viewer", func() error { return update(bob, report) }, codes.PermissionDenied},
+			{"Share with editor", func() error { return share(alice, report, " bob ", v1.ShareRole_EDITOR) }, codes.OK},
+			{"Update of editor", func() error { return update(bob, report) }, codes.OK},
+			{"Delete of editor", func() error {
+				_, err := s.Delete(bob, &v1.DeleteRequest{Api: apiVersion, Id: report})
+				return err
+			}, codes.PermissionDenied},
+			{"Share of editor", func() error { return share(bob, report, "carol", v1.ShareRole_VIEWER) }, codes.PermissionDenied},
+			{"Share with other viewer", func() error { return share(alice, report, "carol", v1.ShareRole_VIEWER) }, codes.OK},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.call(); status.Code(err) != tt.wantCode {
+					t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ReadAll", func(t *testing.T) {
+		tests := []struct {
+			name string
+			ctx  context.Context
+			want []int64
+		}{
+			{"Owner", alice, []int64{report}},
+			{"Authenticated owner", authenticated, []int64{report}},
+			{"Default owner", ctx, []int64{anonymous}},
+			{"Shared", bob, []int64{report, groceries}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ReadAll(tt.ctx, &v1.ReadAllRequest{Api: apiVersion})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+				}
+				if ids := toDoIDs(got.ToDos); !reflect.DeepEqual(ids, tt.want) {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, want %v", ids, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("ListShares", func(t *testing.T) {
+		got, err := s.ListShares(carol, &v1.ListSharesRequest{Api: apiVersion, Id: report})
+		if err != nil {
+			t.Fatalf("toDoServiceServer.ListShares() error = %v", err)
+		}
+		want := &v1.ListSharesResponse{
+			Api:    apiVersion,
+			Owner:  "alice",
+			Shares: []*v1.Share{{User: "bob", Role: v1.ShareRole_EDITOR}, {User: "carol", Role: v1.ShareRole_VIEWER}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoServiceServer.ListShares() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Unshare", func(t *testing.T) {
+		//user leaves the shared task, only the owner revokes access of others
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			user     string
+			wantCode codes.Code
+			wantRead codes.Code
+		}{
+			{"Other user", carol, "bob", codes.PermissionDenied, codes.OK},
+			{"Leave", carol, "carol", codes.OK, codes.OK},
+			{"Left", carol, "carol", codes.NotFound, codes.OK},
+			{"Revoke", alice, "bob", codes.OK, codes.PermissionDenied},
+			{"Revoked", alice, "bob", codes.NotFound, codes.PermissionDenied},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Unshare(tt.ctx, &v1.UnshareRequest{Api: apiVersion, Id: report, User: tt.user})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Unshare() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && len(got.Shares) != 0 {
+					t.Errorf("toDoServiceServer.Unshare() = %v, want no shares visible to the caller", got.Shares)
+				}
+				if err := read(bob, report); status.Code(err) != tt.wantRead {
+					t.Errorf("toDoServiceServer.Read() error = %v, wantCode %v", err, tt.wantRead)
+				}
+			})
+		}
+	})
+}
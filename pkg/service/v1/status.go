@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Transitions maps status of todo entity to statuses it is allowed to be changed to.
+//Keeping the same status is always allowed.
+type Transitions map[v1.Status][]v1.Status
+
+//DefaultTransitions are used when server is not configured with other transitions
+var DefaultTransitions = Transitions{
+	v1.Status_TODO:        {v1.Status_IN_PROGRESS, v1.Status_BLOCKED, v1.Status_DONE, v1.Status_CANCELLED},
+	v1.Status_IN_PROGRESS: {v1.Status_TODO, v1.Status_BLOCKED, v1.Status_DONE, v1.Status_CANCELLED},
+	v1.Status_BLOCKED:     {v1.Status_TODO, v1.Status_IN_PROGRESS, v1.Status_CANCELLED},
+	v1.Status_DONE:        {v1.Status_TODO, v1.Status_IN_PROGRESS},
+	v1.Status_CANCELLED:   {v1.Status_TODO},
+}
+
+//WithTransitions replaces DefaultTransitions enforced by Update
+func WithTransitions(t Transitions) Option {
+	return func(s *todoServiceServer) {
+		s.transitions = t
+	}
+}
+
+//ParseTransitions parses transitions separated by ';' where every transition is
+//the status followed by ':' and comma separated statuses it can be changed to
+//e.g. "TODO:IN_PROGRESS,DONE;IN_PROGRESS:DONE;DONE:TODO"
+func ParseTransitions(s string) (Transitions, error) {
+	t := Transitions{}
+	for _, rule := range strings.Split(s, ";") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+
+		parts := strings.SplitN(rule, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("status transition '%s' must be in 'FROM:TO,TO' format", rule)
+		}
+		from, err := parseStatus(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := t[from]; ok {
+			return nil, fmt.Errorf("status transitions from '%s' are defined more than once", from)
+		}
+
+		t[from] = []v1.Status{}
+		for _, name := range strings.Split(parts[1], ",") {
+			if len(strings.TrimSpace(name)) == 0 {
+				continue
+			}
+			to, err := parseStatus(name)
+			if err != nil {
+				return nil, err
+			}
+			t[from] = append(t[from], to)
+		}
+	}
+	return t, nil
+}
+
+//parseStatus parses status name e.g. "IN_PROGRESS"
+func parseStatus(name string) (v1.Status, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if v, ok := v1.Status_value[name]; ok && v1.Status(v) != v1.Status_STATUS_UNSPECIFIED {
+		return v1.Status(v), nil
+	}
+	return v1.Status_STATUS_UNSPECIFIED, fmt.Errorf("unknown status '%s'", name)
+}
+
+//allowed reports whether todo entity is allowed to change status
+func (t Transitions) allowed(from, to v1.Status) bool {
+	if from == to {
+		return true
+	}
+	for _, s := range t[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+//checkTransition returns FailedPrecondition error when status transition is not allowed
+func (t Transitions) checkTransition(from, to v1.Status) error {
+	if to == v1.Status_STATUS_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "status field is required")
+	}
+	//stored status unknown to the API is not restricted
+	if from == v1.Status_STATUS_UNSPECIFIED {
+		return nil
+	}
+	if !t.allowed(from, to) {
+		return status.Errorf(codes.FailedPrecondition, "status of ToDo can't be changed from %s to %s", from, to)
+	}
+	return nil
+}
+
+//statusFromStorage converts stored status to the API enum, unknown status is STATUS_UNSPECIFIED
+func statusFromStorage(s string) v1.Status {
+	return v1.Status(v1.Status_value[s])
+}
+
+//statusToStorage converts status enum to its stored value
+func statusToStorage(s v1.Status) string {
+	if s == v1.Status_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return s.String()
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+)
+
+func TestParseTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Transitions
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			s:    "TODO:IN_PROGRESS,done; in_progress:DONE ;DONE:",
+			want: Transitions{
+				v1.Status_TODO:        {v1.Status_IN_PROGRESS, v1.Status_DONE},
+				v1.Status_IN_PROGRESS: {v1.Status_DONE},
+				v1.Status_DONE:        {},
+			},
+		},
+		{
+			name: "Empty",
+			s:    "",
+			want: Transitions{},
+		},
+		{
+			name:    "Missing separator",
+			s:       "TODO IN_PROGRESS",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown status",
+			s:       "TODO:STARTED",
+			wantErr: true,
+		},
+		{
+			name:    "Unspecified status",
+			s:       "STATUS_UNSPECIFIED:TODO",
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate status",
+			s:       "TODO:DONE;TODO:BLOCKED",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTransitions(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTransitions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTransitions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitionsAllowed(t *testing.T) {
+	tests := []struct {
+		from v1.Status
+		to   v1.Status
+		want bool
+	}{
+		{v1.Status_TODO, v1.Status_IN_PROGRESS, true},
+		{v1.Status_IN_PROGRESS, v1.Status_DONE, true},
+		{v1.Status_DONE, v1.Status_TODO, true},
+		{v1.Status_BLOCKED, v1.Status_BLOCKED, true},
+		{v1.Status_BLOCKED, v1.Status_DONE, false},
+		{v1.Status_CANCELLED, v1.Status_DONE, false},
+	}
+	for _, tt := range tests {
+		if got := DefaultTransitions.allowed(tt.from, tt.to); got != tt.want {
+			t.Errorf("Transitions.allowed(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//ListChildren reads subtasks of todo entity
+func (s *todoServiceServer) ListChildren(ctx context.Context, req *v1.ListChildrenRequest) (*v1.ListChildrenResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(ctx, req.Id, viewAccess); err != nil {
+		return nil, err
+	}
+
+	var stds []*storage.ToDo
+	var err error
+	if req.Recursive {
+		stds, err = s.repo(ctx).Descendants(ctx, req.Id)
+	} else {
+		stds, err = s.repo(ctx).List(ctx, storage.ListOptions{ParentID: req.Id})
+	}
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	//subtasks added by other users are listed only when the caller may read them
+	if stds, err = s.visible(ctx, stds); err != nil {
+		return nil, err
+	}
+
+	list := []*v1.ToDo{}
+	for _, std := range stds {
+		td, err := toProto(std)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+	}
+
+	return &v1.ListChildrenResponse{
+		Api:   apiVersion,
+		ToDos: list,
+	}, nil
+}
+
+//Move makes todo entity together with its subtasks a subtask of another todo entity
+func (s *todoServiceServer) Move(ctx context.Context, req *v1.MoveRequest) (*v1.MoveResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	if req.ParentId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "parent_id must not be negative, got %d", req.ParentId)
+	}
+
+	//both the todo entity and the new parent are changed by the caller
+	if _, err := s.get(ctx, req.Id, editAccess); err != nil {
+		return nil, err
+	}
+	if req.ParentId != 0 {
+		parent, err := s.repo(ctx).Get(ctx, req.ParentId)
+		if err == storage.ErrNotFound {
+			return nil, storageError(storage.ErrParentNotFound, req.ParentId)
+		}
+		if err != nil {
+			return nil, storageError(err, req.ParentId)
+		}
+		if err := s.checkAccess(ctx, parent, editAccess); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.now()
+	if _, err := s.repo(ctx).Move(ctx, req.Id, req.ParentId, now); err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	//subtasks are moved together with the todo entity
+	moved := int64(1)
+	std, err := s.repo(ctx).Get(ctx, req.Id)
+	if err == nil {
+		s.notify(ctx, v1.WatchResponse_UPDATED, std, now)
+		if descendants, err := s.repo(ctx).Descendants(ctx, req.Id); err == nil {
+			moved += int64(len(descendants))
+		}
+	}
+
+	return &v1.MoveResponse{
+		Api:   apiVersion,
+		Moved: moved,
+	}, nil
+}
+
+//GetProgress computes roll-up progress of todo entity from its subtasks at all levels
+func (s *todoServiceServer) GetProgress(ctx context.Context, req *v1.GetProgressRequest) (*v1.GetProgressResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	std, err := s.get(ctx, req.Id, viewAccess)
+	if err != nil {
+		return nil, err
+	}
+	descendants, err := s.repo(ctx).Descendants(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+
+	res := &v1.GetProgressResponse{Api: apiVersion}
+	for _, d := range descendants {
+		//cancelled subtask is not going to be completed
+		switch statusFromStorage(d.Status) {
+		case v1.Status_CANCELLED:
+			continue
+		case v1.Status_DONE:
+			res.Done++
+		}
+		res.Total++
+	}
+
+	switch {
+	case res.Total > 0:
+		res.Percent = float64(res.Done) * 100 / float64(res.Total)
+	case statusFromStorage(std.Status) == v1.Status_DONE:
+		//task without subtasks is as complete as the task itself
+		res.Percent = 100
+	}
+	return res, nil
+}
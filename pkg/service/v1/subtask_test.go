@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerSubtasks(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 4, 20, 10, 0, 0, 0, time.UTC))
+
+	//release
+	//├── build (DONE)
+	//│   └── test (DONE)
+	//├── docs
+	//└── blog (CANCELLED)
+	release := s.mustCreate(t, ctx, &v1.ToDo{Title: "release"})
+	build := s.mustCreate(t, ctx, &v1.ToDo{Title: "build", Status: v1.Status_DONE, ParentId: release})
+	docs := s.mustCreate(t, ctx, &v1.ToDo{Title: "docs", ParentId: release})
+	blog := s.mustCreate(t, ctx, &v1.ToDo{Title: "blog", Status: v1.Status_CANCELLED, ParentId: release})
+	test := s.mustCreate(t, ctx, &v1.ToDo{Title: "test", Status: v1.Status_DONE, ParentId: build})
+
+	t.Run("Create", func(t *testing.T) {
+		if _, err := s.create(ctx, &v1.ToDo{Title: "orphan", ParentId: 42}); status.Code(err) != codes.NotFound {
+			t.Errorf("toDoServiceServer.Create() error = %v, wantCode %v", err, codes.NotFound)
+		}
+	})
+
+	t.Run("ListChildren", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			req         *v1.ListChildrenRequest
+			want        []int64
+			wantParents []int64
+			wantCode    codes.Code
+		}{
+			{
+				name:        "Children",
+				req:         &v1.ListChildrenRequest{Api: apiVersion, Id: release},
+				want:        []int64{build, docs, blog},
+				wantParents: []int64{release, release, release},
+			},
+			{
+				name:        "Recursive",
+				req:         &v1.ListChildrenRequest{Api: apiVersion, Id: release, Recursive: true},
+				want:        []int64{build, docs, blog, test},
+				wantParents: []int64{release, release, release, build},
+			},
+			{
+				name:     "Not found",
+				req:      &v1.ListChildrenRequest{Api: apiVersion, Id: 42},
+				wantCode: codes.NotFound,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ListChildren(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ListChildren() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err != nil {
+					return
+				}
+				if !reflect.DeepEqual(toDoIDs(got.ToDos), tt.want) {
+					t.Fatalf("toDoServiceServer.ListChildren() = %v, want %v", toDoIDs(got.ToDos), tt.want)
+				}
+				for i, td := range got.ToDos {
+					if td.ParentId != tt.wantParents[i] {
+						t.Errorf("toDoServiceServer.ListChildren() parent of %d = %d, want %d", td.Id, td.ParentId, tt.wantParents[i])
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("GetProgress", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			id       int64
+			want     *v1.GetProgressResponse
+			wantCode codes.Code
+		}{
+			{"Cancelled not counted", release, &v1.GetProgressResponse{Total: 3, Done: 2, Percent: 200.0 / 3}, codes.OK},
+			{"Subtask", build, &v1.GetProgressResponse{Total: 1, Done: 1, Percent: 100}, codes.OK},
+			{"Done without subtasks", test, &v1.GetProgressResponse{Total: 0, Done: 0, Percent: 100}, codes.OK},
+			{"Open without subtasks", docs, &v1.GetProgressResponse{Total: 0, Done: 0, Percent: 0}, codes.OK},
+			{"Not found", 42, nil, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.GetProgress(ctx, &v1.GetProgressRequest{Api: apiVersion, Id: tt.id})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.GetProgress() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && (got.Total != tt.want.Total || got.Done != tt.want.Done || got.Percent != tt.want.Percent) {
+					t.Errorf("toDoServiceServer.GetProgress() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			id           int64
+			parentID     int64
+			want         int64
+			wantCode     codes.Code
+			wantProgress int64
+		}{
+			{"Under itself", build, build, 0, codes.FailedPrecondition, 0},
+			{"Under subtask", build, test, 0, codes.FailedPrecondition, 0},
+			{"Missing parent", build, 42, 0, codes.NotFound, 0},
+			{"Missing task", 42, release, 0, codes.NotFound, 0},
+			{"Negative parent", build, -1, 0, codes.InvalidArgument, 0},
+			{"With subtasks", build, docs, 2, codes.OK, 2},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Move(ctx, &v1.MoveRequest{Api: apiVersion, Id: tt.id, ParentId: tt.parentID})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Move() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && got.Moved != tt.want {
+					t.Errorf("toDoServiceServer.Move() = %v, want %d moved", got, tt.want)
+				}
+				//done subtasks of the new parent
+				progress, err := s.GetProgress(ctx, &v1.GetProgressRequest{Api: apiVersion, Id: docs})
+				if err != nil || progress.Done != tt.wantProgress {
+					t.Errorf("toDoServiceServer.GetProgress() = %v, %v, want %d done", progress, err, tt.wantProgress)
+				}
+			})
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.DeleteRequest
+			want     int64
+			wantCode codes.Code
+			//wantLeft are parents of remaining todo entities by their IDs
+			wantLeft map[int64]int64
+		}{
+			{
+				name:     "With subtasks",
+				req:      &v1.DeleteRequest{Api: apiVersion, Id: docs},
+				wantCode: codes.FailedPrecondition,
+				wantLeft: map[int64]int64{release: 0, build: docs, docs: release, blog: release, test: build},
+			},
+			{
+				name:     "Unknown mode",
+				req:      &v1.DeleteRequest{Api: apiVersion, Id: docs, Mode: 42},
+				wantCode: codes.InvalidArgument,
+				wantLeft: map[int64]int64{release: 0, build: docs, docs: release, blog: release, test: build},
+			},
+			{
+				name:     "Reparent",
+				req:      &v1.DeleteRequest{Api: apiVersion, Id: docs, Mode: v1.DeleteRequest_REPARENT},
+				want:     1,
+				wantLeft: map[int64]int64{release: 0, build: release, blog: release, test: build},
+			},
+			{
+				name:     "Cascade",
+				req:      &v1.DeleteRequest{Api: apiVersion, Id: release, Mode: v1.DeleteRequest_CASCADE},
+				want:     4,
+				wantLeft: map[int64]int64{},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Delete(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Delete() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && got.Deleted != tt.want {
+					t.Errorf("toDoServiceServer.Delete() = %v, want %d deleted", got, tt.want)
+				}
+				all, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+				}
+				left := map[int64]int64{}
+				for _, td := range all.ToDos {
+					left[td.Id] = td.ParentId
+				}
+				if !reflect.DeepEqual(left, tt.wantLeft) {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, want %v", left, tt.wantLeft)
+				}
+			})
+		}
+	})
+}
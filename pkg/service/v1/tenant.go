@@ -0,0 +1,331 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/search"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/tenant"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//TenantMethods are full names of the methods managing tenants, they are called outside of any tenant
+var TenantMethods = []string{
+	"/v1.ToDoService/CreateTenant",
+	"/v1.ToDoService/UpdateTenant",
+	"/v1.ToDoService/DeleteTenant",
+	"/v1.ToDoService/ListTenants",
+}
+
+//Fields of tenant UpdateTenant is able to change
+const (
+	fieldMaxTasks  = "maxtasks"
+	fieldSuspended = "suspended"
+)
+
+//allTenantFields are fields replaced by UpdateTenant without field mask
+var allTenantFields = []string{fieldName, fieldMaxTasks, fieldSuspended}
+
+//space is what server keeps for one tenant: the repository scoped to the tenant, watchers of its changes
+//and full-text index of its todo entities
+type space struct {
+	repo storage.Repository
+
+	//events publishes changes of todo entities to Watch callers
+	events *eventBroker
+
+	//index is full-text index of todo entities searched by Search, it is loaded from the repository by the first Search
+	index *search.Index
+
+	//indexLoad makes concurrent Search calls load the index once
+	indexLoad sync.Mutex
+}
+
+//newSpace creates space of the repository without watchers and with index which is not loaded yet
+func newSpace(repo storage.Repository) *space {
+	return &space{
+		repo:   repo,
+		events: newEventBroker(),
+		index:  search.NewIndex(),
+	}
+}
+
+//WithTenantAdmins sets the users allowed to create, update, delete and list tenants,
+//nobody manages tenants without it. Admins must be authenticated, actor sent in metadata is not trusted.
+func WithTenantAdmins(admins ...string) Option {
+	return func(s *todoServiceServer) {
+		s.tenantAdmins = make(map[string]bool, len(admins))
+		for _, admin := range admins {
+			s.tenantAdmins[admin] = true
+		}
+	}
+}
+
+//space returns space of the tenant the call is scoped to, calls without tenant share the space of data without tenant
+func (s *todoServiceServer) space(ctx context.Context) *space {
+	id := ""
+	if t, ok := tenant.FromContext(ctx); ok {
+		id = t.ID
+	}
+
+	s.spacesMu.Lock()
+	defer s.spacesMu.Unlock()
+
+	sp, ok := s.spaces[id]
+	if !ok {
+		sp = newSpace(s.root.ForTenant(id))
+		s.spaces[id] = sp
+	}
+	return sp
+}
+
+//repo returns repository of the tenant the call is scoped to
+func (s *todoServiceServer) repo(ctx context.Context) storage.Repository {
+	return s.space(ctx).repo
+}
+
+//checkQuota checks that the tenant of the call may have n more todo entities. Concurrent calls are not serialized
+//so the tenant may exceed its quota by todo entities created at the same time.
+func (s *todoServiceServer) checkQuota(ctx context.Context, n int) error {
+	t, ok := tenant.FromContext(ctx)
+	if !ok || t.MaxToDos == 0 || n == 0 {
+		return nil
+	}
+	count, err := s.repo(ctx).CountToDos(ctx)
+	if err != nil {
+		return storageError(err, 0)
+	}
+	if count+int64(n) > t.MaxToDos {
+		return status.Errorf(codes.ResourceExhausted, "Tenant with ID='%s' has %d of %d tasks allowed, delete and purge tasks or ask to raise the quota", t.ID, count, t.MaxToDos)
+	}
+	return nil
+}
+
+//checkTenantAdmin checks that the authenticated caller manages tenants,
+//actor sent in metadata when authentication is disabled can be anybody so it is denied
+func (s *todoServiceServer) checkTenantAdmin(ctx context.Context) error {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "unauthenticated caller may not manage tenants")
+	}
+	if !s.tenantAdmins[p.Subject] {
+		return status.Errorf(codes.PermissionDenied, "user '%s' may not manage tenants", p.Subject)
+	}
+	return nil
+}
+
+//tenantError converts error returned by the repository for tenant to gRPC status error
+func tenantError(err error, id string) error {
+	switch err {
+	case storage.ErrTenantNotFound:
+		return status.Errorf(codes.NotFound, "Tenant with ID='%s' is not found", id)
+	case storage.ErrTenantAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+//tenantUpdateFields returns tenant fields listed in update mask, all fields are returned when mask is empty
+func tenantUpdateFields(mask *field_mask.FieldMask) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return allTenantFields, nil
+	}
+
+	var fields []string
+	for _, path := range mask.GetPaths() {
+		field := strings.TrimPrefix(strings.ToLower(strings.Replace(path, "_", "", -1)), "tenant.")
+		switch field {
+		case "id", "createdat", "updatedat":
+			//ID identifies tenant to update and the times are set by server
+			continue
+		case "*":
+			return allTenantFields, nil
+		case fieldName, fieldMaxTasks, fieldSuspended:
+			fields = append(fields, field)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask contains unsupported field '%s'", path)
+		}
+	}
+	return fields, nil
+}
+
+//CreateTenant creates a new tenant
+func (s *todoServiceServer) CreateTenant(ctx context.Context, req *v1.CreateTenantRequest) (*v1.CreateTenantResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	t := req.GetTenant()
+	if t == nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant field is required")
+	}
+	if !tenant.ValidID(t.Id) {
+		return nil, status.Errorf(codes.InvalidArgument, "id field must be 1 to %d lowercase letters, digits and dashes", tenant.MaxIDLength)
+	}
+	if t.MaxTasks < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_tasks field must not be negative")
+	}
+
+	now := s.now()
+	st := &storage.Tenant{
+		ID:        t.Id,
+		Name:      t.Name,
+		MaxToDos:  t.MaxTasks,
+		Suspended: t.Suspended,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	//tenant is named by its ID unless client names it
+	if len(st.Name) == 0 {
+		st.Name = st.ID
+	}
+	if err := s.root.CreateTenant(ctx, st); err != nil {
+		return nil, tenantError(err, t.Id)
+	}
+
+	created, err := tenantToProto(st)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateTenantResponse{
+		Api:    apiVersion,
+		Tenant: created,
+	}, nil
+}
+
+//UpdateTenant updates tenant, suspended tenant is rejected by the next call of its users
+func (s *todoServiceServer) UpdateTenant(ctx context.Context, req *v1.UpdateTenantRequest) (*v1.UpdateTenantResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Tenant == nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant field is required")
+	}
+
+	fields, err := tenantUpdateFields(req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.root.GetTenant(ctx, req.Tenant.Id)
+	if err != nil {
+		return nil, tenantError(err, req.Tenant.Id)
+	}
+	for _, field := range fields {
+		switch field {
+		case fieldName:
+			if len(req.Tenant.Name) == 0 {
+				return nil, status.Error(codes.InvalidArgument, "name field is required")
+			}
+			st.Name = req.Tenant.Name
+		case fieldMaxTasks:
+			if req.Tenant.MaxTasks < 0 {
+				return nil, status.Error(codes.InvalidArgument, "max_tasks field must not be negative")
+			}
+			st.MaxToDos = req.Tenant.MaxTasks
+		case fieldSuspended:
+			st.Suspended = req.Tenant.Suspended
+		}
+	}
+	st.UpdatedAt = s.now()
+
+	rows, err := s.root.UpdateTenant(ctx, st)
+	if err != nil {
+		return nil, tenantError(err, req.Tenant.Id)
+	}
+
+	return &v1.UpdateTenantResponse{
+		Api:     apiVersion,
+		Updated: rows,
+	}, nil
+}
+
+//DeleteTenant deletes tenant with all its data
+func (s *todoServiceServer) DeleteTenant(ctx context.Context, req *v1.DeleteTenantRequest) (*v1.DeleteTenantResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.root.DeleteTenant(ctx, req.Id)
+	if err != nil {
+		return nil, tenantError(err, req.Id)
+	}
+
+	//tenant created again with the same ID starts with empty index and without watchers
+	s.spacesMu.Lock()
+	delete(s.spaces, req.Id)
+	s.spacesMu.Unlock()
+
+	return &v1.DeleteTenantResponse{
+		Api:          apiVersion,
+		Deleted:      1,
+		DeletedTasks: tasks,
+	}, nil
+}
+
+//ListTenants reads all tenants
+func (s *todoServiceServer) ListTenants(ctx context.Context, req *v1.ListTenantsRequest) (*v1.ListTenantsResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenantAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	sts, err := s.root.ListTenants(ctx)
+	if err != nil {
+		return nil, tenantError(err, "")
+	}
+
+	list := []*v1.Tenant{}
+	for _, st := range sts {
+		t, err := tenantToProto(st)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+
+	return &v1.ListTenantsResponse{
+		Api:     apiVersion,
+		Tenants: list,
+	}, nil
+}
+
+//tenantToProto converts stored tenant to its API representation
+func tenantToProto(st *storage.Tenant) (*v1.Tenant, error) {
+	var err error
+	t := &v1.Tenant{
+		Id:        st.ID,
+		Name:      st.Name,
+		MaxTasks:  st.MaxToDos,
+		Suspended: st.Suspended,
+	}
+	if t.CreatedAt, err = timestampProto("createdAt", st.CreatedAt); err != nil {
+		return nil, err
+	}
+	if t.UpdatedAt, err = timestampProto("updatedAt", st.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
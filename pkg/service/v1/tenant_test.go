@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/auth"
+	"github.com/basebandit/go-grpc/pkg/tenant"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerTenants(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC), WithTenantAdmins("root"))
+	admin := auth.NewContext(ctx, &auth.Principal{Subject: "root", Method: auth.MethodAPIKey})
+	alice := auth.NewContext(ctx, &auth.Principal{Subject: "alice", Method: auth.MethodAPIKey})
+
+	t.Run("CreateTenant", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			req      *v1.CreateTenantRequest
+			wantCode codes.Code
+		}{
+			{"With quota", admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "blue", Name: "Blue team", MaxTasks: 2}}, codes.OK},
+			{"Without quota", admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "red"}}, codes.OK},
+			{"Not an admin", alice, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "green"}}, codes.PermissionDenied},
+			{"Spoofed actor", metadata.NewIncomingContext(ctx, metadata.Pairs(actorMetadata, "root")), &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "green"}}, codes.PermissionDenied},
+			{"Already exists", admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "red"}}, codes.AlreadyExists},
+			{"Invalid ID", admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "Green team"}}, codes.InvalidArgument},
+			{"Negative quota", admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "green", MaxTasks: -1}}, codes.InvalidArgument},
+			{"Without tenant", admin, &v1.CreateTenantRequest{Api: apiVersion}, codes.InvalidArgument},
+			{"Unsupported API", admin, &v1.CreateTenantRequest{Api: "v2", Tenant: &v1.Tenant{Id: "green"}}, codes.Unimplemented},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.CreateTenant(tt.ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.CreateTenant() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	//calls are scoped to the tenant put in the context by the tenancy interceptor
+	in := func(id string) context.Context {
+		st, err := s.root.GetTenant(ctx, id)
+		if err != nil {
+			t.Fatalf("toDoRepository.GetTenant() error = %v", err)
+		}
+		return tenant.NewContext(alice, st)
+	}
+	blue, red := in("blue"), in("red")
+	report := s.mustCreate(t, blue, &v1.ToDo{Title: "report"})
+	//titles are unique within a tenant
+	s.mustCreate(t, red, &v1.ToDo{Title: "report"})
+
+	t.Run("Isolation", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			wantCode codes.Code
+		}{
+			{"Other tenant", red, codes.NotFound},
+			{"Without tenant", alice, codes.NotFound},
+			{"Tenant", blue, codes.OK},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.Read(tt.ctx, &v1.ReadRequest{Api: apiVersion, Id: report}); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.Read() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				res, err := s.Search(tt.ctx, &v1.SearchRequest{Api: apiVersion, Q: "report"})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.Search() error = %v", err)
+				}
+				found := false
+				for _, r := range res.Results {
+					found = found || r.ToDo.Id == report
+				}
+				if found != (tt.wantCode == codes.OK) {
+					t.Errorf("toDoServiceServer.Search() = %v, want task of blue found %v", res.Results, tt.wantCode == codes.OK)
+				}
+			})
+		}
+	})
+
+	t.Run("Quota", func(t *testing.T) {
+		create := func(ctx context.Context, title string) func() error {
+			return func() error {
+				_, err := s.create(ctx, &v1.ToDo{Title: title})
+				return err
+			}
+		}
+		//quota counts tasks in the trash too, raised quota applies to calls scoped after the update
+		tests := []struct {
+			name     string
+			call     func() error
+			wantCode codes.Code
+		}{
+			{"Under quota", create(blue, "slides"), codes.OK},
+			{"Delete", func() error {
+				_, err := s.Delete(blue, &v1.DeleteRequest{Api: apiVersion, Id: report})
+				return err
+			}, codes.OK},
+			{"Over quota", create(blue, "budget"), codes.ResourceExhausted},
+			{"Without quota", func() error {
+				_, err := s.BatchCreate(red, &v1.BatchCreateRequest{Api: apiVersion, Requests: []*v1.CreateRequest{
+					{ToDo: &v1.ToDo{Title: "budget", EstimatedTimeOfCompletion: s.ts, Reminder: s.ts}},
+					{ToDo: &v1.ToDo{Title: "slides", EstimatedTimeOfCompletion: s.ts, Reminder: s.ts}},
+				}})
+				return err
+			}, codes.OK},
+			{"Raise quota", func() error {
+				_, err := s.UpdateTenant(admin, &v1.UpdateTenantRequest{
+					Api:        apiVersion,
+					Tenant:     &v1.Tenant{Id: "blue", MaxTasks: 3, Suspended: true},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"max_tasks"}},
+				})
+				return err
+			}, codes.OK},
+			{"Scoped before update", create(blue, "budget"), codes.ResourceExhausted},
+			{"Raised quota", func() error { return create(in("blue"), "budget")() }, codes.OK},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.call(); status.Code(err) != tt.wantCode {
+					t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("UpdateTenant", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			req      *v1.UpdateTenantRequest
+			wantCode codes.Code
+		}{
+			{"Not found", admin, &v1.UpdateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "green"}}, codes.NotFound},
+			{"Unsupported field", admin, &v1.UpdateTenantRequest{
+				Api:        apiVersion,
+				Tenant:     &v1.Tenant{Id: "blue"},
+				UpdateMask: &field_mask.FieldMask{Paths: []string{"owner"}},
+			}, codes.InvalidArgument},
+			{"Not an admin", alice, &v1.UpdateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "blue", Name: "Blue"}}, codes.PermissionDenied},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.UpdateTenant(tt.ctx, tt.req); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.UpdateTenant() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("ListTenants", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			want     []*v1.Tenant
+			wantCode codes.Code
+		}{
+			{
+				name: "Admin",
+				ctx:  admin,
+				want: []*v1.Tenant{
+					{Id: "blue", Name: "Blue team", MaxTasks: 3, CreatedAt: s.ts, UpdatedAt: s.ts},
+					{Id: "red", Name: "red", CreatedAt: s.ts, UpdatedAt: s.ts},
+				},
+			},
+			{
+				name:     "Not an admin",
+				ctx:      alice,
+				wantCode: codes.PermissionDenied,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.ListTenants(tt.ctx, &v1.ListTenantsRequest{Api: apiVersion})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.ListTenants() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && !reflect.DeepEqual(got.Tenants, tt.want) {
+					t.Errorf("toDoServiceServer.ListTenants() = %v, want %v", got.Tenants, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("DeleteTenant", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			want     *v1.DeleteTenantResponse
+			wantCode codes.Code
+		}{
+			{"Not an admin", alice, nil, codes.PermissionDenied},
+			{"With tasks", admin, &v1.DeleteTenantResponse{Api: apiVersion, Deleted: 1, DeletedTasks: 3}, codes.OK},
+			{"Not found", admin, nil, codes.NotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.DeleteTenant(tt.ctx, &v1.DeleteTenantRequest{Api: apiVersion, Id: "blue"})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.DeleteTenant() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("toDoServiceServer.DeleteTenant() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("Created again", func(t *testing.T) {
+		//tenant created again starts empty
+		if _, err := s.CreateTenant(admin, &v1.CreateTenantRequest{Api: apiVersion, Tenant: &v1.Tenant{Id: "blue"}}); err != nil {
+			t.Fatalf("toDoServiceServer.CreateTenant() error = %v", err)
+		}
+		blue := in("blue")
+		if res, err := s.Search(blue, &v1.SearchRequest{Api: apiVersion, Q: "report"}); err != nil || len(res.Results) != 0 {
+			t.Errorf("toDoServiceServer.Search() = %v, %v, want no results", res, err)
+		}
+		if res, err := s.ReadAll(blue, &v1.ReadAllRequest{Api: apiVersion}); err != nil || len(res.ToDos) != 0 {
+			t.Errorf("toDoServiceServer.ReadAll() = %v, %v, want no tasks", res, err)
+		}
+	})
+}
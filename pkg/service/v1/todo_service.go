@@ -2,11 +2,13 @@ package v1
 
 import (
 	"context"
-	"database/sql"
+	"sync"
 	"time"
 
 	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,12 +20,54 @@ const (
 
 //todoServiceServer is implementation of v1.ToDoServiceServer proto interface
 type todoServiceServer struct {
-	db *sql.DB
+	//root is the repository of all tenants, calls use the repository of their tenant scoped by it
+	root storage.Repository
+
+	//now returns current time for the times set by server
+	now func() time.Time
+
+	//transitions are allowed changes of todo entity status
+	transitions Transitions
+
+	//requireVersion makes Update and Delete fail without the version of todo entity
+	requireVersion bool
+
+	//defaultOwner owns todo entities created by callers without identity
+	defaultOwner string
+
+	//tenantAdmins are the users allowed to manage tenants
+	tenantAdmins map[string]bool
+
+	//spaces are the data kept by server for the tenants by tenant ID, "" is the space of calls without tenant
+	spaces map[string]*space
+
+	//spacesMu guards spaces
+	spacesMu sync.Mutex
+}
+
+//Option configures ToDo service server
+type Option func(*todoServiceServer)
+
+//WithClock replaces time.Now as the source of the times set by server e.g. actual time of completion
+func WithClock(now func() time.Time) Option {
+	return func(s *todoServiceServer) {
+		s.now = now
+	}
 }
 
 //NewToDoServiceServer creates ToDo service server
-func NewToDoServiceServer(db *sql.DB) v1.ToDoServiceServer {
-	return &todoServiceServer{db: db}
+func NewToDoServiceServer(repo storage.Repository, opts ...Option) v1.ToDoServiceServer {
+	s := &todoServiceServer{
+		root:         repo,
+		now:          time.Now,
+		transitions:  DefaultTransitions,
+		defaultOwner: anonymousActor,
+		spaces:       map[string]*space{"": newSpace(repo)},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 //checkAPI checks if the API version requested by client is supported by server
@@ -37,13 +81,27 @@ func (s *todoServiceServer) checkAPI(api string) error {
 	return nil
 }
 
-//connect returns SQL database connection from the pool
-func (s *todoServiceServer) connect(ctx context.Context) (*sql.Conn, error) {
-	c, err := s.db.Conn(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to connect to database -> %s", err.Error())
-	}
-	return c, nil
+//storageError converts error returned by the repository to gRPC status error
+func storageError(err error, id int64) error {
+	switch err {
+	case storage.ErrNotFound:
+		return status.Errorf(codes.NotFound, "ToDo with ID='%d' is not found", id)
+	case storage.ErrAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case storage.ErrParentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case storage.ErrHasChildren:
+		return status.Errorf(codes.FailedPrecondition, "ToDo with ID='%d' has subtasks, delete it in CASCADE or REPARENT mode", id)
+	case storage.ErrCycle, storage.ErrDependencyCycle:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case storage.ErrPrerequisiteNotFound, storage.ErrDependencyNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case storage.ErrDependencyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case storage.ErrVersionMismatch:
+		return status.Errorf(codes.Aborted, "ToDo with ID='%d' was changed since the version was read, read it again", id)
+	}
+	return status.Error(codes.Unknown, err.Error())
 }
 
 //Create creates a new todo entity
@@ -53,39 +111,101 @@ func (s *todoServiceServer) Create(ctx context.Context, req *v1.CreateRequest) (
 		return nil, err
 	}
 
-	//get SQL connection from the connection pool
-	c, err := s.connect(ctx)
+	now := s.now()
+	std, err := s.prepareCreate(ctx, req.ToDo, now)
 	if err != nil {
 		return nil, err
 	}
-	defer c.Close()
+	if err := s.checkQuota(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	//insert todo entity data
+	id, err := s.repo(ctx).Create(ctx, std)
+	if err != nil {
+		return nil, storageError(err, 0)
+	}
+	std.ID = id
+	s.notify(ctx, v1.WatchResponse_CREATED, std, now)
+
+	return &v1.CreateResponse{
+		Api: apiVersion,
+		Id:  id,
+	}, nil
+}
+
+//prepareCreate validates new todo entity and converts it to the stored one created at now
+func (s *todoServiceServer) prepareCreate(ctx context.Context, td *v1.ToDo, now time.Time) (*storage.ToDo, error) {
+	if td == nil {
+		return nil, status.Error(codes.InvalidArgument, "toDo field is required")
+	}
 
-	reminder, err := ptypes.Timestamp(req.ToDo.Reminder)
+	reminder, err := ptypes.Timestamp(td.Reminder)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "reminder field has invalid format -> %s", err.Error())
 	}
 
-	estimatedTimeOfCompletion, err := ptypes.Timestamp(req.ToDo.EstimatedTimeOfCompletion)
+	estimatedTimeOfCompletion, err := ptypes.Timestamp(td.EstimatedTimeOfCompletion)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "estimatedTimeOfCompletion has invalid format -> %s", err.Error())
 	}
 
-	//insert todo entity data
-	res, err := c.ExecContext(ctx, "INSERT INTO ToDo(`Title`,`Description`,`Status`,`EstimatedTimeOfCompletion`,`ActualTimeOfCompletion`,`Reminder`) VALUES (?,?,?,?,?,?)", req.ToDo.Title, req.ToDo.Description, req.ToDo.Status, estimatedTimeOfCompletion, estimatedTimeOfCompletion, reminder)
+	//new todo entity starts in TODO status unless client asked for another one
+	st := td.Status
+	if st == v1.Status_STATUS_UNSPECIFIED {
+		st = v1.Status_TODO
+	}
+	if _, ok := v1.Status_name[int32(st)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "status field has unknown value %d", st)
+	}
+
+	recurrence, err := normalizeRecurrence(td.Recurrence)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to insert into ToDo -> %s", err.Error())
+		return nil, err
 	}
 
-	//get ID of created todo entity
-	id, err := res.LastInsertId()
+	labels, err := normalizeLabels("labels", td.Labels)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to retrieve id for created ToDo -> %s", err.Error())
+		return nil, err
 	}
 
-	return &v1.CreateResponse{
-		Api: apiVersion,
-		Id:  id,
-	}, nil
+	if err := s.checkProject(ctx, td.ProjectId); err != nil {
+		return nil, err
+	}
+
+	//subtask is added only to todo entity the caller may change
+	if td.ParentId != 0 {
+		parent, err := s.repo(ctx).Get(ctx, td.ParentId)
+		if err == storage.ErrNotFound {
+			return nil, storageError(storage.ErrParentNotFound, td.ParentId)
+		}
+		if err != nil {
+			return nil, storageError(err, td.ParentId)
+		}
+		if err := s.checkAccess(ctx, parent, editAccess); err != nil {
+			return nil, err
+		}
+	}
+
+	std := &storage.ToDo{
+		Title:                     td.Title,
+		Description:               td.Description,
+		Status:                    statusToStorage(st),
+		EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+		Reminder:                  reminder,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		Recurrence:                recurrence,
+		ProjectID:                 td.ProjectId,
+		ParentID:                  td.ParentId,
+		Labels:                    labels,
+		Owner:                     s.user(ctx),
+	}
+	//todo entity created as done is completed at creation time
+	if st == v1.Status_DONE {
+		std.ActualTimeOfCompletion = now
+	}
+	return std, nil
 }
 
 //Read reads todo entity
@@ -95,207 +215,504 @@ func (s *todoServiceServer) Read(ctx context.Context, req *v1.ReadRequest) (*v1.
 		return nil, err
 	}
 
-	//get SQL connection from  the connection pool
-	c, err := s.connect(ctx)
+	//query todo entity by ID
+	std, err := s.get(ctx, req.Id, viewAccess)
 	if err != nil {
 		return nil, err
 	}
-	defer c.Close()
 
-	//query todo entity by ID
-	rows, err := c.QueryContext(ctx, "SELECT `ID`,`Title`,`Description`,`Status`,`EstimatedTimeOfCompletion`,`ActualTimeOfCompletion` ,`Reminder` FROM ToDo WHERE `ID`=?", req.Id)
+	td, err := toProto(std)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReadResponse{
+		Api:  apiVersion,
+		ToDo: td,
+	}, nil
+}
+
+//Update updates a todo entity
+func (s *todoServiceServer) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateResponse, error) {
+	//check if the API version requested by the client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	std, completed, err := s.prepareUpdate(ctx, req, now)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to select from ToDo -> %s", err.Error())
+		return nil, err
 	}
-	defer rows.Close()
 
-	if !rows.Next() {
-		if err := rows.Err(); err != nil {
-			return nil, status.Errorf(codes.Unknown, "failed to retrieve data from ToDo -> %s", err.Error())
+	//completed recurring todo entity is followed by the next occurrence,
+	//it is created first so that failed update can be repeated without losing it
+	var nextID int64
+	if completed {
+		if nextID, err = s.createNextOccurrence(ctx, std, now); err != nil {
+			return nil, err
 		}
+	}
 
-		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not found", req.Id)
+	//update todo entity
+	rows, err := s.repo(ctx).Update(ctx, std)
+	if err != nil {
+		if nextID != 0 {
+			_, _ = s.repo(ctx).Delete(ctx, nextID, storage.DeleteRestrict)
+		}
+		return nil, storageError(err, req.ToDo.Id)
+	}
+	s.notify(ctx, v1.WatchResponse_UPDATED, std, now)
+	if nextID != 0 {
+		if created, err := s.repo(ctx).Get(ctx, nextID); err == nil {
+			s.notify(ctx, v1.WatchResponse_CREATED, created, now)
+		}
 	}
 
-	//get todo entity
-	var td v1.ToDo
-	var estimatedTimeOfCompletion time.Time
-	var actualTimeOfCompletion time.Time
-	var reminder time.Time
+	return &v1.UpdateResponse{
+		Api:     apiVersion,
+		Updated: rows,
+		NextId:  nextID,
+		Version: std.Version,
+	}, nil
+}
 
-	if err := rows.Scan(&td.Id, &td.Title, &td.Description, &td.Status, &estimatedTimeOfCompletion, &actualTimeOfCompletion, &reminder); err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to retrieve field values from ToDo row -> %s", err.Error())
+//prepareUpdate validates update of todo entity and returns the stored todo entity with the update applied at now.
+//completed is set when the update completes todo entity.
+func (s *todoServiceServer) prepareUpdate(ctx context.Context, req *v1.UpdateRequest, now time.Time) (std *storage.ToDo, completed bool, err error) {
+	if req.ToDo == nil {
+		return nil, false, status.Error(codes.InvalidArgument, "toDo field is required")
 	}
-	td.EstimatedTimeOfCompletion, err = ptypes.TimestampProto(estimatedTimeOfCompletion)
+
+	fields, err := updateFields(req.UpdateMask)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "estimatedTimeOfCompletion field has invalid format -> %s", err.Error())
+		return nil, false, err
 	}
 
-	td.ActualTimeOfCompletion, err = ptypes.TimestampProto(actualTimeOfCompletion)
+	//fields missing in update mask are kept as they are stored,
+	//without update mask every field set by client is replaced
+	std, err = s.get(ctx, req.ToDo.Id, editAccess)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "actualTimeOfCompletion field has invalid format -> %s", err.Error())
+		return nil, false, err
 	}
 
-	td.Reminder, err = ptypes.TimestampProto(reminder)
+	//update of changed todo entity fails early, the repository checks the version again when it is updated
+	version, err := s.expectedVersion(ctx, req.ToDo.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "reminder field has invalid format -> %s", err.Error())
+		return nil, false, err
+	}
+	if version != 0 && version != std.Version {
+		return nil, false, storageError(storage.ErrVersionMismatch, req.ToDo.Id)
+	}
+	std.Version = version
+	current, project := statusFromStorage(std.Status), std.ProjectID
+	if err := applyFields(std, req.ToDo, fields); err != nil {
+		return nil, false, err
 	}
 
-	if rows.Next() {
-		return nil, status.Errorf(codes.Unknown, "found multiple ToDo rows with ID='%d'", req.Id)
+	//todo entity is moved only to existing project which is not archived
+	if std.ProjectID != project {
+		if err := s.checkProject(ctx, std.ProjectID); err != nil {
+			return nil, false, err
+		}
 	}
 
-	return &v1.ReadResponse{
-		Api:  apiVersion,
-		ToDo: &td,
-	}, nil
+	//status is changed only by allowed transitions
+	next := statusFromStorage(std.Status)
+	if next != current {
+		if err := s.transitions.checkTransition(current, next); err != nil {
+			return nil, false, err
+		}
+	}
+
+	//todo entity is completed only after its prerequisites
+	if next == v1.Status_DONE && current != v1.Status_DONE {
+		if err := s.checkPrerequisites(ctx, std.ID); err != nil {
+			return nil, false, err
+		}
+	}
+
+	std.UpdatedAt = now
+	switch {
+	case next != v1.Status_DONE:
+		//todo entity is not completed or it is reopened
+		std.ActualTimeOfCompletion = time.Time{}
+	case std.ActualTimeOfCompletion.IsZero():
+		//todo entity is completed right now
+		std.ActualTimeOfCompletion = now
+	}
+	return std, next == v1.Status_DONE && current != v1.Status_DONE, nil
 }
 
-//Update updates a todo entity
-func (s *todoServiceServer) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateResponse, error) {
+//createNextOccurrence creates the occurrence following completed recurring todo entity and returns its ID.
+//0 is returned when todo entity does not repeat or the occurrence already exists
+//because the same occurrence was completed before.
+func (s *todoServiceServer) createNextOccurrence(ctx context.Context, std *storage.ToDo, now time.Time) (int64, error) {
+	next, err := nextOccurrence(std, now)
+	if err != nil || next == nil {
+		return 0, err
+	}
+	if err := s.checkQuota(ctx, 1); err != nil {
+		return 0, err
+	}
+
+	id, err := s.repo(ctx).Create(ctx, next)
+	if err == storage.ErrAlreadyExists {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, storageError(err, 0)
+	}
+	return id, nil
+}
+
+//Delete moves a todo entity to the trash
+func (s *todoServiceServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.DeleteResponse, error) {
 	//check if the API version requested by the client is supported by server
 	if err := s.checkAPI(req.Api); err != nil {
 		return nil, err
 	}
 
-	//get SQL connection from the connection pool
-	c, err := s.connect(ctx)
+	mode, err := deleteMode(req.Mode)
 	if err != nil {
 		return nil, err
 	}
-	defer c.Close()
-
-	var actualTimeOfCompletion time.Time
-
-	estimatedTimeOfCompletion, err := ptypes.Timestamp(req.ToDo.EstimatedTimeOfCompletion)
+	version, err := s.expectedVersion(ctx, req.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "estimatedTimeOfCompletion field has invalid format -> %s", err.Error())
+		return nil, err
+	}
+	std, subtasks, err := s.deleteTargets(ctx, req.Id, mode)
+	if err != nil {
+		return nil, err
 	}
 
-	reminder, err := ptypes.Timestamp(req.ToDo.Reminder)
+	//move todo entity to the trash
+	now := s.now()
+	rows, err := s.repo(ctx).Trash(ctx, req.Id, version, mode, now)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "reminder field has invalid format -> %s", err.Error())
+		return nil, storageError(err, req.Id)
 	}
+	s.notifyDelete(ctx, std, subtasks, mode, now)
 
-	if req.ToDo.Status == "Completed" {
-		actualTimeOfCompletion = time.Date(2020, 2, 27, 3, 15, 45, 34567, time.UTC)
-	} else {
-		actualTimeOfCompletion, err = ptypes.Timestamp(req.ToDo.ActualTimeOfCompletion)
+	return &v1.DeleteResponse{
+		Api:     apiVersion,
+		Deleted: rows,
+	}, nil
+}
+
+//deleteMode converts delete mode of the request to the repository one
+func deleteMode(mode v1.DeleteRequest_Mode) (storage.DeleteMode, error) {
+	switch mode {
+	case v1.DeleteRequest_MODE_UNSPECIFIED:
+		return storage.DeleteRestrict, nil
+	case v1.DeleteRequest_CASCADE:
+		return storage.DeleteCascade, nil
+	case v1.DeleteRequest_REPARENT:
+		return storage.DeleteReparent, nil
+	}
+	return 0, status.Errorf(codes.InvalidArgument, "mode field has unknown value %d", mode)
+}
+
+//deleteTargets reads todo entity to delete and its subtasks deleted or moved by the mode
+//so that they can be sent to watchers after the delete
+func (s *todoServiceServer) deleteTargets(ctx context.Context, id int64, mode storage.DeleteMode) (*storage.ToDo, []*storage.ToDo, error) {
+	std, err := s.get(ctx, id, ownAccess)
+	if err != nil {
+		return nil, nil, err
+	}
+	var subtasks []*storage.ToDo
+	switch mode {
+	case storage.DeleteCascade:
+		subtasks, err = s.repo(ctx).Descendants(ctx, id)
+	case storage.DeleteReparent:
+		subtasks, err = s.repo(ctx).List(ctx, storage.ListOptions{ParentID: id})
 	}
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "actualTimeOfCompletion field has invalid format -> %s", err.Error())
+		return nil, nil, storageError(err, id)
 	}
+	return std, subtasks, nil
+}
 
-	//update todo entity
-	res, err := c.ExecContext(ctx, "UPDATE ToDo SET `Title`=?, `Description`=?, `Status`=?, `EstimatedTimeOfCompletion`=?, `ActualTimeOfCompletion`=?,`Reminder`=? WHERE `ID`=?", req.ToDo.Title, req.ToDo.Description, req.ToDo.Status, estimatedTimeOfCompletion, actualTimeOfCompletion, reminder, req.ToDo.Id)
+//notifyDelete sends todo entity and its subtasks moved to the trash at now and its moved subtasks to watchers
+func (s *todoServiceServer) notifyDelete(ctx context.Context, std *storage.ToDo, subtasks []*storage.ToDo, mode storage.DeleteMode, now time.Time) {
+	std.DeletedAt = now
+	s.notify(ctx, v1.WatchResponse_DELETED, std, now)
+	for _, sub := range subtasks {
+		if mode == storage.DeleteCascade {
+			sub.DeletedAt = now
+			s.notify(ctx, v1.WatchResponse_DELETED, sub, now)
+			continue
+		}
+		sub.ParentID = std.ParentID
+		s.notify(ctx, v1.WatchResponse_UPDATED, sub, now)
+	}
+}
+
+//ReadAll reads a page of todo entities
+func (s *todoServiceServer) ReadAll(ctx context.Context, req *v1.ReadAllRequest) (*v1.ReadAllResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	opts, pageSize, fingerprint, err := listOptions(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to update ToDo -> %s", err.Error())
+		return nil, err
 	}
 
-	rows, err := res.RowsAffected()
+	//tasks of archived project are listed too
+	if opts.ProjectID != 0 {
+		if _, err := s.repo(ctx).GetProject(ctx, opts.ProjectID); err != nil {
+			return nil, projectError(err, opts.ProjectID)
+		}
+	}
+
+	//get one todo entity more than requested to find out if there is the next page,
+	//only todo entities of the caller and shared with the caller are listed
+	opts.Limit = pageSize + 1
+	opts.VisibleTo = s.user(ctx)
+	stds, err := s.repo(ctx).List(ctx, opts)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to retrieve rows affected value -> %s", err.Error())
+		return nil, storageError(err, 0)
 	}
-	if rows == 0 {
-		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not found", req.ToDo.Id)
+
+	var nextPageToken string
+	if len(stds) > pageSize {
+		stds = stds[:pageSize]
+		nextPageToken = encodePageToken(stds[pageSize-1], opts.SortBy, fingerprint)
 	}
-	return &v1.UpdateResponse{
-		Api:     apiVersion,
-		Updated: rows,
+
+	list := []*v1.ToDo{}
+	for _, std := range stds {
+		td, err := toProto(std)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+	}
+
+	return &v1.ReadAllResponse{
+		Api:           apiVersion,
+		ToDos:         list,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-//Delete deleted a todo entity
-func (s *todoServiceServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.DeleteResponse, error) {
-	//check if the API version requested by the client is supported by server
+//ListCompletions reads completion history of todo entity
+func (s *todoServiceServer) ListCompletions(ctx context.Context, req *v1.ListCompletionsRequest) (*v1.ListCompletionsResponse, error) {
+	//check if the API version requested by client is supported by server
 	if err := s.checkAPI(req.Api); err != nil {
 		return nil, err
 	}
 
-	//get SQL connection from the connection pool
-	c, err := s.connect(ctx)
+	//creation time of todo entity is the start of cycle time
+	std, err := s.get(ctx, req.Id, viewAccess)
 	if err != nil {
 		return nil, err
 	}
 
-	defer c.Close()
-
-	//delete todo entity
-	res, err := c.ExecContext(ctx, "DELETE FROM ToDo WHERE `ID`=?", req.Id)
+	completions, err := s.repo(ctx).Completions(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to delete ToDo -> %s", err.Error())
+		return nil, storageError(err, req.Id)
 	}
 
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to retrieve rows affected value -> %s", err.Error())
-	}
-	if rows == 0 {
-		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not found", req.Id)
+	list := []*v1.Completion{}
+	for _, c := range completions {
+		completion := &v1.Completion{}
+		if completion.CompletedAt, err = timestampProto("completed_at", c.CompletedAt); err != nil {
+			return nil, err
+		}
+		if completion.ReopenedAt, err = timestampProto("reopened_at", c.ReopenedAt); err != nil {
+			return nil, err
+		}
+		if !std.CreatedAt.IsZero() {
+			completion.CycleTime = ptypes.DurationProto(c.CompletedAt.Sub(std.CreatedAt))
+		}
+		list = append(list, completion)
 	}
-	return &v1.DeleteResponse{
-		Api:     apiVersion,
-		Deleted: rows,
+
+	return &v1.ListCompletionsResponse{
+		Api:         apiVersion,
+		Completions: list,
 	}, nil
 }
 
-//Read all todo entities
-func (s *todoServiceServer) ReadAll(ctx context.Context, req *v1.ReadAllRequest) (*v1.ReadAllResponse, error) {
+//ListOccurrences expands occurrences of todo entity in the time window
+func (s *todoServiceServer) ListOccurrences(ctx context.Context, req *v1.ListOccurrencesRequest) (*v1.ListOccurrencesResponse, error) {
 	//check if the API version requested by client is supported by server
 	if err := s.checkAPI(req.Api); err != nil {
 		return nil, err
 	}
 
-	//get SQL connection from the connection pool
-	c, err := s.connect(ctx)
-
+	start, end, limit, err := occurrenceWindow(req, s.now())
 	if err != nil {
 		return nil, err
 	}
-	defer c.Close()
 
-	//get todo entity list
-	rows, err := c.QueryContext(ctx, "SELECT `ID`,`Title`,`Description`,`Status`,`EstimatedTimeOfCompletion`,`ActualTimeOfCompletion`,`Reminder` FROM ToDo")
+	std, err := s.get(ctx, req.Id, viewAccess)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to select from ToDo -> %s", err.Error())
+		return nil, err
 	}
-	defer rows.Close()
 
-	var estimatedTimeOfCompletion time.Time
-	var actualTimeOfCompletion time.Time
-	var reminder time.Time
+	list := []*v1.Occurrence{}
+	if std.EstimatedTimeOfCompletion.IsZero() {
+		return &v1.ListOccurrencesResponse{Api: apiVersion, Occurrences: list}, nil
+	}
 
-	list := []*v1.ToDo{}
-	for rows.Next() {
-		td := new(v1.ToDo) //pointer to an empty todo struct initialized to default zero values of its respective fields
-		if err := rows.Scan(&td.Id, &td.Title, &td.Description, &td.Status, &estimatedTimeOfCompletion, &actualTimeOfCompletion, &reminder); err != nil {
-			return nil, status.Errorf(codes.Unknown, "failed to retrieve field values from ToDo row -> %s", err.Error())
-		}
+	r, err := storedRecurrence(std)
+	if err != nil {
+		return nil, err
+	}
 
-		td.EstimatedTimeOfCompletion, err = ptypes.TimestampProto(estimatedTimeOfCompletion)
-		if err != nil {
-			return nil, status.Errorf(codes.Unknown, "estimatedTimeOfCompletion field has invalid format -> %s", err.Error())
+	for _, etc := range r.occurrences(std.EstimatedTimeOfCompletion, start, end, limit) {
+		o := &v1.Occurrence{}
+		if o.EstimatedTimeOfCompletion, err = timestampProto("estimatedTimeOfCompletion", etc); err != nil {
+			return nil, err
 		}
+		if !std.Reminder.IsZero() {
+			if o.Reminder, err = timestampProto("reminder", std.Reminder.Add(etc.Sub(std.EstimatedTimeOfCompletion))); err != nil {
+				return nil, err
+			}
+		}
+		list = append(list, o)
+	}
 
-		td.ActualTimeOfCompletion, err = ptypes.TimestampProto(actualTimeOfCompletion)
-		if err != nil {
-			return nil, status.Errorf(codes.Unknown, "actualTimeOfCompletion field has invalid format -> %s", err.Error())
+	return &v1.ListOccurrencesResponse{
+		Api:         apiVersion,
+		Occurrences: list,
+	}, nil
+}
+
+//Watch streams changes of todo entities made after the call or after the resume token
+func (s *todoServiceServer) Watch(req *v1.WatchRequest, stream v1.ToDoService_WatchServer) error {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return err
+	}
+
+	//changes are watched within the tenant of the call
+	ctx := stream.Context()
+	events := s.space(ctx).events
+	w, missed, err := events.subscribe(req.ResumeToken)
+	if err != nil {
+		return err
+	}
+	defer events.unsubscribe(w)
+
+	//send changes client missed since the resume token
+	for _, e := range missed {
+		if err := s.sendEvent(ctx, stream, events, e); err != nil {
+			return err
 		}
+	}
 
-		td.Reminder, err = ptypes.TimestampProto(reminder)
-		if err != nil {
-			return nil, status.Errorf(codes.Unknown, "reminder field has invalid format -> %s", err.Error())
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case e, ok := <-w.events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watch stream fell behind the changes, watch again with the last resume_token")
+			}
+			if err := s.sendEvent(ctx, stream, events, e); err != nil {
+				return err
+			}
 		}
+	}
+}
 
-		list = append(list, td)
+//sendEvent sends change to Watch caller unless the caller may not read the todo entity
+func (s *todoServiceServer) sendEvent(ctx context.Context, stream v1.ToDoService_WatchServer, events *eventBroker, e *event) error {
+	granted, err := s.access(ctx, &storage.ToDo{ID: e.td.Id, Owner: e.td.Owner}, s.user(ctx))
+	if err != nil {
+		return err
+	}
+	if granted < viewAccess {
+		return nil
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to retrieve data from ToDo-> %s", err.Error())
+	tm, err := timestampProto("time", e.time)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&v1.WatchResponse{
+		Api:         apiVersion,
+		Type:        e.typ,
+		ToDo:        e.td,
+		ResumeToken: events.resumeToken(e),
+		Time:        tm,
+	})
+}
+
+//notify publishes change of todo entity made by the call to watchers, keeps the search index up to date
+//and records the change in the history
+func (s *todoServiceServer) notify(ctx context.Context, typ v1.WatchResponse_EventType, std *storage.ToDo, tm time.Time) {
+	s.publish(ctx, typ, std, tm)
+	s.record(ctx, historyActions[typ], std, tm)
+}
+
+//publish publishes change of todo entity to watchers of the tenant and keeps its search index up to date
+func (s *todoServiceServer) publish(ctx context.Context, typ v1.WatchResponse_EventType, std *storage.ToDo, tm time.Time) {
+	sp := s.space(ctx)
+	if typ == v1.WatchResponse_DELETED {
+		sp.index.Remove(std.ID)
+	} else {
+		sp.index.Put(document(std))
 	}
 
-	return &v1.ReadAllResponse{
-		Api:   apiVersion,
-		ToDos: list,
-	}, nil
+	td, err := toProto(std)
+	if err != nil {
+		//todo entity which can't be converted is not readable by clients either
+		return
+	}
+	sp.events.publish(typ, td, tm)
+}
+
+//toProto converts stored todo entity to its API representation
+func toProto(std *storage.ToDo) (*v1.ToDo, error) {
+	var err error
+	td := &v1.ToDo{
+		Id:          std.ID,
+		Title:       std.Title,
+		Description: std.Description,
+		Status:      statusFromStorage(std.Status),
+	}
+
+	if td.EstimatedTimeOfCompletion, err = timestampProto("estimatedTimeOfCompletion", std.EstimatedTimeOfCompletion); err != nil {
+		return nil, err
+	}
+	if td.ActualTimeOfCompletion, err = timestampProto("actualTimeOfCompletion", std.ActualTimeOfCompletion); err != nil {
+		return nil, err
+	}
+	if td.Reminder, err = timestampProto("reminder", std.Reminder); err != nil {
+		return nil, err
+	}
+	if td.CreatedAt, err = timestampProto("createdAt", std.CreatedAt); err != nil {
+		return nil, err
+	}
+	if td.UpdatedAt, err = timestampProto("updatedAt", std.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if td.DeletedAt, err = timestampProto("deletedAt", std.DeletedAt); err != nil {
+		return nil, err
+	}
+	td.Recurrence = std.Recurrence
+	td.ProjectId = std.ProjectID
+	td.ParentId = std.ParentID
+	td.Labels = std.Labels
+	td.Version = std.Version
+	td.Owner = std.Owner
+	return td, nil
+}
+
+//timestampProto converts stored time to timestamp, zero time is not set
+func timestampProto(name string, t time.Time) (*timestamp.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%s field has invalid format -> %s", name, err.Error())
+	}
+	return ts, nil
 }
@@ -3,45 +3,152 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
-	sqlMock "github.com/DATA-DOG/go-sqlmock"
 	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func TestToDoServiceServerCreate(t *testing.T) {
-	ctx := context.Background()
-	db, mock, err := sqlMock.New()
+//fakeRepository is storage.Repository stub which behaviour is defined by every test case.
+//Methods not used by the service are not implemented.
+type fakeRepository struct {
+	storage.Repository
+
+	create func(td *storage.ToDo) (int64, error)
+	get    func(id int64) (*storage.ToDo, error)
+	update func(td *storage.ToDo) (int64, error)
+	trash  func(id int64) (int64, error)
+	list   func(opts storage.ListOptions) ([]*storage.ToDo, error)
+
+	completions   func(id int64) ([]*storage.Completion, error)
+	prerequisites func(id int64) ([]*storage.ToDo, error)
+}
+
+func (r *fakeRepository) Create(ctx context.Context, td *storage.ToDo) (int64, error) {
+	return r.create(td)
+}
+
+func (r *fakeRepository) Get(ctx context.Context, id int64) (*storage.ToDo, error) {
+	return r.get(id)
+}
+
+func (r *fakeRepository) Update(ctx context.Context, td *storage.ToDo) (int64, error) {
+	return r.update(td)
+}
+
+func (r *fakeRepository) Trash(ctx context.Context, id int64, version int64, mode storage.DeleteMode, deletedAt time.Time) (int64, error) {
+	return r.trash(id)
+}
+
+func (r *fakeRepository) AddHistory(ctx context.Context, e *storage.HistoryEntry) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeRepository) List(ctx context.Context, opts storage.ListOptions) ([]*storage.ToDo, error) {
+	return r.list(opts)
+}
+
+func (r *fakeRepository) Completions(ctx context.Context, id int64) ([]*storage.Completion, error) {
+	return r.completions(id)
+}
+
+//ShareRole returns no role, so the caller may access only its own todo entities
+func (r *fakeRepository) ShareRole(ctx context.Context, id int64, user string) (storage.ShareRole, error) {
+	return "", nil
+}
+
+//Prerequisites returns no prerequisites unless the test case defines them
+func (r *fakeRepository) Prerequisites(ctx context.Context, id int64) ([]*storage.ToDo, error) {
+	if r.prerequisites == nil {
+		return []*storage.ToDo{}, nil
+	}
+	return r.prerequisites(id)
+}
+
+//fixedClock returns clock which always returns the time
+func fixedClock(tm time.Time) Option {
+	return WithClock(func() time.Time { return tm })
+}
+
+//testServer is ToDo service over empty in-memory repository with clock fixed at the time,
+//tests create the todo entities their cases work with by it
+type testServer struct {
+	*todoServiceServer
+
+	//ts is the fixed time, it is estimated time of completion and reminder of created todo entities
+	ts *timestamp.Timestamp
+}
+
+//newTestServer creates service with clock fixed at the time and the options over empty in-memory repository
+func newTestServer(tm time.Time, opts ...Option) *testServer {
+	ts, _ := ptypes.TimestampProto(tm)
+	return &testServer{
+		todoServiceServer: NewToDoServiceServer(memory.NewToDoRepository(), append([]Option{fixedClock(tm)}, opts...)...).(*todoServiceServer),
+		ts:                ts,
+	}
+}
+
+//create creates todo entity, it is due and reminded at the fixed time unless it sets the times
+func (s *testServer) create(ctx context.Context, td *v1.ToDo) (int64, error) {
+	if td.EstimatedTimeOfCompletion == nil {
+		td.EstimatedTimeOfCompletion = s.ts
+	}
+	if td.Reminder == nil {
+		td.Reminder = s.ts
+	}
+	res, err := s.Create(ctx, &v1.CreateRequest{Api: apiVersion, ToDo: td})
+	return res.GetId(), err
+}
+
+//mustCreate creates todo entity and stops the test when it fails
+func (s *testServer) mustCreate(t *testing.T, ctx context.Context, td *v1.ToDo) int64 {
+	t.Helper()
+	id, err := s.create(ctx, td)
 	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		t.Fatalf("toDoServiceServer.Create() error = %v", err)
+	}
+	return id
+}
+
+//toDoIDs returns IDs of the todo entities in their order
+func toDoIDs(list []*v1.ToDo) []int64 {
+	ids := []int64{}
+	for _, td := range list {
+		ids = append(ids, td.Id)
 	}
-	defer db.Close()
+	return ids
+}
 
-	s := NewToDoServiceServer(db)
+func TestToDoServiceServerCreate(t *testing.T) {
+	ctx := context.Background()
 	tm := time.Now().In(time.UTC)
 	reminder, _ := ptypes.TimestampProto(tm)
 	estimatedTimeOfCompletion, _ := ptypes.TimestampProto(tm)
 	actualTimeOfCompletion, _ := ptypes.TimestampProto(tm)
+	now := time.Date(2020, 2, 27, 3, 15, 45, 34567, time.UTC)
 
 	type args struct {
 		ctx context.Context
 		req *v1.CreateRequest
 	}
 	tests := []struct {
-		name    string
-		s       v1.ToDoServiceServer
-		args    args
-		mock    func()
-		want    *v1.CreateResponse
-		wantErr bool
+		name     string
+		args     args
+		repo     fakeRepository
+		want     *v1.CreateResponse
+		wantCode codes.Code
 	}{
 		{
 			name: "OK",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.CreateRequest{
@@ -49,24 +156,101 @@ func TestToDoServiceServerCreate(t *testing.T) {
 					ToDo: &v1.ToDo{
 						Title:                     "title",
 						Description:               "description",
-						Status:                    "status",
+						Status:                    v1.Status_IN_PROGRESS,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
 						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("INSERT INTO ToDo").WithArgs("title", "description", "status", tm, tm, tm).WillReturnResult(sqlMock.NewResult(1, 1))
+			repo: fakeRepository{
+				create: func(td *storage.ToDo) (int64, error) {
+					want := &storage.ToDo{
+						Title:                     "title",
+						Description:               "description",
+						Status:                    "IN_PROGRESS",
+						EstimatedTimeOfCompletion: tm,
+						Reminder:                  tm,
+						CreatedAt:                 now,
+						UpdatedAt:                 now,
+						Owner:                     anonymousActor,
+					}
+					if !reflect.DeepEqual(td, want) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
 			},
 			want: &v1.CreateResponse{
 				Api: "v1",
 				Id:  1,
 			},
 		},
+		{
+			name: "Completed",
+			args: args{
+				ctx: ctx,
+				req: &v1.CreateRequest{
+					Api: "v1",
+					ToDo: &v1.ToDo{
+						Title:                     "title",
+						Status:                    v1.Status_DONE,
+						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+						Reminder:                  reminder,
+					},
+				},
+			},
+			repo: fakeRepository{
+				create: func(td *storage.ToDo) (int64, error) {
+					want := &storage.ToDo{
+						Title:                     "title",
+						Status:                    "DONE",
+						EstimatedTimeOfCompletion: tm,
+						ActualTimeOfCompletion:    now,
+						Reminder:                  tm,
+						CreatedAt:                 now,
+						UpdatedAt:                 now,
+						Owner:                     anonymousActor,
+					}
+					if !reflect.DeepEqual(td, want) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 2, nil
+				},
+			},
+			want: &v1.CreateResponse{
+				Api: "v1",
+				Id:  2,
+			},
+		},
+		{
+			name: "Default status",
+			args: args{
+				ctx: ctx,
+				req: &v1.CreateRequest{
+					Api: "v1",
+					ToDo: &v1.ToDo{
+						Title:                     "title",
+						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
+						Reminder:                  reminder,
+					},
+				},
+			},
+			repo: fakeRepository{
+				create: func(td *storage.ToDo) (int64, error) {
+					if td.Status != "TODO" {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 3, nil
+				},
+			},
+			want: &v1.CreateResponse{
+				Api: "v1",
+				Id:  3,
+			},
+		},
 		{
 			name: "Unsupported API",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.CreateRequest{
@@ -78,10 +262,6 @@ func TestToDoServiceServerCreate(t *testing.T) {
 							Seconds: 3,
 							Nanos:   2,
 						},
-						ActualTimeOfCompletion: &timestamp.Timestamp{
-							Seconds: 3,
-							Nanos:   2,
-						},
 						Reminder: &timestamp.Timestamp{
 							Seconds: 1,
 							Nanos:   -1,
@@ -89,12 +269,10 @@ func TestToDoServiceServerCreate(t *testing.T) {
 					},
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.Unimplemented,
 		},
 		{
 			name: "Invalid Reminder field format",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.CreateRequest{
@@ -113,33 +291,30 @@ func TestToDoServiceServerCreate(t *testing.T) {
 					},
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.InvalidArgument,
 		},
 		{
-			name: "INSERT FAILED",
-			s:    s,
+			name: "Title already exists",
 			args: args{
 				ctx: ctx,
 				req: &v1.CreateRequest{
 					Api: "v1",
 					ToDo: &v1.ToDo{
 						Title:                     "title",
-						Description:               "desription",
-						Status:                    "status",
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("INSERT INTO ToDo").WithArgs("title", "description", "status", tm, tm).WillReturnError(errors.New("INSERT failed"))
+			repo: fakeRepository{
+				create: func(td *storage.ToDo) (int64, error) {
+					return 0, storage.ErrAlreadyExists
+				},
 			},
-			wantErr: true,
+			wantCode: codes.AlreadyExists,
 		},
 		{
-			name: "LastInsertId failed",
-			s:    s,
+			name: "Create failed",
 			args: args{
 				ctx: ctx,
 				req: &v1.CreateRequest{
@@ -147,25 +322,26 @@ func TestToDoServiceServerCreate(t *testing.T) {
 					ToDo: &v1.ToDo{
 						Title:                     "title",
 						Description:               "description",
-						Status:                    "status",
+						Status:                    v1.Status_IN_PROGRESS,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("INSERT INTO ToDo").WithArgs("title", "description", "status", tm, tm).WillReturnResult(sqlMock.NewErrorResult(errors.New("LastInsertId failed")))
+			repo: fakeRepository{
+				create: func(td *storage.ToDo) (int64, error) {
+					return 0, errors.New("INSERT failed")
+				},
 			},
-			wantErr: true,
+			wantCode: codes.Unknown,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mock()
-			got, err := tt.s.Create(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("toDoServiceServer.Create() error = %v, wantErr %v", err, tt.wantErr)
+			s := NewToDoServiceServer(&tt.repo, fixedClock(now))
+			got, err := s.Create(tt.args.ctx, tt.args.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("toDoServiceServer.Create() error = %v, wantCode %v", err, tt.wantCode)
 				return
 			}
 			if err == nil && !reflect.DeepEqual(got, tt.want) {
@@ -173,17 +349,10 @@ func TestToDoServiceServerCreate(t *testing.T) {
 			}
 		})
 	}
-
 }
 
 func TestToDoServiceServerRead(t *testing.T) {
 	ctx := context.Background()
-	db, mock, err := sqlMock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-	s := NewToDoServiceServer(db)
 	tm := time.Now().In(time.UTC)
 	reminder, _ := ptypes.TimestampProto(tm)
 	estimatedTimeOfCompletion, _ := ptypes.TimestampProto(tm)
@@ -194,16 +363,14 @@ func TestToDoServiceServerRead(t *testing.T) {
 		req *v1.ReadRequest
 	}
 	tests := []struct {
-		name    string
-		s       v1.ToDoServiceServer
-		args    args
-		mock    func()
-		want    *v1.ReadResponse
-		wantErr bool
+		name     string
+		args     args
+		repo     fakeRepository
+		want     *v1.ReadResponse
+		wantCode codes.Code
 	}{
 		{
 			name: "OK",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadRequest{
@@ -211,9 +378,19 @@ func TestToDoServiceServerRead(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				rows := sqlMock.NewRows([]string{"ID", "Title", "Description", "Status", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder"}).AddRow(1, "title", "description", "status", tm, tm, tm)
-				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1).WillReturnRows(rows)
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return &storage.ToDo{
+						ID:                        id,
+						Title:                     "title",
+						Description:               "description",
+						Status:                    "IN_PROGRESS",
+						EstimatedTimeOfCompletion: tm,
+						ActualTimeOfCompletion:    tm,
+						Reminder:                  tm,
+						Owner:                     anonymousActor,
+					}, nil
+				},
 			},
 			want: &v1.ReadResponse{
 				Api: "v1",
@@ -221,16 +398,16 @@ func TestToDoServiceServerRead(t *testing.T) {
 					Id:                        1,
 					Title:                     "title",
 					Description:               "description",
-					Status:                    "status",
+					Status:                    v1.Status_IN_PROGRESS,
 					EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
 					ActualTimeOfCompletion:    actualTimeOfCompletion,
 					Reminder:                  reminder,
+					Owner:                     anonymousActor,
 				},
 			},
 		},
 		{
 			name: "Unsupported API",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadRequest{
@@ -238,12 +415,10 @@ func TestToDoServiceServerRead(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.Unimplemented,
 		},
 		{
-			name: "SELECT failed",
-			s:    s,
+			name: "Get failed",
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadRequest{
@@ -251,14 +426,15 @@ func TestToDoServiceServerRead(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1).WillReturnError(errors.New("SELECT failed"))
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return nil, errors.New("SELECT failed")
+				},
 			},
-			wantErr: true,
+			wantCode: codes.Unknown,
 		},
 		{
 			name: "Not found",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadRequest{
@@ -266,23 +442,24 @@ func TestToDoServiceServerRead(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				rows := sqlMock.NewRows([]string{"ID", "Title", "Description", "Status", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder"})
-				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1).WillReturnRows(rows)
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return nil, storage.ErrNotFound
+				},
 			},
-			wantErr: true,
+			wantCode: codes.NotFound,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mock()
-			got, err := tt.s.Read(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("toDoserviceServer.Read() error = %v, wantErr %v", err, tt.wantErr)
+			s := NewToDoServiceServer(&tt.repo)
+			got, err := s.Read(tt.args.ctx, tt.args.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("toDoserviceServer.Read() error = %v, wantCode %v", err, tt.wantCode)
 				return
 			}
 			if err == nil && !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("toDoServiceServer.Read() error = %v, want %v", got, tt.want)
+				t.Errorf("toDoServiceServer.Read() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -290,17 +467,30 @@ func TestToDoServiceServerRead(t *testing.T) {
 
 func TestToDoServiceUpdate(t *testing.T) {
 	ctx := context.Background()
-	db, mock, err := sqlMock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-	s := NewToDoServiceServer(db)
 	tm := time.Now().In(time.UTC)
-	atc := time.Date(2020, 2, 27, 3, 15, 45, 34567, time.UTC)
+	now := time.Date(2020, 2, 27, 3, 15, 45, 34567, time.UTC)
+	later := tm.Add(time.Hour)
 	reminder, _ := ptypes.TimestampProto(tm)
 	estimatedTimeOfCompletion, _ := ptypes.TimestampProto(tm)
-	actualTimeOfCompletion, _ := ptypes.TimestampProto(atc)
+	laterReminder, _ := ptypes.TimestampProto(later)
+
+	//get returns stored todo entity with the status and actual time of completion
+	get := func(status string, actualTimeOfCompletion time.Time) func(id int64) (*storage.ToDo, error) {
+		return func(id int64) (*storage.ToDo, error) {
+			return &storage.ToDo{
+				ID:                        id,
+				Title:                     "title",
+				Description:               "description",
+				Status:                    status,
+				EstimatedTimeOfCompletion: tm,
+				ActualTimeOfCompletion:    actualTimeOfCompletion,
+				Reminder:                  tm,
+				CreatedAt:                 tm,
+				UpdatedAt:                 tm,
+				Owner:                     anonymousActor,
+			}, nil
+		}
+	}
 
 	type args struct {
 		ctx context.Context
@@ -308,16 +498,14 @@ func TestToDoServiceUpdate(t *testing.T) {
 	}
 
 	tests := []struct {
-		name    string
-		s       v1.ToDoServiceServer
-		args    args
-		mock    func()
-		want    *v1.UpdateResponse
-		wantErr bool
+		name     string
+		args     args
+		repo     fakeRepository
+		want     *v1.UpdateResponse
+		wantCode codes.Code
 	}{
 		{
 			name: "OK",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
@@ -326,15 +514,81 @@ func TestToDoServiceUpdate(t *testing.T) {
 						Id:                        1,
 						Title:                     "new title",
 						Description:               "new description",
-						Status:                    "Completed",
+						Status:                    v1.Status_DONE,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
+						ActualTimeOfCompletion:    laterReminder,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("UPDATE ToDo").WithArgs("new title", "new description", "Completed", tm, atc, tm, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+				update: func(td *storage.ToDo) (int64, error) {
+					want := &storage.ToDo{
+						ID:                        1,
+						Title:                     "new title",
+						Description:               "new description",
+						Status:                    "DONE",
+						EstimatedTimeOfCompletion: tm,
+						ActualTimeOfCompletion:    now,
+						Reminder:                  tm,
+						CreatedAt:                 tm,
+						UpdatedAt:                 now,
+						Owner:                     anonymousActor,
+					}
+					if !reflect.DeepEqual(td, want) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
+			},
+			want: &v1.UpdateResponse{
+				Api:     apiVersion,
+				Updated: 1,
+			},
+		},
+		{
+			name: "Already completed",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1, Description: "new description"},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"description"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("DONE", tm),
+				update: func(td *storage.ToDo) (int64, error) {
+					if td.Description != "new description" || !td.ActualTimeOfCompletion.Equal(tm) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
+			},
+			want: &v1.UpdateResponse{
+				Api:     apiVersion,
+				Updated: 1,
+			},
+		},
+		{
+			name: "Reopened",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1, Status: v1.Status_IN_PROGRESS},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("DONE", tm),
+				update: func(td *storage.ToDo) (int64, error) {
+					if td.Status != "IN_PROGRESS" || !td.ActualTimeOfCompletion.IsZero() || !td.UpdatedAt.Equal(now) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
 			},
 			want: &v1.UpdateResponse{
 				Api:     apiVersion,
@@ -343,28 +597,24 @@ func TestToDoServiceUpdate(t *testing.T) {
 		},
 		{
 			name: "Unsupported API",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
-					Api: apiVersion,
+					Api: "v1000",
 					ToDo: &v1.ToDo{
 						Id:                        1,
 						Title:                     "new title",
 						Description:               "new description",
-						Status:                    "Started",
+						Status:                    v1.Status_IN_PROGRESS,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.Unimplemented,
 		},
 		{
 			name: "Invalid Reminder field format",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
@@ -373,15 +623,11 @@ func TestToDoServiceUpdate(t *testing.T) {
 						Id:          1,
 						Title:       "new title",
 						Description: "new description",
-						Status:      "Completed",
+						Status:      v1.Status_DONE,
 						EstimatedTimeOfCompletion: &timestamp.Timestamp{
 							Seconds: 1,
 							Nanos:   -1,
 						},
-						ActualTimeOfCompletion: &timestamp.Timestamp{
-							Seconds: 1,
-							Nanos:   -1,
-						},
 						Reminder: &timestamp.Timestamp{
 							Seconds: 1,
 							Nanos:   -1,
@@ -389,12 +635,13 @@ func TestToDoServiceUpdate(t *testing.T) {
 					},
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+			},
+			wantCode: codes.InvalidArgument,
 		},
 		{
-			name: "UPDATE failed",
-			s:    s,
+			name: "Update failed",
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
@@ -403,71 +650,201 @@ func TestToDoServiceUpdate(t *testing.T) {
 						Id:                        1,
 						Title:                     "new title",
 						Description:               "new description",
-						Status:                    "Completed",
+						Status:                    v1.Status_DONE,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("UPDATE ToDo").WithArgs("new title", "new description", "Completed", tm, atc, tm, 1).WillReturnError(errors.New("UPDATE failed"))
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+				update: func(td *storage.ToDo) (int64, error) {
+					return 0, errors.New("UPDATE failed")
+				},
 			},
-			wantErr: true,
+			wantCode: codes.Unknown,
 		},
 		{
-			name: "RowsAffected failed",
-			s:    s,
+			name: "Not Found",
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
-					Api: apiVersion,
+					Api: "v1",
 					ToDo: &v1.ToDo{
 						Id:                        1,
 						Title:                     "new title",
 						Description:               "new description",
-						Status:                    "Started",
+						Status:                    v1.Status_DONE,
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("UPDATE ToDo").WithArgs("new title", "new description", "Started", tm, atc, tm, 1).WillReturnResult(sqlMock.NewErrorResult(errors.New("RowsAffected failed")))
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return nil, storage.ErrNotFound
+				},
 			},
-			wantErr: true,
+			wantCode: codes.NotFound,
 		},
 		{
-			name: "Not Found",
-			s:    s,
+			name: "Partial update",
 			args: args{
 				ctx: ctx,
 				req: &v1.UpdateRequest{
-					Api: "v1",
+					Api: apiVersion,
+					ToDo: &v1.ToDo{
+						Id:    1,
+						Title: "new title",
+					},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"Id", "Title", "ActualTimeOfCompletion"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+				update: func(td *storage.ToDo) (int64, error) {
+					want := &storage.ToDo{
+						ID:                        1,
+						Title:                     "new title",
+						Description:               "description",
+						Status:                    "IN_PROGRESS",
+						EstimatedTimeOfCompletion: tm,
+						Reminder:                  tm,
+						CreatedAt:                 tm,
+						UpdatedAt:                 now,
+						Owner:                     anonymousActor,
+					}
+					if !reflect.DeepEqual(td, want) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
+			},
+			want: &v1.UpdateResponse{
+				Api:     apiVersion,
+				Updated: 1,
+			},
+		},
+		{
+			name: "Partial update of timestamp",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api: apiVersion,
+					ToDo: &v1.ToDo{
+						Id:       1,
+						Reminder: laterReminder,
+					},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"toDo.reminder", "description"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+				update: func(td *storage.ToDo) (int64, error) {
+					if td.Title != "title" || len(td.Description) > 0 || !td.Reminder.Equal(later) {
+						return 0, errors.New("unexpected ToDo")
+					}
+					return 1, nil
+				},
+			},
+			want: &v1.UpdateResponse{
+				Api:     apiVersion,
+				Updated: 1,
+			},
+		},
+		{
+			name: "Partial update of missing timestamp",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"estimated_time_of_completion"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Unsupported update mask field",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1, Title: "new title"},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"title", "assignee"}},
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Illegal status transition",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1, Status: v1.Status_DONE},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("CANCELLED", time.Time{}),
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Unknown status",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: 1, Status: v1.Status(42)},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+				},
+			},
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Missing status",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api: apiVersion,
 					ToDo: &v1.ToDo{
 						Id:                        1,
 						Title:                     "new title",
-						Description:               "new description",
-						Status:                    "Completed",
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion,
-						ActualTimeOfCompletion:    actualTimeOfCompletion,
 						Reminder:                  reminder,
 					},
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("UPDATE ToDo").WithArgs("new title", "new description", "Completed", tm, atc, tm, 1).WillReturnResult(sqlMock.NewResult(1, 0))
+			repo: fakeRepository{
+				get: get("IN_PROGRESS", time.Time{}),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Missing ToDo",
+			args: args{
+				ctx: ctx,
+				req: &v1.UpdateRequest{
+					Api: apiVersion,
+				},
 			},
-			wantErr: true,
+			wantCode: codes.InvalidArgument,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mock()
-			got, err := tt.s.Update(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("toDoServiceServer.Update() error = %v, wantErr %v", err, tt.wantErr)
+			s := NewToDoServiceServer(&tt.repo, fixedClock(now))
+			got, err := s.Update(tt.args.ctx, tt.args.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("toDoServiceServer.Update() error = %v, wantCode %v", err, tt.wantCode)
 				return
 			}
 			if err == nil && !reflect.DeepEqual(got, tt.want) {
@@ -479,27 +856,20 @@ func TestToDoServiceUpdate(t *testing.T) {
 
 func TestToDoServiceServerDelete(t *testing.T) {
 	ctx := context.Background()
-	db, mock, err := sqlMock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-	s := NewToDoServiceServer(db)
 
 	type args struct {
 		ctx context.Context
 		req *v1.DeleteRequest
 	}
 	tests := []struct {
-		name    string
-		s       v1.ToDoServiceServer
-		args    args
-		mock    func()
-		want    *v1.DeleteResponse
-		wantErr bool
+		name     string
+		args     args
+		repo     fakeRepository
+		want     *v1.DeleteResponse
+		wantCode codes.Code
 	}{
-		{name: "OK",
-			s: s,
+		{
+			name: "OK",
 			args: args{
 				ctx: ctx,
 				req: &v1.DeleteRequest{
@@ -507,8 +877,13 @@ func TestToDoServiceServerDelete(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("DELETE FROM ToDo").WithArgs(1).WillReturnResult(sqlMock.NewResult(1, 1))
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return &storage.ToDo{ID: id, Title: "title", Owner: anonymousActor}, nil
+				},
+				trash: func(id int64) (int64, error) {
+					return 1, nil
+				},
 			},
 			want: &v1.DeleteResponse{
 				Api:     "v1",
@@ -517,20 +892,17 @@ func TestToDoServiceServerDelete(t *testing.T) {
 		},
 		{
 			name: "Unsupported API",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.DeleteRequest{
-					Api: apiVersion,
+					Api: "v1000",
 					Id:  1,
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.Unimplemented,
 		},
 		{
-			name: "DELETE failed",
-			s:    s,
+			name: "Delete failed",
 			args: args{
 				ctx: ctx,
 				req: &v1.DeleteRequest{
@@ -538,29 +910,18 @@ func TestToDoServiceServerDelete(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("DELETE FROM ToDo").WithArgs(1).WillReturnError(errors.New("DELETE failed"))
-			},
-			wantErr: true,
-		},
-		{
-			name: "RowsAffected failed",
-			s:    s,
-			args: args{
-				ctx: ctx,
-				req: &v1.DeleteRequest{
-					Api: apiVersion,
-					Id:  1,
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return &storage.ToDo{ID: id, Title: "title", Owner: anonymousActor}, nil
+				},
+				trash: func(id int64) (int64, error) {
+					return 0, errors.New("UPDATE failed")
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("DELETE FROM ToDo").WithArgs(1).WillReturnResult(sqlMock.NewErrorResult(errors.New("RowsAffected failed")))
-			},
-			wantErr: true,
+			wantCode: codes.Unknown,
 		},
 		{
 			name: "Not Found",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.DeleteRequest{
@@ -568,18 +929,20 @@ func TestToDoServiceServerDelete(t *testing.T) {
 					Id:  1,
 				},
 			},
-			mock: func() {
-				mock.ExpectExec("DELETE FROM ToDo").WithArgs(1).WillReturnResult(sqlMock.NewResult(1, 0))
+			repo: fakeRepository{
+				get: func(id int64) (*storage.ToDo, error) {
+					return nil, storage.ErrNotFound
+				},
 			},
-			wantErr: true,
+			wantCode: codes.NotFound,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mock()
-			got, err := tt.s.Delete(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("toDoServiceServer.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			s := NewToDoServiceServer(&tt.repo)
+			got, err := s.Delete(tt.args.ctx, tt.args.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("toDoServiceServer.Delete() error = %v, wantCode %v", err, tt.wantCode)
 				return
 			}
 			if err == nil && !reflect.DeepEqual(got, tt.want) {
@@ -591,12 +954,6 @@ func TestToDoServiceServerDelete(t *testing.T) {
 
 func TestToDoServiceServerReadAll(t *testing.T) {
 	ctx := context.Background()
-	db, mock, err := sqlMock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-	s := NewToDoServiceServer(db)
 	t1 := time.Now().In(time.UTC)
 	tm1 := time.Date(2019, 9, 27, 8, 30, 45, 56478, time.UTC)
 	reminder1, _ := ptypes.TimestampProto(t1)
@@ -613,25 +970,43 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 		req *v1.ReadAllRequest
 	}
 	tests := []struct {
-		name    string
-		s       v1.ToDoServiceServer
-		args    args
-		mock    func()
-		want    *v1.ReadAllResponse
-		wantErr bool
+		name     string
+		args     args
+		repo     fakeRepository
+		want     *v1.ReadAllResponse
+		wantCode codes.Code
 	}{
 		{
 			name: "OK",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadAllRequest{
 					Api: apiVersion,
 				},
 			},
-			mock: func() {
-				rows := sqlMock.NewRows([]string{"ID", "Title", "Description", "Status", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder"}).AddRow(1, "title 1", "description 1", "Completed", t1, tm1, t1).AddRow(2, "title 2", "description 2", "InProgress", t2, tm2, t2)
-				mock.ExpectQuery("SELECT (.+) FROM ToDo").WillReturnRows(rows)
+			repo: fakeRepository{
+				list: func(opts storage.ListOptions) ([]*storage.ToDo, error) {
+					return []*storage.ToDo{
+						{
+							ID:                        1,
+							Title:                     "title 1",
+							Description:               "description 1",
+							Status:                    "DONE",
+							EstimatedTimeOfCompletion: t1,
+							ActualTimeOfCompletion:    tm1,
+							Reminder:                  t1,
+						},
+						{
+							ID:                        2,
+							Title:                     "title 2",
+							Description:               "description 2",
+							Status:                    "IN_PROGRESS",
+							EstimatedTimeOfCompletion: t2,
+							ActualTimeOfCompletion:    tm2,
+							Reminder:                  t2,
+						},
+					}, nil
+				},
 			},
 			want: &v1.ReadAllResponse{
 				Api: "v1",
@@ -639,7 +1014,7 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 					{
 						Id:                        1,
 						Title:                     "title 1",
-						Status:                    "Completed",
+						Status:                    v1.Status_DONE,
 						Description:               "description 1",
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion1,
 						ActualTimeOfCompletion:    actualTimeOfCompletion1,
@@ -648,7 +1023,7 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 					{
 						Id:                        2,
 						Title:                     "title 2",
-						Status:                    "InProgress",
+						Status:                    v1.Status_IN_PROGRESS,
 						Description:               "description 2",
 						EstimatedTimeOfCompletion: estimatedTimeOfCompletion2,
 						ActualTimeOfCompletion:    actualTimeOfCompletion2,
@@ -659,16 +1034,16 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 		},
 		{
 			name: "Empty",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadAllRequest{
 					Api: apiVersion,
 				},
 			},
-			mock: func() {
-				rows := sqlMock.NewRows([]string{"ID", "Title", "Status", "Description", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder"})
-				mock.ExpectQuery("SELECT (.+) FROM ToDo").WillReturnRows(rows)
+			repo: fakeRepository{
+				list: func(opts storage.ListOptions) ([]*storage.ToDo, error) {
+					return []*storage.ToDo{}, nil
+				},
 			},
 			want: &v1.ReadAllResponse{
 				Api:   "v1",
@@ -677,23 +1052,21 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 		},
 		{
 			name: "Unsupported API",
-			s:    s,
 			args: args{
 				ctx: ctx,
 				req: &v1.ReadAllRequest{
 					Api: "v2",
 				},
 			},
-			mock:    func() {},
-			wantErr: true,
+			wantCode: codes.Unimplemented,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.mock()
-			got, err := tt.s.ReadAll(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("toDoService.ReadAll() error = %v, wantErr %v", err, tt.wantErr)
+			s := NewToDoServiceServer(&tt.repo)
+			got, err := s.ReadAll(tt.args.ctx, tt.args.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("toDoService.ReadAll() error = %v, wantCode %v", err, tt.wantCode)
 				return
 			}
 			if err == nil && !reflect.DeepEqual(got, tt.want) {
@@ -702,3 +1075,173 @@ func TestToDoServiceServerReadAll(t *testing.T) {
 		})
 	}
 }
+
+func TestToDoServiceServerReadAllPagination(t *testing.T) {
+	ctx := context.Background()
+	s := NewToDoServiceServer(memory.NewToDoRepository())
+	base := time.Date(2019, 10, 17, 8, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		due, _ := ptypes.TimestampProto(base.Add(time.Duration(5-i) * time.Hour))
+		reminder, _ := ptypes.TimestampProto(base)
+		st := v1.Status_IN_PROGRESS
+		if i%2 == 0 {
+			st = v1.Status_DONE
+		}
+		_, err := s.Create(ctx, &v1.CreateRequest{
+			Api: apiVersion,
+			ToDo: &v1.ToDo{
+				Title:                     fmt.Sprintf("title %d", i),
+				Status:                    st,
+				EstimatedTimeOfCompletion: due,
+				Reminder:                  reminder,
+			},
+		})
+		if err != nil {
+			t.Fatalf("toDoServiceServer.Create() error = %v", err)
+		}
+	}
+
+	//readAll reads every page and returns IDs of the read todo entities
+	readAll := func(req *v1.ReadAllRequest) []int64 {
+		var ids []int64
+		for {
+			res, err := s.ReadAll(ctx, req)
+			if err != nil {
+				t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+			}
+			if len(res.ToDos) > int(req.PageSize) {
+				t.Fatalf("toDoServiceServer.ReadAll() returned %d entities, page size is %d", len(res.ToDos), req.PageSize)
+			}
+			for _, td := range res.ToDos {
+				ids = append(ids, td.Id)
+			}
+			if len(res.NextPageToken) == 0 {
+				return ids
+			}
+			req.PageToken = res.NextPageToken
+		}
+	}
+
+	dueFrom, _ := ptypes.TimestampProto(base.Add(2 * time.Hour))
+	tests := []struct {
+		name string
+		req  *v1.ReadAllRequest
+		want []int64
+	}{
+		{
+			name: "By ID",
+			req:  &v1.ReadAllRequest{PageSize: 2},
+			want: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name: "By due date",
+			req:  &v1.ReadAllRequest{PageSize: 2, OrderBy: "estimatedTimeOfCompletion"},
+			want: []int64{5, 4, 3, 2, 1},
+		},
+		{
+			name: "By title descending",
+			req:  &v1.ReadAllRequest{PageSize: 3, OrderBy: "title desc"},
+			want: []int64{5, 4, 3, 2, 1},
+		},
+		{
+			name: "By status with ties",
+			req:  &v1.ReadAllRequest{PageSize: 1, OrderBy: "status"},
+			want: []int64{1, 3, 5, 2, 4},
+		},
+		{
+			name: "Filtered",
+			req:  &v1.ReadAllRequest{PageSize: 1, Status: v1.Status_DONE, DueAfter: dueFrom},
+			want: []int64{1, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readAll(tt.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toDoServiceServer.ReadAll() IDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	res, err := s.ReadAll(ctx, &v1.ReadAllRequest{PageSize: 2})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+	}
+	invalid := []*v1.ReadAllRequest{
+		{PageSize: -1},
+		{OrderBy: "description"},
+		{OrderBy: "title sideways"},
+		{PageToken: "not a token"},
+		{PageToken: res.NextPageToken, Status: v1.Status_DONE},
+	}
+	for _, req := range invalid {
+		if _, err := s.ReadAll(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("toDoServiceServer.ReadAll(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestToDoServiceServerListCompletions(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 2, 27, 3, 15, 45, 0, time.UTC)
+	now := tm
+	s := NewToDoServiceServer(memory.NewToDoRepository(), WithClock(func() time.Time { return now }))
+
+	reminder, _ := ptypes.TimestampProto(tm)
+	created, err := s.Create(ctx, &v1.CreateRequest{
+		Api: apiVersion,
+		ToDo: &v1.ToDo{
+			Title:                     "title",
+			Status:                    v1.Status_IN_PROGRESS,
+			EstimatedTimeOfCompletion: reminder,
+			Reminder:                  reminder,
+		},
+	})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.Create() error = %v", err)
+	}
+
+	//complete, reopen and complete the task again an hour apart
+	for _, st := range []v1.Status{v1.Status_DONE, v1.Status_IN_PROGRESS, v1.Status_DONE} {
+		now = now.Add(time.Hour)
+		_, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:        apiVersion,
+			ToDo:       &v1.ToDo{Id: created.Id, Status: st},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"status"}},
+		})
+		if err != nil {
+			t.Fatalf("toDoServiceServer.Update() error = %v", err)
+		}
+	}
+
+	read, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: created.Id})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.Read() error = %v", err)
+	}
+	if got, _ := ptypes.Timestamp(read.ToDo.ActualTimeOfCompletion); !got.Equal(tm.Add(3 * time.Hour)) {
+		t.Errorf("toDoServiceServer.Read() actualTimeOfCompletion = %v, want %v", got, tm.Add(3*time.Hour))
+	}
+
+	got, err := s.ListCompletions(ctx, &v1.ListCompletionsRequest{Api: apiVersion, Id: created.Id})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.ListCompletions() error = %v", err)
+	}
+	timestampAt := func(hours int) *timestamp.Timestamp {
+		ts, _ := ptypes.TimestampProto(tm.Add(time.Duration(hours) * time.Hour))
+		return ts
+	}
+	want := &v1.ListCompletionsResponse{
+		Api: apiVersion,
+		Completions: []*v1.Completion{
+			{CompletedAt: timestampAt(1), ReopenedAt: timestampAt(2), CycleTime: ptypes.DurationProto(time.Hour)},
+			{CompletedAt: timestampAt(3), CycleTime: ptypes.DurationProto(3 * time.Hour)},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoServiceServer.ListCompletions() = %v, want %v", got, want)
+	}
+
+	if _, err := s.ListCompletions(ctx, &v1.ListCompletionsRequest{Api: apiVersion, Id: created.Id + 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("toDoServiceServer.ListCompletions() error = %v, wantCode %v", err, codes.NotFound)
+	}
+}
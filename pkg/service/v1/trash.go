@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Undelete restores todo entity from the trash together with the subtasks deleted with it
+func (s *todoServiceServer) Undelete(ctx context.Context, req *v1.UndeleteRequest) (*v1.UndeleteResponse, error) {
+	//check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	//only the owner restores todo entity
+	trashed, err := s.repo(ctx).Trashed(ctx, req.Id)
+	if err == storage.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not in the trash", req.Id)
+	}
+	if err != nil {
+		return nil, storageError(err, req.Id)
+	}
+	if err := s.checkAccess(ctx, trashed, ownAccess); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	rows, err := s.repo(ctx).Restore(ctx, req.Id, now)
+	switch err {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "ToDo with ID='%d' is not in the trash", req.Id)
+	case storage.ErrParentNotFound:
+		return nil, status.Errorf(codes.FailedPrecondition, "parent of ToDo with ID='%d' is deleted, undelete the parent first", req.Id)
+	default:
+		return nil, storageError(err, req.Id)
+	}
+
+	//restored todo entities appear to watchers as created ones
+	if std, err := s.repo(ctx).Get(ctx, req.Id); err == nil {
+		restored := []*storage.ToDo{std}
+		if subtasks, err := s.repo(ctx).Descendants(ctx, req.Id); err == nil {
+			restored = append(restored, subtasks...)
+		}
+		for _, std := range restored {
+			s.publish(ctx, v1.WatchResponse_CREATED, std, now)
+			s.record(ctx, storage.HistoryRestored, std, now)
+		}
+	}
+
+	return &v1.UndeleteResponse{
+		Api:       apiVersion,
+		Undeleted: rows,
+	}, nil
+}
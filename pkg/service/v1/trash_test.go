@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerTrash(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC))
+
+	release := s.mustCreate(t, ctx, &v1.ToDo{Title: "release"})
+	build := s.mustCreate(t, ctx, &v1.ToDo{Title: "build", ParentId: release})
+	report := s.mustCreate(t, ctx, &v1.ToDo{Title: "report"})
+
+	deleted, err := s.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: release, Mode: v1.DeleteRequest_CASCADE})
+	if err != nil || deleted.Deleted != 2 {
+		t.Fatalf("toDoServiceServer.Delete() = %v, %v, want 2 deleted", deleted, err)
+	}
+
+	t.Run("Deleted", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			call     func() error
+			wantCode codes.Code
+		}{
+			{
+				name: "Read",
+				call: func() error {
+					_, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: build})
+					return err
+				},
+				wantCode: codes.NotFound,
+			},
+			{
+				//title of todo entity in the trash stays in use
+				name: "Create with the title",
+				call: func() error {
+					_, err := s.create(ctx, &v1.ToDo{Title: "release"})
+					return err
+				},
+				wantCode: codes.AlreadyExists,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.call(); status.Code(err) != tt.wantCode {
+					t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+
+	t.Run("Undelete", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			req      *v1.UndeleteRequest
+			want     int64
+			wantCode codes.Code
+			//wantListed are todo entities listed without show_deleted
+			wantListed []int64
+			//wantTrashed are todo entities in the trash listed with show_deleted
+			wantTrashed []int64
+			//wantFound are todo entities found by search of "build"
+			wantFound []int64
+		}{
+			{
+				name:        "Not in the trash",
+				req:         &v1.UndeleteRequest{Api: apiVersion, Id: report},
+				wantCode:    codes.NotFound,
+				wantListed:  []int64{report},
+				wantTrashed: []int64{release, build},
+				wantFound:   []int64{},
+			},
+			{
+				name:        "Not found",
+				req:         &v1.UndeleteRequest{Api: apiVersion, Id: 42},
+				wantCode:    codes.NotFound,
+				wantListed:  []int64{report},
+				wantTrashed: []int64{release, build},
+				wantFound:   []int64{},
+			},
+			{
+				name:        "Parent in the trash",
+				req:         &v1.UndeleteRequest{Api: apiVersion, Id: build},
+				wantCode:    codes.FailedPrecondition,
+				wantListed:  []int64{report},
+				wantTrashed: []int64{release, build},
+				wantFound:   []int64{},
+			},
+			{
+				name:        "Unsupported API",
+				req:         &v1.UndeleteRequest{Api: "v2", Id: release},
+				wantCode:    codes.Unimplemented,
+				wantListed:  []int64{report},
+				wantTrashed: []int64{release, build},
+				wantFound:   []int64{},
+			},
+			{
+				//subtasks deleted together with the parent are restored with it
+				name:        "With subtasks",
+				req:         &v1.UndeleteRequest{Api: apiVersion, Id: release},
+				want:        2,
+				wantListed:  []int64{release, build, report},
+				wantTrashed: []int64{},
+				wantFound:   []int64{build},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Undelete(ctx, tt.req)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Undelete() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && got.Undeleted != tt.want {
+					t.Errorf("toDoServiceServer.Undelete() = %v, want %d undeleted", got, tt.want)
+				}
+
+				listed, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+				}
+				if ids := toDoIDs(listed.ToDos); !reflect.DeepEqual(ids, tt.wantListed) {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, want %v", ids, tt.wantListed)
+				}
+				all, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion, ShowDeleted: true})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.ReadAll() error = %v", err)
+				}
+				trashed := []int64{}
+				for _, td := range all.ToDos {
+					if td.DeletedAt != nil {
+						trashed = append(trashed, td.Id)
+					}
+				}
+				if !reflect.DeepEqual(toDoIDs(all.ToDos), []int64{release, build, report}) || !reflect.DeepEqual(trashed, tt.wantTrashed) {
+					t.Errorf("toDoServiceServer.ReadAll(show_deleted) = %v with %v in the trash, want %v in the trash", toDoIDs(all.ToDos), trashed, tt.wantTrashed)
+				}
+				found, err := s.Search(ctx, &v1.SearchRequest{Api: apiVersion, Q: "build"})
+				if err != nil {
+					t.Fatalf("toDoServiceServer.Search() error = %v", err)
+				}
+				if ids := searchIDs(found); !reflect.DeepEqual(ids, tt.wantFound) {
+					t.Errorf("toDoServiceServer.Search() = %v, want %v", ids, tt.wantFound)
+				}
+			})
+		}
+	})
+}
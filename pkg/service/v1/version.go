@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//ifMatchMetadata is the metadata key of the version expected by Update and Delete when the request does not set it,
+//HTTP gateway sets it from If-Match header
+const ifMatchMetadata = "if-match"
+
+//WithRequiredVersion makes Update and Delete fail unless client sends the version of todo entity it read
+func WithRequiredVersion() Option {
+	return func(s *todoServiceServer) {
+		s.requireVersion = true
+	}
+}
+
+//expectedVersion returns the version of todo entity the change is made to, version of the request takes precedence
+//over If-Match sent in metadata. 0 means any version, it is allowed only when server does not require versions.
+func (s *todoServiceServer) expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "version field has invalid value %d", version)
+	}
+	if version == 0 {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(ifMatchMetadata); len(v) > 0 {
+				return parseETag(v[0])
+			}
+		}
+	}
+	if version == 0 && s.requireVersion {
+		return 0, status.Error(codes.InvalidArgument, "version field is required, read the todo entity to get its version")
+	}
+	return version, nil
+}
+
+//parseETag parses entity tag of If-Match header e.g. "3" or W/"3", * matches any version
+func parseETag(tag string) (int64, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "*" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(tag, "W/"), `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "If-Match has invalid entity tag %s", tag)
+	}
+	return version, nil
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoServiceServerVersion(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 6, 10, 10, 0, 0, 0, time.UTC))
+
+	id := s.mustCreate(t, ctx, &v1.ToDo{Title: "report"})
+	ifMatch := func(tag string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(ifMatchMetadata, tag))
+	}
+
+	t.Run("Update", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			version  int64
+			want     int64
+			wantCode codes.Code
+		}{
+			{"Current version", ctx, 1, 2, codes.OK},
+			//unconditional update changes any version
+			{"Unconditional", ctx, 0, 3, codes.OK},
+			{"Weak If-Match", ifMatch(`W/"3"`), 0, 4, codes.OK},
+			{"Stale version", ctx, 2, 4, codes.Aborted},
+			{"Stale If-Match", ifMatch(`"3"`), 0, 4, codes.Aborted},
+			{"Version over If-Match", ifMatch(`"4"`), 3, 4, codes.Aborted},
+			{"Invalid If-Match", ifMatch("four"), 0, 4, codes.InvalidArgument},
+			{"Negative version", ctx, -1, 4, codes.InvalidArgument},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Update(tt.ctx, &v1.UpdateRequest{
+					Api:        apiVersion,
+					ToDo:       &v1.ToDo{Id: id, Title: tt.name, Version: tt.version},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"title", "version"}},
+				})
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("toDoServiceServer.Update() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				if err == nil && got.Version != tt.want {
+					t.Errorf("toDoServiceServer.Update() version = %d, want %d", got.Version, tt.want)
+				}
+				if read, err := s.Read(ctx, &v1.ReadRequest{Api: apiVersion, Id: id}); err != nil || read.ToDo.Version != tt.want {
+					t.Errorf("toDoServiceServer.Read() = %v, %v, want version %d", read, err, tt.want)
+				}
+				if list, err := s.ReadAll(ctx, &v1.ReadAllRequest{Api: apiVersion}); err != nil || list.ToDos[0].Version != tt.want {
+					t.Errorf("toDoServiceServer.ReadAll() = %v, %v, want version %d", list, err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("BatchDelete", func(t *testing.T) {
+		got, err := s.BatchDelete(ctx, &v1.BatchDeleteRequest{
+			Api:      apiVersion,
+			Mode:     v1.BatchMode_PER_ITEM,
+			Requests: []*v1.DeleteRequest{{Id: id, Version: 3}},
+		})
+		if err != nil || codes.Code(got.Results[0].Status.Code) != codes.Aborted {
+			t.Errorf("toDoServiceServer.BatchDelete() = %v, %v, want %v", got, err, codes.Aborted)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			version  int64
+			wantCode codes.Code
+		}{
+			{"Stale version", ctx, 2, codes.Aborted},
+			{"Stale If-Match", ifMatch(`"3"`), 0, codes.Aborted},
+			{"Version over If-Match", ifMatch(`"4"`), 3, codes.Aborted},
+			{"Invalid If-Match", ifMatch("four"), 0, codes.InvalidArgument},
+			{"Negative version", ctx, -1, codes.InvalidArgument},
+			{"If-Match", ifMatch(`"4"`), 0, codes.OK},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := s.Delete(tt.ctx, &v1.DeleteRequest{Api: apiVersion, Id: id, Version: tt.version}); status.Code(err) != tt.wantCode {
+					t.Errorf("toDoServiceServer.Delete() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			})
+		}
+	})
+}
+
+func TestToDoServiceServerRequiredVersion(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(time.Date(2020, 6, 10, 10, 0, 0, 0, time.UTC), WithRequiredVersion())
+
+	id := s.mustCreate(t, ctx, &v1.ToDo{Title: "report"})
+	update := func(version int64) error {
+		_, err := s.Update(ctx, &v1.UpdateRequest{
+			Api:        apiVersion,
+			ToDo:       &v1.ToDo{Id: id, Title: "weekly report", Version: version},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+		})
+		return err
+	}
+	remove := func(version int64) error {
+		_, err := s.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: id, Version: version})
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		call     func(version int64) error
+		version  int64
+		wantCode codes.Code
+	}{
+		{"Update without version", update, 0, codes.InvalidArgument},
+		{"Delete without version", remove, 0, codes.InvalidArgument},
+		{"Update", update, 1, codes.OK},
+		{"Delete", remove, 2, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(tt.version); status.Code(err) != tt.wantCode {
+				t.Errorf("error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//eventHistorySize is number of the latest changes kept to resume watching
+	eventHistorySize = 1000
+
+	//watcherBufferSize is number of changes waiting to be sent to a watcher before it is dropped
+	watcherBufferSize = 100
+)
+
+//event is a change of todo entity published to watchers
+type event struct {
+	//seq is the position of the change in the change history
+	seq uint64
+
+	//typ is kind of change
+	typ v1.WatchResponse_EventType
+
+	//td is todo entity after the change or before deletion
+	td *v1.ToDo
+
+	//time is date and time of the change
+	time time.Time
+}
+
+//watcher receives changes published after it subscribed
+type watcher struct {
+	//events delivers changes, it is closed when watcher falls behind
+	events chan *event
+}
+
+//eventBroker publishes changes of todo entities to watchers of this server process.
+//It keeps the latest changes so that watcher can resume after reconnection.
+type eventBroker struct {
+	mu sync.Mutex
+
+	//epoch identifies the broker so that resume token of another server process is rejected
+	epoch int64
+
+	//seq is sequence number of the last published change
+	seq uint64
+
+	//history holds the latest changes in publishing order
+	history []*event
+
+	//watchers are current subscribers
+	watchers map[*watcher]struct{}
+}
+
+//newEventBroker creates broker without changes and watchers
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		epoch:    time.Now().UnixNano(),
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+//publish sends change to every watcher.
+//Watcher which is not able to keep up is dropped and has to resume with the last token it received.
+func (b *eventBroker) publish(typ v1.WatchResponse_EventType, td *v1.ToDo, tm time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e := &event{seq: b.seq, typ: typ, td: td, time: tm}
+
+	b.history = append(b.history, e)
+	if len(b.history) > eventHistorySize {
+		b.history = b.history[len(b.history)-eventHistorySize:]
+	}
+
+	for w := range b.watchers {
+		select {
+		case w.events <- e:
+		default:
+			delete(b.watchers, w)
+			close(w.events)
+		}
+	}
+}
+
+//subscribe registers watcher and returns the changes published after the resume token
+func (b *eventBroker) subscribe(resumeToken string) (*watcher, []*event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var missed []*event
+	if len(resumeToken) > 0 {
+		var epoch int64
+		var seq uint64
+		if _, err := fmt.Sscanf(resumeToken, "%x.%x", &epoch, &seq); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "resume_token is malformed")
+		}
+		if epoch != b.epoch || seq > b.seq {
+			return nil, nil, status.Error(codes.OutOfRange, "resume_token is issued by another server, read current tasks with ReadAll and watch again")
+		}
+
+		//the changes after the token must still be in the history
+		if seq < b.seq && (len(b.history) == 0 || b.history[0].seq > seq+1) {
+			return nil, nil, status.Error(codes.OutOfRange, "resume_token is too old, read current tasks with ReadAll and watch again")
+		}
+		for _, e := range b.history {
+			if e.seq > seq {
+				missed = append(missed, e)
+			}
+		}
+	}
+
+	w := &watcher{events: make(chan *event, watcherBufferSize)}
+	b.watchers[w] = struct{}{}
+	return w, missed, nil
+}
+
+//unsubscribe removes watcher if it is not dropped yet
+func (b *eventBroker) unsubscribe(w *watcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.watchers[w]; ok {
+		delete(b.watchers, w)
+		close(w.events)
+	}
+}
+
+//resumeToken returns token to resume watching after the change
+func (b *eventBroker) resumeToken(e *event) string {
+	return fmt.Sprintf("%x.%x", b.epoch, e.seq)
+}
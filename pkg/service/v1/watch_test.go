@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/basebandit/go-grpc/pkg/api/v1"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//fakeWatchServer collects changes sent by Watch
+type fakeWatchServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *v1.WatchResponse
+}
+
+func (s *fakeWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchServer) Send(res *v1.WatchResponse) error {
+	s.events <- res
+	return nil
+}
+
+//watch calls Watch in background and returns the stream and function stopping the call
+func watch(t *testing.T, s v1.ToDoServiceServer, resumeToken string) (*fakeWatchServer, func() error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchServer{ctx: ctx, events: make(chan *v1.WatchResponse, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Watch(&v1.WatchRequest{Api: apiVersion, ResumeToken: resumeToken}, stream)
+	}()
+	return stream, func() error {
+		cancel()
+		return <-done
+	}
+}
+
+//receive waits for the next change sent by Watch
+func receive(t *testing.T, stream *fakeWatchServer) *v1.WatchResponse {
+	select {
+	case res := <-stream.events:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatalf("toDoServiceServer.Watch() sent nothing")
+	}
+	return nil
+}
+
+func TestToDoServiceServerWatch(t *testing.T) {
+	ctx := context.Background()
+	s := NewToDoServiceServer(memory.NewToDoRepository())
+
+	stream, stop := watch(t, s, "")
+	//wait until Watch subscribes to changes
+	broker := s.(*todoServiceServer).space(ctx).events
+	for i := 0; ; i++ {
+		broker.mu.Lock()
+		n := len(broker.watchers)
+		broker.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if i > 500 {
+			t.Fatalf("toDoServiceServer.Watch() did not subscribe")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	tm, _ := ptypes.TimestampProto(time.Now())
+	created, err := s.Create(ctx, &v1.CreateRequest{
+		Api:  apiVersion,
+		ToDo: &v1.ToDo{Title: "title", EstimatedTimeOfCompletion: tm, Reminder: tm},
+	})
+	if err != nil {
+		t.Fatalf("toDoServiceServer.Create() error = %v", err)
+	}
+	if _, err := s.Update(ctx, &v1.UpdateRequest{
+		Api:  apiVersion,
+		ToDo: &v1.ToDo{Id: created.Id, Title: "new title", Status: v1.Status_IN_PROGRESS, EstimatedTimeOfCompletion: tm, Reminder: tm},
+	}); err != nil {
+		t.Fatalf("toDoServiceServer.Update() error = %v", err)
+	}
+	if _, err := s.Delete(ctx, &v1.DeleteRequest{Api: apiVersion, Id: created.Id}); err != nil {
+		t.Fatalf("toDoServiceServer.Delete() error = %v", err)
+	}
+
+	want := []struct {
+		typ   v1.WatchResponse_EventType
+		title string
+	}{
+		{v1.WatchResponse_CREATED, "title"},
+		{v1.WatchResponse_UPDATED, "new title"},
+		{v1.WatchResponse_DELETED, "new title"},
+	}
+	var tokens []string
+	for _, w := range want {
+		res := receive(t, stream)
+		if res.Type != w.typ || res.ToDo.Id != created.Id || res.ToDo.Title != w.title || len(res.ResumeToken) == 0 || res.Time == nil {
+			t.Errorf("toDoServiceServer.Watch() sent %v, want %s of '%s'", res, w.typ, w.title)
+		}
+		tokens = append(tokens, res.ResumeToken)
+	}
+	if err := stop(); status.Code(err) != codes.Canceled {
+		t.Errorf("toDoServiceServer.Watch() error = %v, wantCode %v", err, codes.Canceled)
+	}
+
+	//resume after the first change
+	stream, stop = watch(t, s, tokens[0])
+	for i, w := range want[1:] {
+		res := receive(t, stream)
+		if res.Type != w.typ || res.ResumeToken != tokens[i+1] {
+			t.Errorf("toDoServiceServer.Watch() resumed with %v, want %s", res, w.typ)
+		}
+	}
+	stop()
+
+	for _, token := range []string{"not a token", "1.1"} {
+		err := s.Watch(&v1.WatchRequest{Api: apiVersion, ResumeToken: token}, &fakeWatchServer{ctx: ctx})
+		if code := status.Code(err); code != codes.InvalidArgument && code != codes.OutOfRange {
+			t.Errorf("toDoServiceServer.Watch() error = %v, want InvalidArgument or OutOfRange", err)
+		}
+	}
+}
+
+func TestEventBroker(t *testing.T) {
+	b := newEventBroker()
+	td := &v1.ToDo{Id: 1}
+
+	//the second change is the oldest one kept in history
+	for i := 0; i < eventHistorySize+2; i++ {
+		b.publish(v1.WatchResponse_UPDATED, td, time.Now())
+	}
+	if _, _, err := b.subscribe(b.resumeToken(&event{seq: 1})); status.Code(err) != codes.OutOfRange {
+		t.Errorf("eventBroker.subscribe() error = %v, wantCode %v", err, codes.OutOfRange)
+	}
+	if _, _, err := b.subscribe("1.1"); status.Code(err) != codes.OutOfRange {
+		t.Errorf("eventBroker.subscribe() error = %v, wantCode %v", err, codes.OutOfRange)
+	}
+	w, missed, err := b.subscribe(b.resumeToken(&event{seq: b.seq - 1}))
+	if err != nil || len(missed) != 1 || missed[0].seq != b.seq {
+		t.Errorf("eventBroker.subscribe() = %v, %v, want the last change", missed, err)
+	}
+
+	//watcher which does not receive changes is dropped
+	for i := 0; i < watcherBufferSize+1; i++ {
+		b.publish(v1.WatchResponse_UPDATED, td, time.Now())
+	}
+	n := 0
+	for range w.events {
+		n++
+	}
+	if n != watcherBufferSize {
+		t.Errorf("dropped watcher received %d changes, want %d", n, watcherBufferSize)
+	}
+	b.unsubscribe(w)
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//snapshot is a copy of repository data a failed batch is rolled back to,
+//IDs assigned by rolled back operations are not reused like auto incremented IDs of SQL database
+type snapshot struct {
+	todos          map[int64]storage.ToDo
+	trashed        map[int64]storage.ToDo
+	completions    map[int64][]storage.Completion
+	remindersSent  map[int64]time.Time
+	reminderClaims map[int64]time.Time
+	prerequisites  map[int64]map[int64]bool
+	shares         map[int64]map[string]storage.ShareRole
+}
+
+//Batch runs items of operations under one lock, failed item or the whole atomic batch
+//is rolled back to the copy of data taken before it
+func (r *toDoRepository) Batch(ctx context.Context, items [][]*storage.BatchOp, atomic bool) ([]error, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var before *snapshot
+	if atomic {
+		before = r.snapshot()
+	}
+
+	errs := make([]error, len(items))
+	for i, ops := range items {
+		//single operation does not change anything when it fails
+		if !atomic && len(ops) > 1 {
+			before = r.snapshot()
+		}
+
+		if errs[i] = r.batchItem(ops); errs[i] != nil {
+			if atomic || len(ops) > 1 {
+				r.restore(before)
+			}
+			if atomic {
+				return errs, nil
+			}
+		}
+	}
+	return errs, nil
+}
+
+//batchItem runs operations of batch item, the caller must hold the lock
+func (r *toDoRepository) batchItem(ops []*storage.BatchOp) error {
+	for _, op := range ops {
+		var err error
+		switch op.Kind {
+		case storage.BatchCreate:
+			op.ToDo.ID, err = r.create(op.ToDo)
+			if err == storage.ErrAlreadyExists && op.SkipExisting {
+				err = nil
+			}
+		case storage.BatchUpdate:
+			op.Rows, err = r.update(op.ToDo)
+		case storage.BatchDelete:
+			op.Rows, err = r.remove(op.ID, op.Mode)
+		case storage.BatchTrash:
+			op.Rows, err = r.trash(op.ID, op.Version, op.Mode, op.DeletedAt)
+		default:
+			err = fmt.Errorf("unknown batch operation %d", op.Kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//snapshot copies data changed by batch operations, the caller must hold the lock
+func (r *toDoRepository) snapshot() *snapshot {
+	s := &snapshot{
+		todos:          make(map[int64]storage.ToDo, len(r.todos)),
+		trashed:        make(map[int64]storage.ToDo, len(r.trashed)),
+		completions:    make(map[int64][]storage.Completion, len(r.completions)),
+		remindersSent:  make(map[int64]time.Time, len(r.remindersSent)),
+		reminderClaims: make(map[int64]time.Time, len(r.reminderClaims)),
+		prerequisites:  make(map[int64]map[int64]bool, len(r.prerequisites)),
+		shares:         make(map[int64]map[string]storage.ShareRole, len(r.shares)),
+	}
+	for id, td := range r.todos {
+		s.todos[id] = td
+	}
+	for id, td := range r.trashed {
+		s.trashed[id] = td
+	}
+	for id, c := range r.completions {
+		s.completions[id] = append([]storage.Completion{}, c...)
+	}
+	for id, tm := range r.remindersSent {
+		s.remindersSent[id] = tm
+	}
+	for id, tm := range r.reminderClaims {
+		s.reminderClaims[id] = tm
+	}
+	for id, prerequisites := range r.prerequisites {
+		copied := make(map[int64]bool, len(prerequisites))
+		for p := range prerequisites {
+			copied[p] = true
+		}
+		s.prerequisites[id] = copied
+	}
+	for id, roles := range r.shares {
+		copied := make(map[string]storage.ShareRole, len(roles))
+		for user, role := range roles {
+			copied[user] = role
+		}
+		s.shares[id] = copied
+	}
+	return s
+}
+
+//restore replaces data changed by batch operations with the snapshot, the caller must hold the lock
+func (r *toDoRepository) restore(s *snapshot) {
+	r.todos = s.todos
+	r.trashed = s.trashed
+	r.completions = s.completions
+	r.remindersSent = s.remindersSent
+	r.reminderClaims = s.reminderClaims
+	r.prerequisites = s.prerequisites
+	r.shares = s.shares
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryBatch(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "report"}); err != nil {
+		t.Fatalf("toDoRepository.Create() error = %v", err)
+	}
+	titles := func() []string {
+		list, _ := r.List(ctx, storage.ListOptions{})
+		titles := []string{}
+		for _, td := range list {
+			titles = append(titles, td.Title)
+		}
+		return titles
+	}
+	create := func(title string) *storage.BatchOp {
+		return &storage.BatchOp{Kind: storage.BatchCreate, ToDo: &storage.ToDo{Title: title}}
+	}
+
+	//atomic batch is rolled back by the failed item
+	errs, err := r.Batch(ctx, [][]*storage.BatchOp{{create("groceries")}, {create("report")}, {create("taxes")}}, true)
+	if err != nil || !reflect.DeepEqual(errs, []error{nil, storage.ErrAlreadyExists, nil}) {
+		t.Errorf("toDoRepository.Batch() = %v, %v", errs, err)
+	}
+	if got := titles(); !reflect.DeepEqual(got, []string{"report"}) {
+		t.Errorf("toDoRepository.Batch() left %v, want [report]", got)
+	}
+
+	//only failed items are rolled back, operations of an item fail together
+	skipped := &storage.BatchOp{Kind: storage.BatchCreate, ToDo: &storage.ToDo{Title: "report"}, SkipExisting: true}
+	update := &storage.BatchOp{Kind: storage.BatchUpdate, ToDo: &storage.ToDo{ID: 1, Title: "weekly report"}}
+	errs, err = r.Batch(ctx, [][]*storage.BatchOp{
+		{create("groceries")},
+		{create("taxes"), {Kind: storage.BatchDelete, ID: 42}},
+		{skipped},
+		{update},
+	}, false)
+	if err != nil || !reflect.DeepEqual(errs, []error{nil, storage.ErrNotFound, nil, nil}) {
+		t.Errorf("toDoRepository.Batch() = %v, %v", errs, err)
+	}
+	if got := titles(); !reflect.DeepEqual(got, []string{"weekly report", "groceries"}) {
+		t.Errorf("toDoRepository.Batch() left %v, want [weekly report groceries]", got)
+	}
+	if skipped.ToDo.ID != 0 || update.Rows != 1 {
+		t.Errorf("toDoRepository.Batch() skipped ID = %d, updated rows = %d", skipped.ToDo.ID, update.Rows)
+	}
+}
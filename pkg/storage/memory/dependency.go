@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//AddDependency makes todo entity depend on the prerequisite
+func (r *toDoRepository) AddDependency(ctx context.Context, id int64, dependsOnID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return storage.ErrNotFound
+	}
+	if _, ok := r.todos[dependsOnID]; !ok {
+		return storage.ErrPrerequisiteNotFound
+	}
+	if r.prerequisites[id][dependsOnID] {
+		return storage.ErrDependencyExists
+	}
+	//walk prerequisites of the prerequisite, the todo entity must not be met on the way
+	visited := map[int64]bool{dependsOnID: true}
+	for queue := []int64{dependsOnID}; len(queue) > 0; queue = queue[1:] {
+		if queue[0] == id {
+			return storage.ErrDependencyCycle
+		}
+		for p := range r.prerequisites[queue[0]] {
+			if !visited[p] {
+				visited[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+
+	if r.prerequisites[id] == nil {
+		r.prerequisites[id] = make(map[int64]bool)
+	}
+	r.prerequisites[id][dependsOnID] = true
+	return nil
+}
+
+//RemoveDependency removes dependency of todo entity on the prerequisite
+func (r *toDoRepository) RemoveDependency(ctx context.Context, id int64, dependsOnID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.prerequisites[id][dependsOnID] {
+		return storage.ErrDependencyNotFound
+	}
+	delete(r.prerequisites[id], dependsOnID)
+	return nil
+}
+
+//Prerequisites returns copies of todo entities the todo entity depends on
+func (r *toDoRepository) Prerequisites(ctx context.Context, id int64) ([]*storage.ToDo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return nil, storage.ErrNotFound
+	}
+	list := []*storage.ToDo{}
+	for p := range r.prerequisites[id] {
+		//prerequisite in the trash is skipped
+		if td, ok := r.todos[p]; ok {
+			list = append(list, &td)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+//Dependencies returns all dependencies between todo entities which are not in the trash
+func (r *toDoRepository) Dependencies(ctx context.Context) ([]*storage.Dependency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := []*storage.Dependency{}
+	for id, prerequisites := range r.prerequisites {
+		if _, ok := r.todos[id]; !ok {
+			continue
+		}
+		for p := range prerequisites {
+			if _, ok := r.todos[p]; ok {
+				list = append(list, &storage.Dependency{ToDoID: id, DependsOnID: p})
+			}
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].ToDoID != list[j].ToDoID {
+			return list[i].ToDoID < list[j].ToDoID
+		}
+		return list[i].DependsOnID < list[j].DependsOnID
+	})
+	return list, nil
+}
+
+//ready reports whether todo entity is open and all its prerequisites which are not in the trash are closed.
+//The caller must hold the lock.
+func (r *toDoRepository) ready(td *storage.ToDo, closed []string) bool {
+	if isClosed(td.Status, closed) {
+		return false
+	}
+	for p := range r.prerequisites[td.ID] {
+		if prerequisite, ok := r.todos[p]; ok && !isClosed(prerequisite.Status, closed) {
+			return false
+		}
+	}
+	return true
+}
+
+//isClosed reports whether the status is one of closed statuses
+func isClosed(status string, closed []string) bool {
+	for _, c := range closed {
+		if status == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryDependencies(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+
+	//review <- deploy <- announce
+	for _, td := range []*storage.ToDo{{Title: "review", Status: "DONE"}, {Title: "deploy", Status: "TODO"}, {Title: "announce", Status: "TODO"}, {Title: "docs", Status: "CANCELLED"}} {
+		if _, err := r.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		id          int64
+		dependsOnID int64
+		wantErr     error
+	}{
+		{"Deploy after review", 2, 1, nil},
+		{"Announce after deploy", 3, 2, nil},
+		{"Exists", 3, 2, storage.ErrDependencyExists},
+		{"Itself", 1, 1, storage.ErrDependencyCycle},
+		{"Direct cycle", 2, 3, storage.ErrDependencyCycle},
+		{"Transitive cycle", 1, 3, storage.ErrDependencyCycle},
+		{"Missing task", 42, 1, storage.ErrNotFound},
+		{"Missing prerequisite", 1, 42, storage.ErrPrerequisiteNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.AddDependency(ctx, tt.id, tt.dependsOnID); err != tt.wantErr {
+				t.Errorf("toDoRepository.AddDependency() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	ids := func(list []*storage.ToDo) []int64 {
+		ids := []int64{}
+		for _, td := range list {
+			ids = append(ids, td.ID)
+		}
+		return ids
+	}
+	if list, err := r.Prerequisites(ctx, 3); err != nil || !reflect.DeepEqual(ids(list), []int64{2}) {
+		t.Errorf("toDoRepository.Prerequisites() = %v, %v, want [2]", ids(list), err)
+	}
+	if _, err := r.Prerequisites(ctx, 42); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Prerequisites() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	want := []*storage.Dependency{{ToDoID: 2, DependsOnID: 1}, {ToDoID: 3, DependsOnID: 2}}
+	if got, err := r.Dependencies(ctx); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoRepository.Dependencies() = %v, %v, want %v", got, err, want)
+	}
+
+	closed := []string{"DONE", "CANCELLED"}
+	if list, err := r.List(ctx, storage.ListOptions{Ready: true, Closed: closed}); err != nil || !reflect.DeepEqual(ids(list), []int64{2}) {
+		t.Errorf("toDoRepository.List() = %v, %v, want [2]", ids(list), err)
+	}
+	if list, err := r.List(ctx, storage.ListOptions{Ready: true}); err != nil || !reflect.DeepEqual(ids(list), []int64{1, 4}) {
+		t.Errorf("toDoRepository.List() = %v, %v, want [1 4]", ids(list), err)
+	}
+
+	if err := r.RemoveDependency(ctx, 3, 1); err != storage.ErrDependencyNotFound {
+		t.Errorf("toDoRepository.RemoveDependency() error = %v, want %v", err, storage.ErrDependencyNotFound)
+	}
+	if err := r.RemoveDependency(ctx, 3, 2); err != nil {
+		t.Errorf("toDoRepository.RemoveDependency() error = %v", err)
+	}
+	if list, err := r.List(ctx, storage.ListOptions{Ready: true, Closed: closed}); err != nil || !reflect.DeepEqual(ids(list), []int64{2, 3}) {
+		t.Errorf("toDoRepository.List() = %v, %v, want [2 3]", ids(list), err)
+	}
+
+	//dependencies of deleted todo entity are deleted with it
+	if _, err := r.Delete(ctx, 1, storage.DeleteRestrict); err != nil {
+		t.Fatalf("toDoRepository.Delete() error = %v", err)
+	}
+	if got, err := r.Dependencies(ctx); err != nil || len(got) != 0 {
+		t.Errorf("toDoRepository.Dependencies() = %v, %v, want []", got, err)
+	}
+}
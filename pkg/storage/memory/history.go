@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//AddHistory stores copy of history entry
+func (r *toDoRepository) AddHistory(ctx context.Context, e *storage.HistoryEntry) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastHistoryID++
+	stored := copyHistoryEntry(e)
+	stored.ID = r.lastHistoryID
+	r.history[stored.ToDoID] = append(r.history[stored.ToDoID], *stored)
+	return stored.ID, nil
+}
+
+//History returns copies of history entries of todo entity
+func (r *toDoRepository) History(ctx context.Context, todoID int64) ([]*storage.HistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*storage.HistoryEntry, 0, len(r.history[todoID]))
+	for i := range r.history[todoID] {
+		list = append(list, copyHistoryEntry(&r.history[todoID][i]))
+	}
+	return list, nil
+}
+
+//copyHistoryEntry returns copy of history entry which does not share the snapshot
+func copyHistoryEntry(e *storage.HistoryEntry) *storage.HistoryEntry {
+	c := *e
+	c.Snapshot = make(map[string]string, len(e.Snapshot))
+	for field, value := range e.Snapshot {
+		c.Snapshot[field] = value
+	}
+	return &c
+}
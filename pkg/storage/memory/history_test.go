@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestHistoryRepository(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+
+	e := &storage.HistoryEntry{ToDoID: 1, Action: storage.HistoryCreated, Snapshot: map[string]string{"title": "report"}, Actor: "alice"}
+	if id, err := r.AddHistory(ctx, e); err != nil || id != 1 {
+		t.Fatalf("toDoRepository.AddHistory() = %d, %v, want 1", id, err)
+	}
+	if id, err := r.AddHistory(ctx, &storage.HistoryEntry{ToDoID: 2, Action: storage.HistoryCreated}); err != nil || id != 2 {
+		t.Fatalf("toDoRepository.AddHistory() = %d, %v, want 2", id, err)
+	}
+
+	//stored entry is not changed through the caller's snapshot
+	e.Snapshot["title"] = "changed"
+	got, err := r.History(ctx, 1)
+	want := []*storage.HistoryEntry{{ID: 1, ToDoID: 1, Action: storage.HistoryCreated, Snapshot: map[string]string{"title": "report"}, Actor: "alice"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoRepository.History() = %v, %v, want %v", got, err, want)
+	}
+	if got, err := r.History(ctx, 42); err != nil || len(got) != 0 {
+		t.Errorf("toDoRepository.History() = %v, %v, want empty list", got, err)
+	}
+}
@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//AddLabels adds labels to todo entity
+func (r *toDoRepository) AddLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	td, ok := r.todos[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	td.Labels = withLabels(td.Labels, labels)
+	td.UpdatedAt = updatedAt
+	td.Version++
+	r.todos[id] = td
+	return nil
+}
+
+//RemoveLabels removes labels from todo entity
+func (r *toDoRepository) RemoveLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	td, ok := r.todos[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	removed := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		removed[l] = true
+	}
+	var kept []string
+	for _, l := range td.Labels {
+		if !removed[l] {
+			kept = append(kept, l)
+		}
+	}
+	td.Labels = kept
+	td.UpdatedAt = updatedAt
+	td.Version++
+	r.todos[id] = td
+	return nil
+}
+
+//ListLabels counts todo entities by label
+func (r *toDoRepository) ListLabels(ctx context.Context, projectID int64, user string) ([]*storage.LabelCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := map[string]int64{}
+	for _, td := range r.todos {
+		td := td
+		if projectID != 0 && td.ProjectID != projectID {
+			continue
+		}
+		if len(user) > 0 && !r.visible(&td, user) {
+			continue
+		}
+		for _, l := range td.Labels {
+			counts[l]++
+		}
+	}
+
+	list := make([]*storage.LabelCount, 0, len(counts))
+	for name, count := range counts {
+		list = append(list, &storage.LabelCount{Name: name, Count: count})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+//withLabels returns a new sorted slice of labels and added labels without duplicates, nil when it is empty.
+//Stored slices are never modified so copies of todo entities can share them.
+func withLabels(labels []string, added []string) []string {
+	set := make(map[string]bool, len(labels)+len(added))
+	var list []string
+	for _, l := range append(append([]string{}, labels...), added...) {
+		if !set[l] {
+			set[l] = true
+			list = append(list, l)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+//hasLabels reports whether labels contain all the wanted labels or any of them
+func hasLabels(labels []string, wanted []string, any bool) bool {
+	has := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		has[l] = true
+	}
+	for _, l := range wanted {
+		switch {
+		case any && has[l]:
+			return true
+		case !any && !has[l]:
+			return false
+		}
+	}
+	return !any
+}
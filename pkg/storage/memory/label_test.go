@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryLabels(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	tm := time.Now().In(time.UTC)
+
+	for _, td := range []*storage.ToDo{
+		{Title: "report", Labels: []string{"work", "urgent", "work"}},
+		{Title: "groceries", Labels: []string{"home"}},
+		{Title: "taxes", ProjectID: 0},
+	} {
+		if _, err := r.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+	if td, _ := r.Get(ctx, 1); !reflect.DeepEqual(td.Labels, []string{"urgent", "work"}) {
+		t.Errorf("toDoRepository.Create() labels = %v, want [urgent work]", td.Labels)
+	}
+
+	if err := r.AddLabels(ctx, 3, []string{"home", "urgent"}, tm); err != nil {
+		t.Fatalf("toDoRepository.AddLabels() error = %v", err)
+	}
+	if err := r.AddLabels(ctx, 42, []string{"home"}, tm); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.AddLabels() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	td, _ := r.Get(ctx, 3)
+	if !reflect.DeepEqual(td.Labels, []string{"home", "urgent"}) || !td.UpdatedAt.Equal(tm) {
+		t.Errorf("toDoRepository.AddLabels() = %v, %v", td.Labels, td.UpdatedAt)
+	}
+
+	//update keeps labels
+	if _, err := r.Update(ctx, &storage.ToDo{ID: 3, Title: "taxes"}); err != nil {
+		t.Fatalf("toDoRepository.Update() error = %v", err)
+	}
+	if td, _ := r.Get(ctx, 3); !reflect.DeepEqual(td.Labels, []string{"home", "urgent"}) {
+		t.Errorf("toDoRepository.Update() changed labels to %v", td.Labels)
+	}
+
+	ids := func(list []*storage.ToDo) []int64 {
+		ids := []int64{}
+		for _, td := range list {
+			ids = append(ids, td.ID)
+		}
+		return ids
+	}
+	tests := []struct {
+		name string
+		opts storage.ListOptions
+		want []int64
+	}{
+		{"All labels", storage.ListOptions{Labels: []string{"home", "urgent"}}, []int64{3}},
+		{"Any label", storage.ListOptions{Labels: []string{"home", "urgent"}, AnyLabel: true}, []int64{1, 2, 3}},
+		{"Unknown label", storage.ListOptions{Labels: []string{"garden"}}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if list, err := r.List(ctx, tt.opts); err != nil || !reflect.DeepEqual(ids(list), tt.want) {
+				t.Errorf("toDoRepository.List() = %v, %v, want %v", ids(list), err, tt.want)
+			}
+		})
+	}
+
+	want := []*storage.LabelCount{{Name: "home", Count: 2}, {Name: "urgent", Count: 2}, {Name: "work", Count: 1}}
+	if got, err := r.ListLabels(ctx, 0, ""); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+	}
+
+	if err := r.RemoveLabels(ctx, 3, []string{"urgent", "garden"}, tm); err != nil {
+		t.Fatalf("toDoRepository.RemoveLabels() error = %v", err)
+	}
+	if err := r.RemoveLabels(ctx, 42, []string{"home"}, tm); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.RemoveLabels() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if _, err := r.Delete(ctx, 2, storage.DeleteRestrict); err != nil {
+		t.Fatalf("toDoRepository.Delete() error = %v", err)
+	}
+	want = []*storage.LabelCount{{Name: "home", Count: 1}, {Name: "urgent", Count: 1}, {Name: "work", Count: 1}}
+	if got, err := r.ListLabels(ctx, 0, ""); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+	}
+	if got, err := r.ListLabels(ctx, 42, ""); err != nil || len(got) != 0 {
+		t.Errorf("toDoRepository.ListLabels() = %v, %v, want []", got, err)
+	}
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//nameTaken reports whether a project other than id already uses the name.
+//The caller must hold the lock.
+func (r *toDoRepository) nameTaken(name string, id int64) bool {
+	for _, p := range r.projects {
+		if p.Name == name && p.ID != id {
+			return true
+		}
+	}
+	return false
+}
+
+//CreateProject stores a new project
+func (r *toDoRepository) CreateProject(ctx context.Context, p *storage.Project) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nameTaken(p.Name, 0) {
+		return 0, storage.ErrProjectAlreadyExists
+	}
+
+	r.lastProjectID++
+	stored := *p
+	stored.ID = r.lastProjectID
+	r.projects[stored.ID] = stored
+	return stored.ID, nil
+}
+
+//GetProject returns copy of project by ID
+func (r *toDoRepository) GetProject(ctx context.Context, id int64) (*storage.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.projects[id]
+	if !ok {
+		return nil, storage.ErrProjectNotFound
+	}
+	return &p, nil
+}
+
+//UpdateProject replaces project identified by p.ID
+func (r *toDoRepository) UpdateProject(ctx context.Context, p *storage.Project) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.projects[p.ID]; !ok {
+		return 0, storage.ErrProjectNotFound
+	}
+	if r.nameTaken(p.Name, p.ID) {
+		return 0, storage.ErrProjectAlreadyExists
+	}
+
+	r.projects[p.ID] = *p
+	return 1, nil
+}
+
+//DeleteProject removes project by ID and its todo entities when cascade is true
+func (r *toDoRepository) DeleteProject(ctx context.Context, id int64, cascade bool) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.projects[id]; !ok {
+		return 0, storage.ErrProjectNotFound
+	}
+
+	var tasks []int64
+	for _, todos := range []map[int64]storage.ToDo{r.todos, r.trashed} {
+		for _, td := range todos {
+			if td.ProjectID == id {
+				tasks = append(tasks, td.ID)
+			}
+		}
+	}
+	if len(tasks) > 0 && !cascade {
+		return 0, storage.ErrProjectNotEmpty
+	}
+
+	for _, taskID := range tasks {
+		r.delete(taskID)
+	}
+	//subtasks of deleted todo entities in other projects become top level todo entities
+	for _, todos := range []map[int64]storage.ToDo{r.todos, r.trashed} {
+		for _, td := range todos {
+			_, live := r.todos[td.ParentID]
+			_, trashed := r.trashed[td.ParentID]
+			if td.ParentID != 0 && !live && !trashed {
+				td.ParentID = 0
+				td.Version++
+				todos[td.ID] = td
+			}
+		}
+	}
+	delete(r.projects, id)
+	return int64(len(tasks)), nil
+}
+
+//ListProjects returns copies of projects sorted by name
+func (r *toDoRepository) ListProjects(ctx context.Context, includeArchived bool) ([]*storage.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*storage.Project, 0, len(r.projects))
+	for _, p := range r.projects {
+		p := p
+		if p.Archived && !includeArchived {
+			continue
+		}
+		list = append(list, &p)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Name != list[j].Name {
+			return list[i].Name < list[j].Name
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestProjectRepository(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	tm := time.Now().In(time.UTC)
+	p := &storage.Project{Name: "work", Description: "description", CreatedAt: tm, UpdatedAt: tm}
+
+	id, err := r.CreateProject(ctx, p)
+	if err != nil || id != 1 {
+		t.Fatalf("toDoRepository.CreateProject() = %d, %v, want 1", id, err)
+	}
+	if _, err := r.CreateProject(ctx, p); err != storage.ErrProjectAlreadyExists {
+		t.Errorf("toDoRepository.CreateProject() error = %v, want %v", err, storage.ErrProjectAlreadyExists)
+	}
+	archivedID, err := r.CreateProject(ctx, &storage.Project{Name: "archive", Archived: true})
+	if err != nil {
+		t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+	}
+
+	got, err := r.GetProject(ctx, id)
+	want := *p
+	want.ID = id
+	if err != nil || !reflect.DeepEqual(got, &want) {
+		t.Errorf("toDoRepository.GetProject() = %v, %v, want %v", got, err, &want)
+	}
+	if _, err := r.GetProject(ctx, 42); err != storage.ErrProjectNotFound {
+		t.Errorf("toDoRepository.GetProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+	}
+
+	if _, err := r.UpdateProject(ctx, &storage.Project{ID: archivedID, Name: "work"}); err != storage.ErrProjectAlreadyExists {
+		t.Errorf("toDoRepository.UpdateProject() error = %v, want %v", err, storage.ErrProjectAlreadyExists)
+	}
+	if _, err := r.UpdateProject(ctx, &storage.Project{ID: 42, Name: "missing"}); err != storage.ErrProjectNotFound {
+		t.Errorf("toDoRepository.UpdateProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+	}
+
+	list, err := r.ListProjects(ctx, false)
+	if err != nil || len(list) != 1 || list[0].ID != id {
+		t.Errorf("toDoRepository.ListProjects() = %v, %v, want [%v]", list, err, &want)
+	}
+	list, err = r.ListProjects(ctx, true)
+	if err != nil || len(list) != 2 || list[0].ID != archivedID || list[1].ID != id {
+		t.Errorf("toDoRepository.ListProjects() returned %v, %v, want projects sorted by name", list, err)
+	}
+
+	//titles are unique in a project
+	for _, td := range []*storage.ToDo{{Title: "title"}, {Title: "title", ProjectID: id}, {Title: "other", ProjectID: id}} {
+		if _, err := r.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "title", ProjectID: id}); err != storage.ErrAlreadyExists {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+	tasks, err := r.List(ctx, storage.ListOptions{ProjectID: id})
+	if err != nil || len(tasks) != 2 || tasks[0].ID != 2 || tasks[1].ID != 3 {
+		t.Errorf("toDoRepository.List() = %v, %v, want tasks of the project", tasks, err)
+	}
+
+	if _, err := r.DeleteProject(ctx, id, false); err != storage.ErrProjectNotEmpty {
+		t.Errorf("toDoRepository.DeleteProject() error = %v, want %v", err, storage.ErrProjectNotEmpty)
+	}
+	if n, err := r.DeleteProject(ctx, id, true); err != nil || n != 2 {
+		t.Errorf("toDoRepository.DeleteProject() = %d, %v, want 2", n, err)
+	}
+	if tasks, err := r.List(ctx, storage.ListOptions{}); err != nil || len(tasks) != 1 || tasks[0].ID != 1 {
+		t.Errorf("toDoRepository.List() = %v, %v, want task without project", tasks, err)
+	}
+	if n, err := r.DeleteProject(ctx, archivedID, false); err != nil || n != 0 {
+		t.Errorf("toDoRepository.DeleteProject() = %d, %v, want 0", n, err)
+	}
+	if _, err := r.DeleteProject(ctx, id, true); err != storage.ErrProjectNotFound {
+		t.Errorf("toDoRepository.DeleteProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+	}
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Share grants the user the role on todo entity
+func (r *toDoRepository) Share(ctx context.Context, id int64, user string, role storage.ShareRole) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return storage.ErrNotFound
+	}
+	if r.shares[id] == nil {
+		r.shares[id] = make(map[string]storage.ShareRole)
+	}
+	r.shares[id][user] = role
+	return nil
+}
+
+//Unshare revokes access of the user to todo entity
+func (r *toDoRepository) Unshare(ctx context.Context, id int64, user string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.shares[id][user]; !ok {
+		return storage.ErrShareNotFound
+	}
+	delete(r.shares[id], user)
+	if len(r.shares[id]) == 0 {
+		delete(r.shares, id)
+	}
+	return nil
+}
+
+//Shares returns shares of todo entity sorted by user
+func (r *toDoRepository) Shares(ctx context.Context, id int64) ([]*storage.Share, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return nil, storage.ErrNotFound
+	}
+	list := make([]*storage.Share, 0, len(r.shares[id]))
+	for user, role := range r.shares[id] {
+		list = append(list, &storage.Share{ToDoID: id, User: user, Role: role})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].User < list[j].User })
+	return list, nil
+}
+
+//ShareRole returns role of the user on todo entity
+func (r *toDoRepository) ShareRole(ctx context.Context, id int64, user string) (storage.ShareRole, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.shares[id][user], nil
+}
+
+//AssignOwner sets owner of todo entities without owner
+func (r *toDoRepository) AssignOwner(ctx context.Context, owner string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var assigned int64
+	for _, todos := range []map[int64]storage.ToDo{r.todos, r.trashed} {
+		for id, td := range todos {
+			if len(td.Owner) == 0 {
+				td.Owner = owner
+				todos[id] = td
+				assigned++
+			}
+		}
+	}
+	return assigned, nil
+}
+
+//visible reports whether todo entity is owned by or shared with the user.
+//The caller must hold the lock.
+func (r *toDoRepository) visible(td *storage.ToDo, user string) bool {
+	if td.Owner == user {
+		return true
+	}
+	_, ok := r.shares[td.ID][user]
+	return ok
+}
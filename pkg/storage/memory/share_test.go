@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryShares(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	tm := time.Date(2020, 6, 20, 10, 0, 0, 0, time.UTC)
+	create := func(td *storage.ToDo) int64 {
+		id, err := r.Create(ctx, td)
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		return id
+	}
+	ids := func(opts storage.ListOptions) []int64 {
+		list, err := r.List(ctx, opts)
+		if err != nil {
+			t.Fatalf("toDoRepository.List() error = %v", err)
+		}
+		ids := []int64{}
+		for _, td := range list {
+			ids = append(ids, td.ID)
+		}
+		return ids
+	}
+
+	legacy := create(&storage.ToDo{Title: "legacy"})
+	report := create(&storage.ToDo{Title: "report", Owner: "alice", Labels: []string{"work"}})
+	//titles are unique among todo entities of one owner
+	other := create(&storage.ToDo{Title: "report", Owner: "bob"})
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "report", Owner: "alice"}); err != storage.ErrAlreadyExists {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	if n, err := r.AssignOwner(ctx, "nobody"); err != nil || n != 1 {
+		t.Errorf("toDoRepository.AssignOwner() = %d, %v, want 1", n, err)
+	}
+	if n, err := r.AssignOwner(ctx, "nobody"); err != nil || n != 0 {
+		t.Errorf("toDoRepository.AssignOwner() = %d, %v, want 0", n, err)
+	}
+	if td, err := r.Get(ctx, legacy); err != nil || td.Owner != "nobody" {
+		t.Errorf("toDoRepository.Get() = %v, %v, want owner nobody", td, err)
+	}
+
+	//update does not change owner
+	if _, err := r.Update(ctx, &storage.ToDo{ID: report, Title: "report", Owner: "bob"}); err != nil {
+		t.Fatalf("toDoRepository.Update() error = %v", err)
+	}
+	if td, _ := r.Get(ctx, report); td.Owner != "alice" {
+		t.Errorf("toDoRepository.Update() changed owner to %s", td.Owner)
+	}
+
+	if err := r.Share(ctx, report, "bob", storage.ShareViewer); err != nil {
+		t.Fatalf("toDoRepository.Share() error = %v", err)
+	}
+	if err := r.Share(ctx, report, "bob", storage.ShareEditor); err != nil {
+		t.Fatalf("toDoRepository.Share() error = %v", err)
+	}
+	if err := r.Share(ctx, report, "carol", storage.ShareViewer); err != nil {
+		t.Fatalf("toDoRepository.Share() error = %v", err)
+	}
+	if err := r.Share(ctx, 42, "bob", storage.ShareViewer); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Share() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if role, err := r.ShareRole(ctx, report, "bob"); err != nil || role != storage.ShareEditor {
+		t.Errorf("toDoRepository.ShareRole() = %v, %v, want %v", role, err, storage.ShareEditor)
+	}
+	if role, err := r.ShareRole(ctx, other, "alice"); err != nil || role != "" {
+		t.Errorf("toDoRepository.ShareRole() = %v, %v, want no role", role, err)
+	}
+
+	shares, err := r.Shares(ctx, report)
+	want := []*storage.Share{
+		{ToDoID: report, User: "bob", Role: storage.ShareEditor},
+		{ToDoID: report, User: "carol", Role: storage.ShareViewer},
+	}
+	if err != nil || !reflect.DeepEqual(shares, want) {
+		t.Errorf("toDoRepository.Shares() = %v, %v, want %v", shares, err, want)
+	}
+	if _, err := r.Shares(ctx, 42); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Shares() error = %v, want %v", err, storage.ErrNotFound)
+	}
+
+	for _, tt := range []struct {
+		user string
+		want []int64
+	}{
+		{"alice", []int64{report}},
+		{"bob", []int64{report, other}},
+		{"nobody", []int64{legacy}},
+		{"dave", []int64{}},
+		{"", []int64{legacy, report, other}},
+	} {
+		if got := ids(storage.ListOptions{VisibleTo: tt.user}); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("toDoRepository.List() visible to %q = %v, want %v", tt.user, got, tt.want)
+		}
+	}
+	if got := ids(storage.ListOptions{IDs: []int64{other, legacy}, VisibleTo: "bob"}); !reflect.DeepEqual(got, []int64{other}) {
+		t.Errorf("toDoRepository.List() of IDs visible to bob = %v, want %v", got, []int64{other})
+	}
+	if labels, err := r.ListLabels(ctx, 0, "carol"); err != nil || len(labels) != 1 {
+		t.Errorf("toDoRepository.ListLabels() = %v, %v, want label of shared todo entity", labels, err)
+	}
+	if labels, err := r.ListLabels(ctx, 0, "dave"); err != nil || len(labels) != 0 {
+		t.Errorf("toDoRepository.ListLabels() = %v, %v, want no labels", labels, err)
+	}
+
+	if err := r.Unshare(ctx, report, "carol"); err != nil {
+		t.Fatalf("toDoRepository.Unshare() error = %v", err)
+	}
+	if err := r.Unshare(ctx, report, "carol"); err != storage.ErrShareNotFound {
+		t.Errorf("toDoRepository.Unshare() error = %v, want %v", err, storage.ErrShareNotFound)
+	}
+
+	//shares stay with todo entity in the trash and are deleted with it
+	if _, err := r.Trash(ctx, report, 0, storage.DeleteRestrict, tm); err != nil {
+		t.Fatalf("toDoRepository.Trash() error = %v", err)
+	}
+	if td, err := r.Trashed(ctx, report); err != nil || td.Owner != "alice" {
+		t.Errorf("toDoRepository.Trashed() = %v, %v, want todo entity of alice", td, err)
+	}
+	if _, err := r.Trashed(ctx, other); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Trashed() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if role, _ := r.ShareRole(ctx, report, "bob"); role != storage.ShareEditor {
+		t.Errorf("toDoRepository.ShareRole() = %v, want %v", role, storage.ShareEditor)
+	}
+	if n, err := r.Purge(ctx, tm.Add(time.Second)); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Purge() = %d, %v, want 1", n, err)
+	}
+	if role, _ := r.ShareRole(ctx, report, "bob"); role != "" {
+		t.Errorf("toDoRepository.ShareRole() = %v, want no role of purged todo entity", role)
+	}
+}
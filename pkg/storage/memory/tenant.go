@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//CreateTenant stores a new tenant
+func (r *toDoRepository) CreateTenant(ctx context.Context, t *storage.Tenant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tenants[t.ID]; ok {
+		return storage.ErrTenantAlreadyExists
+	}
+	r.tenants[t.ID] = *t
+	return nil
+}
+
+//GetTenant returns copy of tenant by ID
+func (r *toDoRepository) GetTenant(ctx context.Context, id string) (*storage.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.tenants[id]
+	if !ok {
+		return nil, storage.ErrTenantNotFound
+	}
+	return &t, nil
+}
+
+//UpdateTenant replaces tenant identified by t.ID, its creation time is kept
+func (r *toDoRepository) UpdateTenant(ctx context.Context, t *storage.Tenant) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, ok := r.tenants[t.ID]
+	if !ok {
+		return 0, storage.ErrTenantNotFound
+	}
+	stored := *t
+	stored.CreatedAt = old.CreatedAt
+	r.tenants[t.ID] = stored
+	return 1, nil
+}
+
+//ListTenants returns copies of tenants sorted by ID
+func (r *toDoRepository) ListTenants(ctx context.Context) ([]*storage.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*storage.Tenant, 0, len(r.tenants))
+	for _, t := range r.tenants {
+		t := t
+		list = append(list, &t)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+//DeleteTenant removes tenant and its dataset
+func (r *toDoRepository) DeleteTenant(ctx context.Context, id string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tenants[id]; !ok {
+		return 0, storage.ErrTenantNotFound
+	}
+	delete(r.tenants, id)
+
+	d, ok := r.datasets[id]
+	if !ok {
+		return 0, nil
+	}
+	deleted := int64(len(d.todos) + len(d.trashed))
+	delete(r.datasets, id)
+	//repositories of the tenant still in use don't find the deleted data
+	*d = *newDataset()
+	return deleted, nil
+}
+
+//ForTenant returns repository of the dataset of the tenant, the dataset is created on first use
+func (r *toDoRepository) ForTenant(id string) storage.Repository {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.datasets[id]
+	if !ok {
+		d = newDataset()
+		r.datasets[id] = d
+	}
+	return &toDoRepository{shared: r.shared, dataset: d, tenant: id}
+}
@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryTenants(t *testing.T) {
+	ctx := context.Background()
+	root := NewToDoRepository()
+	tm := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, tenant := range []*storage.Tenant{
+		{ID: "blue", Name: "Blue team", MaxToDos: 10, CreatedAt: tm, UpdatedAt: tm},
+		{ID: "red", Name: "Red team", CreatedAt: tm, UpdatedAt: tm},
+	} {
+		if err := root.CreateTenant(ctx, tenant); err != nil {
+			t.Fatalf("toDoRepository.CreateTenant() error = %v", err)
+		}
+	}
+	if err := root.CreateTenant(ctx, &storage.Tenant{ID: "red"}); err != storage.ErrTenantAlreadyExists {
+		t.Errorf("toDoRepository.CreateTenant() error = %v, want %v", err, storage.ErrTenantAlreadyExists)
+	}
+	if _, err := root.GetTenant(ctx, "green"); err != storage.ErrTenantNotFound {
+		t.Errorf("toDoRepository.GetTenant() error = %v, want %v", err, storage.ErrTenantNotFound)
+	}
+
+	//suspension keeps the creation time
+	if _, err := root.UpdateTenant(ctx, &storage.Tenant{ID: "red", Name: "Red team", Suspended: true, UpdatedAt: tm.Add(time.Hour)}); err != nil {
+		t.Fatalf("toDoRepository.UpdateTenant() error = %v", err)
+	}
+	want := &storage.Tenant{ID: "red", Name: "Red team", Suspended: true, CreatedAt: tm, UpdatedAt: tm.Add(time.Hour)}
+	if got, err := root.GetTenant(ctx, "red"); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("toDoRepository.GetTenant() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := root.UpdateTenant(ctx, &storage.Tenant{ID: "green"}); err != storage.ErrTenantNotFound {
+		t.Errorf("toDoRepository.UpdateTenant() error = %v, want %v", err, storage.ErrTenantNotFound)
+	}
+	if list, err := root.ListTenants(ctx); err != nil || len(list) != 2 || list[0].ID != "blue" || list[1].ID != "red" {
+		t.Errorf("toDoRepository.ListTenants() = %v, %v, want blue and red", list, err)
+	}
+
+	blue, red := root.ForTenant("blue"), root.ForTenant("red")
+	create := func(r storage.Repository, td *storage.ToDo) int64 {
+		id, err := r.Create(ctx, td)
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		return id
+	}
+	//titles are unique within a tenant only
+	report := create(blue, &storage.ToDo{Title: "report", Reminder: tm})
+	create(red, &storage.ToDo{Title: "report", Reminder: tm})
+	create(root, &storage.ToDo{Title: "report"})
+	if _, err := red.Create(ctx, &storage.ToDo{Title: "subtask", ParentID: report}); err != storage.ErrParentNotFound {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrParentNotFound)
+	}
+	if _, err := blue.CreateProject(ctx, &storage.Project{Name: "work"}); err != nil {
+		t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+	}
+
+	if td, err := blue.Get(ctx, report); err != nil || td.Tenant != "blue" {
+		t.Errorf("toDoRepository.Get() = %v, %v, want todo entity of blue", td, err)
+	}
+	if _, err := red.Get(ctx, report); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if _, err := red.Delete(ctx, report, storage.DeleteRestrict); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Delete() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if projects, err := red.ListProjects(ctx, true); err != nil || len(projects) != 0 {
+		t.Errorf("toDoRepository.ListProjects() = %v, %v, want no projects", projects, err)
+	}
+	for _, tt := range []struct {
+		name string
+		r    storage.Repository
+		want int64
+	}{
+		{"blue", blue, 1},
+		{"red", red, 1},
+		{"no tenant", root, 1},
+	} {
+		if n, err := tt.r.CountToDos(ctx); err != nil || n != tt.want {
+			t.Errorf("toDoRepository.CountToDos() of %s = %d, %v, want %d", tt.name, n, err, tt.want)
+		}
+	}
+
+	//reminders are sent for all tenants
+	if due, err := root.DueReminders(ctx, tm, nil); err != nil || len(due) != 2 {
+		t.Errorf("toDoRepository.DueReminders() = %v, %v, want 2 reminders", due, err)
+	}
+
+	if n, err := root.DeleteTenant(ctx, "blue"); err != nil || n != 1 {
+		t.Errorf("toDoRepository.DeleteTenant() = %d, %v, want 1", n, err)
+	}
+	if _, err := root.DeleteTenant(ctx, "blue"); err != storage.ErrTenantNotFound {
+		t.Errorf("toDoRepository.DeleteTenant() error = %v, want %v", err, storage.ErrTenantNotFound)
+	}
+	if _, err := blue.Get(ctx, report); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if n, err := root.ForTenant("blue").CountToDos(ctx); err != nil || n != 0 {
+		t.Errorf("toDoRepository.CountToDos() = %d, %v, want 0", n, err)
+	}
+	if n, err := red.CountToDos(ctx); err != nil || n != 1 {
+		t.Errorf("toDoRepository.CountToDos() = %d, %v, want 1", n, err)
+	}
+}
@@ -0,0 +1,568 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//toDoRepository is a storage.Repository which keeps todo entities and projects in process memory.
+//It follows the constraints of the SQL schema: IDs are auto incremented, titles are unique among todo entities
+//of the owner in a project and project names are unique. Every tenant has its own dataset,
+//the repository works with the dataset of the tenant it is scoped to.
+type toDoRepository struct {
+	*shared
+	*dataset
+
+	//tenant is the tenant the dataset belongs to, empty for data stored without tenant
+	tenant string
+}
+
+//shared holds what repositories of all tenants share
+type shared struct {
+	mu sync.RWMutex
+
+	//lastID is the last assigned todo entity ID
+	lastID int64
+
+	//lastProjectID is the last assigned project ID
+	lastProjectID int64
+
+	//lastHistoryID is the last assigned history entry ID
+	lastHistoryID int64
+
+	//tenants holds tenants by ID
+	tenants map[string]storage.Tenant
+
+	//datasets holds datasets by tenant ID
+	datasets map[string]*dataset
+}
+
+//dataset holds todo entities, projects, the change history and shares of one tenant
+type dataset struct {
+	//todos holds todo entities by ID
+	todos map[int64]storage.ToDo
+
+	//trashed holds todo entities in the trash by ID, they are kept apart so that no other method finds them
+	trashed map[int64]storage.ToDo
+
+	//completions holds completion history by todo entity ID
+	completions map[int64][]storage.Completion
+
+	//remindersSent holds delivery time of reminder by todo entity ID
+	remindersSent map[int64]time.Time
+
+	//reminderClaims holds the time claim of reminder lasts until by todo entity ID
+	reminderClaims map[int64]time.Time
+
+	//prerequisites holds IDs of todo entities a todo entity depends on by todo entity ID
+	prerequisites map[int64]map[int64]bool
+
+	//projects holds projects by ID
+	projects map[int64]storage.Project
+
+	//history holds history entries by todo entity ID from the oldest to the latest
+	history map[int64][]storage.HistoryEntry
+
+	//shares holds roles of users todo entity is shared with by todo entity ID
+	shares map[int64]map[string]storage.ShareRole
+}
+
+//NewToDoRepository creates empty in-memory ToDo repository
+func NewToDoRepository() storage.Repository {
+	s := &shared{
+		tenants:  make(map[string]storage.Tenant),
+		datasets: map[string]*dataset{"": newDataset()},
+	}
+	return &toDoRepository{shared: s, dataset: s.datasets[""]}
+}
+
+//newDataset creates empty dataset
+func newDataset() *dataset {
+	return &dataset{
+		todos:          make(map[int64]storage.ToDo),
+		trashed:        make(map[int64]storage.ToDo),
+		completions:    make(map[int64][]storage.Completion),
+		remindersSent:  make(map[int64]time.Time),
+		reminderClaims: make(map[int64]time.Time),
+		prerequisites:  make(map[int64]map[int64]bool),
+		projects:       make(map[int64]storage.Project),
+		history:        make(map[int64][]storage.HistoryEntry),
+		shares:         make(map[int64]map[string]storage.ShareRole),
+	}
+}
+
+//titleTaken reports whether a todo entity of the owner other than id already uses the title in the project,
+//titles of todo entities in the trash are in use too. The caller must hold the lock.
+func (r *toDoRepository) titleTaken(owner string, projectID int64, title string, id int64) bool {
+	for _, todos := range []map[int64]storage.ToDo{r.todos, r.trashed} {
+		for _, td := range todos {
+			if td.Owner == owner && td.ProjectID == projectID && td.Title == title && td.ID != id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//Create stores a new todo entity
+func (r *toDoRepository) Create(ctx context.Context, td *storage.ToDo) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.create(td)
+}
+
+//create stores a new todo entity, the caller must hold the lock
+func (r *toDoRepository) create(td *storage.ToDo) (int64, error) {
+	if r.titleTaken(td.Owner, td.ProjectID, td.Title, 0) {
+		return 0, storage.ErrAlreadyExists
+	}
+	if _, ok := r.todos[td.ParentID]; td.ParentID != 0 && !ok {
+		return 0, storage.ErrParentNotFound
+	}
+
+	r.lastID++
+	td.Version = 1
+	stored := *td
+	stored.ID = r.lastID
+	stored.Tenant = r.tenant
+	stored.Labels = withLabels(nil, td.Labels)
+	r.todos[stored.ID] = stored
+	if !stored.ActualTimeOfCompletion.IsZero() {
+		r.completions[stored.ID] = []storage.Completion{{CompletedAt: stored.ActualTimeOfCompletion}}
+	}
+	return stored.ID, nil
+}
+
+//Get returns copy of todo entity by ID
+func (r *toDoRepository) Get(ctx context.Context, id int64) (*storage.ToDo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	td, ok := r.todos[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &td, nil
+}
+
+//Update replaces todo entity identified by td.ID
+func (r *toDoRepository) Update(ctx context.Context, td *storage.ToDo) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.update(td)
+}
+
+//update replaces todo entity identified by td.ID, the caller must hold the lock
+func (r *toDoRepository) update(td *storage.ToDo) (int64, error) {
+	old, ok := r.todos[td.ID]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+	if td.Version != 0 && td.Version != old.Version {
+		return 0, storage.ErrVersionMismatch
+	}
+	if r.titleTaken(old.Owner, td.ProjectID, td.Title, td.ID) {
+		return 0, storage.ErrAlreadyExists
+	}
+
+	td.Version = old.Version + 1
+	stored := *td
+	stored.ParentID = old.ParentID
+	stored.Labels = old.Labels
+	stored.Owner = old.Owner
+	stored.Tenant = old.Tenant
+	r.todos[td.ID] = stored
+	if !old.Reminder.Equal(td.Reminder) {
+		delete(r.remindersSent, td.ID)
+		delete(r.reminderClaims, td.ID)
+	}
+
+	completions := r.completions[td.ID]
+	switch {
+	case old.ActualTimeOfCompletion.IsZero() && !td.ActualTimeOfCompletion.IsZero():
+		r.completions[td.ID] = append(completions, storage.Completion{CompletedAt: td.ActualTimeOfCompletion})
+	case !old.ActualTimeOfCompletion.IsZero() && td.ActualTimeOfCompletion.IsZero() && len(completions) > 0:
+		completions[len(completions)-1].ReopenedAt = td.UpdatedAt
+	}
+	return 1, nil
+}
+
+//Delete removes todo entity by ID and handles its subtasks according to the mode
+func (r *toDoRepository) Delete(ctx context.Context, id int64, mode storage.DeleteMode) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.remove(id, mode)
+}
+
+//remove removes todo entity by ID and handles its subtasks according to the mode, the caller must hold the lock
+func (r *toDoRepository) remove(id int64, mode storage.DeleteMode) (int64, error) {
+	td, ok := r.todos[id]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+
+	children := r.children(id)
+	switch {
+	case len(children) == 0:
+	case mode == storage.DeleteCascade:
+		descendants := r.descendants(id)
+		for _, d := range descendants {
+			r.delete(d.ID)
+		}
+		r.delete(id)
+		return int64(len(descendants) + 1), nil
+	case mode == storage.DeleteReparent:
+		for _, child := range children {
+			child.ParentID = td.ParentID
+			child.Version++
+			r.todos[child.ID] = *child
+		}
+	default:
+		return 0, storage.ErrHasChildren
+	}
+
+	r.delete(id)
+	return 1, nil
+}
+
+//Move makes todo entity a subtask of another todo entity
+func (r *toDoRepository) Move(ctx context.Context, id int64, parentID int64, updatedAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	td, ok := r.todos[id]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+	//walk up from the new parent, the todo entity must not be met on the way
+	for ancestor := parentID; ancestor != 0; {
+		if ancestor == id {
+			return 0, storage.ErrCycle
+		}
+		parent, ok := r.todos[ancestor]
+		if !ok {
+			return 0, storage.ErrParentNotFound
+		}
+		ancestor = parent.ParentID
+	}
+
+	td.ParentID = parentID
+	td.UpdatedAt = updatedAt
+	td.Version++
+	r.todos[id] = td
+	return 1, nil
+}
+
+//Descendants returns copies of subtasks of todo entity at all levels
+func (r *toDoRepository) Descendants(ctx context.Context, id int64) ([]*storage.ToDo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return nil, storage.ErrNotFound
+	}
+	return r.descendants(id), nil
+}
+
+//children returns copies of direct subtasks of todo entity sorted by ID.
+//The caller must hold the lock.
+func (d *dataset) children(id int64) []*storage.ToDo {
+	list := []*storage.ToDo{}
+	for _, td := range d.todos {
+		td := td
+		if td.ParentID == id {
+			list = append(list, &td)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+//descendants returns copies of subtasks of todo entity at all levels in breadth-first order.
+//The caller must hold the lock.
+func (d *dataset) descendants(id int64) []*storage.ToDo {
+	list := d.children(id)
+	for i := 0; i < len(list); i++ {
+		list = append(list, d.children(list[i].ID)...)
+	}
+	return list
+}
+
+//delete removes todo entity, its history, dependencies and shares.
+//The caller must hold the lock.
+func (d *dataset) delete(id int64) {
+	delete(d.todos, id)
+	delete(d.trashed, id)
+	delete(d.completions, id)
+	delete(d.remindersSent, id)
+	delete(d.reminderClaims, id)
+	delete(d.prerequisites, id)
+	delete(d.shares, id)
+	for _, prerequisites := range d.prerequisites {
+		delete(prerequisites, id)
+	}
+}
+
+//Completions returns copy of completion history of todo entity
+func (r *toDoRepository) Completions(ctx context.Context, id int64) ([]*storage.Completion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	list := make([]*storage.Completion, 0, len(r.completions[id]))
+	for _, c := range r.completions[id] {
+		c := c
+		list = append(list, &c)
+	}
+	return list, nil
+}
+
+//DueReminders returns copies of open todo entities of all tenants which reminder is due and not delivered
+func (r *toDoRepository) DueReminders(ctx context.Context, until time.Time, closed []string) ([]*storage.ToDo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := []*storage.ToDo{}
+	for _, d := range r.datasets {
+		for id, td := range d.todos {
+			td := td
+			if td.Reminder.IsZero() || td.Reminder.After(until) || isClosed(td.Status, closed) {
+				continue
+			}
+			if _, ok := d.remindersSent[id]; ok {
+				continue
+			}
+			if claimedUntil, ok := d.reminderClaims[id]; ok && claimedUntil.After(until) {
+				continue
+			}
+			list = append(list, &td)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if c := compareTime(list[i].Reminder, list[j].Reminder); c != 0 {
+			return c < 0
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+//ClaimReminder claims the current reminder of todo entity of any tenant until leaseUntil
+func (r *toDoRepository) ClaimReminder(ctx context.Context, id int64, reminder time.Time, now time.Time, leaseUntil time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, err := r.pendingReminder(id, reminder)
+	if err != nil {
+		return err
+	}
+	if claimedUntil, ok := d.reminderClaims[id]; ok && claimedUntil.After(now) {
+		return storage.ErrNotFound
+	}
+	d.reminderClaims[id] = leaseUntil
+	return nil
+}
+
+//ReleaseReminder drops the claim of the current reminder of todo entity of any tenant
+func (r *toDoRepository) ReleaseReminder(ctx context.Context, id int64, reminder time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, err := r.pendingReminder(id, reminder)
+	if err != nil {
+		return err
+	}
+	delete(d.reminderClaims, id)
+	return nil
+}
+
+//MarkReminderSent records delivery of the current reminder of todo entity of any tenant
+func (r *toDoRepository) MarkReminderSent(ctx context.Context, id int64, reminder time.Time, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, err := r.pendingReminder(id, reminder)
+	if err != nil {
+		return err
+	}
+	d.remindersSent[id] = sentAt
+	delete(d.reminderClaims, id)
+	return nil
+}
+
+//pendingReminder returns dataset of todo entity of any tenant which Reminder is the reminder and is not delivered
+//or ErrNotFound. The caller must hold the lock.
+func (r *toDoRepository) pendingReminder(id int64, reminder time.Time) (*dataset, error) {
+	for _, d := range r.datasets {
+		td, ok := d.todos[id]
+		if !ok {
+			continue
+		}
+		if !td.Reminder.Equal(reminder) {
+			return nil, storage.ErrNotFound
+		}
+		if _, ok := d.remindersSent[id]; ok {
+			return nil, storage.ErrNotFound
+		}
+		return d, nil
+	}
+	return nil, storage.ErrNotFound
+}
+
+//CountToDos returns number of todo entities including those in the trash
+func (r *toDoRepository) CountToDos(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.todos) + len(r.trashed)), nil
+}
+
+//List returns copies of todo entities matching the options
+func (r *toDoRepository) List(ctx context.Context, opts storage.ListOptions) ([]*storage.ToDo, error) {
+	sortBy := opts.SortBy
+	if sortBy == "" {
+		sortBy = storage.SortByID
+	}
+	if !sortable(sortBy) {
+		return nil, fmt.Errorf("unsupported sort field '%s'", sortBy)
+	}
+
+	//less reports whether a goes before b in the requested order
+	less := func(a, b *storage.ToDo) bool {
+		c := compare(a, b, sortBy)
+		if c == 0 {
+			c = compare(a, b, storage.SortByID)
+		}
+		if opts.Descending {
+			return c > 0
+		}
+		return c < 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sources := []map[int64]storage.ToDo{r.todos}
+	if opts.ShowDeleted {
+		sources = append(sources, r.trashed)
+	}
+
+	var ids map[int64]bool
+	if len(opts.IDs) > 0 {
+		ids = make(map[int64]bool, len(opts.IDs))
+		for _, id := range opts.IDs {
+			ids[id] = true
+		}
+	}
+
+	list := make([]*storage.ToDo, 0, len(r.todos))
+	for _, todos := range sources {
+		for _, td := range todos {
+			td := td
+			if ids != nil && !ids[td.ID] {
+				continue
+			}
+			if !matches(&td, opts) {
+				continue
+			}
+			if len(opts.VisibleTo) > 0 && !r.visible(&td, opts.VisibleTo) {
+				continue
+			}
+			if opts.Ready && !r.ready(&td, opts.Closed) {
+				continue
+			}
+			if opts.After != nil && !less(opts.After, &td) {
+				continue
+			}
+			list = append(list, &td)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return less(list[i], list[j]) })
+
+	if opts.Limit > 0 && len(list) > opts.Limit {
+		list = list[:opts.Limit]
+	}
+	return list, nil
+}
+
+//matches reports whether todo entity passes filters of the options
+func matches(td *storage.ToDo, opts storage.ListOptions) bool {
+	if len(opts.Status) > 0 && td.Status != opts.Status {
+		return false
+	}
+	if opts.ProjectID != 0 && td.ProjectID != opts.ProjectID {
+		return false
+	}
+	if opts.ParentID != 0 && td.ParentID != opts.ParentID {
+		return false
+	}
+	if len(opts.Labels) > 0 && !hasLabels(td.Labels, opts.Labels, opts.AnyLabel) {
+		return false
+	}
+	if !opts.ReminderFrom.IsZero() && td.Reminder.Before(opts.ReminderFrom) {
+		return false
+	}
+	if !opts.ReminderTo.IsZero() && !td.Reminder.Before(opts.ReminderTo) {
+		return false
+	}
+	if !opts.DueFrom.IsZero() && td.EstimatedTimeOfCompletion.Before(opts.DueFrom) {
+		return false
+	}
+	if !opts.DueTo.IsZero() && !td.EstimatedTimeOfCompletion.Before(opts.DueTo) {
+		return false
+	}
+	return true
+}
+
+//sortable reports whether the field is supported by compare
+func sortable(sortBy storage.SortField) bool {
+	switch sortBy {
+	case storage.SortByID, storage.SortByTitle, storage.SortByStatus, storage.SortByEstimatedTimeOfCompletion, storage.SortByReminder:
+		return true
+	}
+	return false
+}
+
+//compare compares the sort field of two todo entities and returns -1, 0 or 1
+func compare(a, b *storage.ToDo, sortBy storage.SortField) int {
+	switch sortBy {
+	case storage.SortByTitle:
+		return strings.Compare(a.Title, b.Title)
+	case storage.SortByStatus:
+		return strings.Compare(a.Status, b.Status)
+	case storage.SortByEstimatedTimeOfCompletion:
+		return compareTime(a.EstimatedTimeOfCompletion, b.EstimatedTimeOfCompletion)
+	case storage.SortByReminder:
+		return compareTime(a.Reminder, b.Reminder)
+	}
+	switch {
+	case a.ID < b.ID:
+		return -1
+	case a.ID > b.ID:
+		return 1
+	}
+	return 0
+}
+
+//compareTime compares two instants and returns -1, 0 or 1
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
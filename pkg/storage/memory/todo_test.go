@@ -0,0 +1,303 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepository(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	tm := time.Now().In(time.UTC)
+	td := &storage.ToDo{
+		Title:                     "title",
+		Description:               "description",
+		Status:                    "Started",
+		EstimatedTimeOfCompletion: tm,
+		ActualTimeOfCompletion:    tm,
+		Reminder:                  tm,
+	}
+
+	id, err := r.Create(ctx, td)
+	if err != nil || id != 1 {
+		t.Fatalf("toDoRepository.Create() = %d, %v, want 1", id, err)
+	}
+	if td.ID != 0 {
+		t.Errorf("toDoRepository.Create() modified the argument")
+	}
+	if _, err := r.Create(ctx, td); err != storage.ErrAlreadyExists {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	got, err := r.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("toDoRepository.Get() error = %v", err)
+	}
+	want := *td
+	want.ID = id
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("toDoRepository.Get() = %v, want %v", got, &want)
+	}
+
+	//returned entity is a copy
+	got.Title = "changed"
+	if got, _ := r.Get(ctx, id); got.Title != "title" {
+		t.Errorf("toDoRepository.Get() returned stored entity instead of a copy")
+	}
+
+	other := &storage.ToDo{Title: "other"}
+	otherID, err := r.Create(ctx, other)
+	if err != nil || otherID != 2 {
+		t.Fatalf("toDoRepository.Create() = %d, %v, want 2", otherID, err)
+	}
+
+	want.Status = "Completed"
+	if n, err := r.Update(ctx, &want); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Update() = %d, %v, want 1", n, err)
+	}
+	if _, err := r.Update(ctx, &storage.ToDo{ID: otherID, Title: "title"}); err != storage.ErrAlreadyExists {
+		t.Errorf("toDoRepository.Update() error = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+	if _, err := r.Update(ctx, &storage.ToDo{ID: 42, Title: "missing"}); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Update() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if want.Version != 2 {
+		t.Errorf("toDoRepository.Update() version = %d, want 2", want.Version)
+	}
+	stale := want
+	stale.Version = 1
+	if _, err := r.Update(ctx, &stale); err != storage.ErrVersionMismatch {
+		t.Errorf("toDoRepository.Update() error = %v, want %v", err, storage.ErrVersionMismatch)
+	}
+
+	list, err := r.List(ctx, storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("toDoRepository.List() error = %v", err)
+	}
+	if len(list) != 2 || !reflect.DeepEqual(list[0], &want) || list[1].ID != otherID {
+		t.Errorf("toDoRepository.List() = %v, want [%v %v]", list, &want, other)
+	}
+
+	//reopen the task
+	reopened := want
+	reopened.ActualTimeOfCompletion = time.Time{}
+	reopened.UpdatedAt = tm.Add(time.Hour)
+	if _, err := r.Update(ctx, &reopened); err != nil {
+		t.Errorf("toDoRepository.Update() error = %v", err)
+	}
+	completions, err := r.Completions(ctx, id)
+	wantCompletions := []*storage.Completion{{CompletedAt: tm, ReopenedAt: tm.Add(time.Hour)}}
+	if err != nil || !reflect.DeepEqual(completions, wantCompletions) {
+		t.Errorf("toDoRepository.Completions() = %v, %v, want %v", completions, err, wantCompletions)
+	}
+
+	if n, err := r.Delete(ctx, id, storage.DeleteRestrict); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Delete() = %d, %v, want 1", n, err)
+	}
+	if _, err := r.Completions(ctx, id); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Completions() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if _, err := r.Delete(ctx, id, storage.DeleteRestrict); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Delete() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if _, err := r.Get(ctx, id); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+	}
+}
+
+func TestToDoRepositoryConcurrentCreate(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := r.Create(ctx, &storage.ToDo{Title: fmt.Sprintf("title %d", i)}); err != nil {
+				t.Errorf("toDoRepository.Create() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	list, err := r.List(ctx, storage.ListOptions{})
+	if err != nil {
+		t.Fatalf("toDoRepository.List() error = %v", err)
+	}
+	for i, td := range list {
+		if td.ID != int64(i+1) {
+			t.Fatalf("toDoRepository.List() ID = %d at position %d, want %d", td.ID, i, i+1)
+		}
+	}
+	if len(list) != 50 {
+		t.Errorf("toDoRepository.List() returned %d entities, want 50", len(list))
+	}
+}
+
+func TestToDoRepositoryList(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	base := time.Date(2019, 10, 17, 8, 0, 0, 0, time.UTC)
+
+	for i, st := range []string{"Completed", "Started", "Completed", "Started"} {
+		_, err := r.Create(ctx, &storage.ToDo{
+			Title:                     fmt.Sprintf("title %d", i),
+			Status:                    st,
+			EstimatedTimeOfCompletion: base.Add(time.Duration(-i) * time.Hour),
+			Reminder:                  base.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		opts    storage.ListOptions
+		want    []int64
+		wantErr bool
+	}{
+		{
+			name: "All",
+			want: []int64{1, 2, 3, 4},
+		},
+		{
+			name: "Status",
+			opts: storage.ListOptions{Status: "Started"},
+			want: []int64{2, 4},
+		},
+		{
+			name: "Reminder range",
+			opts: storage.ListOptions{ReminderFrom: base.Add(time.Hour), ReminderTo: base.Add(3 * time.Hour)},
+			want: []int64{2, 3},
+		},
+		{
+			name: "Due from",
+			opts: storage.ListOptions{DueFrom: base.Add(-time.Hour)},
+			want: []int64{1, 2},
+		},
+		{
+			name: "Sorted by due date",
+			opts: storage.ListOptions{SortBy: storage.SortByEstimatedTimeOfCompletion},
+			want: []int64{4, 3, 2, 1},
+		},
+		{
+			name: "Sorted by status descending after",
+			opts: storage.ListOptions{SortBy: storage.SortByStatus, Descending: true, After: &storage.ToDo{ID: 4, Status: "Started"}},
+			want: []int64{2, 3, 1},
+		},
+		{
+			name: "Limit",
+			opts: storage.ListOptions{Limit: 3, After: &storage.ToDo{ID: 1}},
+			want: []int64{2, 3, 4},
+		},
+		{
+			name:    "Unsupported sort field",
+			opts:    storage.ListOptions{SortBy: "description"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := r.List(ctx, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toDoRepository.List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []int64
+			for _, td := range list {
+				got = append(got, td.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toDoRepository.List() IDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryHierarchy(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	tm := time.Now().In(time.UTC)
+
+	//1
+	//├── 2
+	//│   └── 4
+	//└── 3
+	for _, td := range []*storage.ToDo{{Title: "1"}, {Title: "2", ParentID: 1}, {Title: "3", ParentID: 1}, {Title: "4", ParentID: 2}} {
+		if _, err := r.Create(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+	}
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "orphan", ParentID: 42}); err != storage.ErrParentNotFound {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrParentNotFound)
+	}
+
+	ids := func(list []*storage.ToDo) []int64 {
+		ids := []int64{}
+		for _, td := range list {
+			ids = append(ids, td.ID)
+		}
+		return ids
+	}
+	if list, err := r.Descendants(ctx, 1); err != nil || !reflect.DeepEqual(ids(list), []int64{2, 3, 4}) {
+		t.Errorf("toDoRepository.Descendants() = %v, %v, want [2 3 4]", ids(list), err)
+	}
+	if _, err := r.Descendants(ctx, 42); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Descendants() error = %v, want %v", err, storage.ErrNotFound)
+	}
+
+	//update keeps the parent
+	if _, err := r.Update(ctx, &storage.ToDo{ID: 4, Title: "4"}); err != nil {
+		t.Fatalf("toDoRepository.Update() error = %v", err)
+	}
+	if td, _ := r.Get(ctx, 4); td.ParentID != 2 {
+		t.Errorf("toDoRepository.Update() changed parent to %d", td.ParentID)
+	}
+
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		wantErr  error
+	}{
+		{"Itself", 2, 2, storage.ErrCycle},
+		{"Under subtask", 1, 4, storage.ErrCycle},
+		{"Missing parent", 2, 42, storage.ErrParentNotFound},
+		{"Missing task", 42, 1, storage.ErrNotFound},
+		{"Subtree", 2, 3, nil},
+		{"Top level", 3, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := r.Move(ctx, tt.id, tt.parentID, tm); err != tt.wantErr {
+				t.Errorf("toDoRepository.Move() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if list, err := r.Descendants(ctx, 3); err != nil || !reflect.DeepEqual(ids(list), []int64{2, 4}) {
+		t.Errorf("toDoRepository.Descendants() = %v, %v, want [2 4]", ids(list), err)
+	}
+
+	if _, err := r.Delete(ctx, 2, storage.DeleteRestrict); err != storage.ErrHasChildren {
+		t.Errorf("toDoRepository.Delete() error = %v, want %v", err, storage.ErrHasChildren)
+	}
+	if n, err := r.Delete(ctx, 2, storage.DeleteReparent); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Delete() = %d, %v, want 1", n, err)
+	}
+	if td, _ := r.Get(ctx, 4); td.ParentID != 3 {
+		t.Errorf("toDoRepository.Delete() moved subtask to %d, want 3", td.ParentID)
+	}
+	if n, err := r.Delete(ctx, 3, storage.DeleteCascade); err != nil || n != 2 {
+		t.Errorf("toDoRepository.Delete() = %d, %v, want 2", n, err)
+	}
+	if list, err := r.List(ctx, storage.ListOptions{}); err != nil || !reflect.DeepEqual(ids(list), []int64{1}) {
+		t.Errorf("toDoRepository.List() = %v, %v, want [1]", ids(list), err)
+	}
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Trash moves todo entity to the trash and handles its subtasks according to the mode
+func (r *toDoRepository) Trash(ctx context.Context, id int64, version int64, mode storage.DeleteMode, deletedAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.trash(id, version, mode, deletedAt)
+}
+
+//trash moves todo entity to the trash and handles its subtasks according to the mode, the caller must hold the lock
+func (r *toDoRepository) trash(id int64, version int64, mode storage.DeleteMode, deletedAt time.Time) (int64, error) {
+	td, ok := r.todos[id]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+	if version != 0 && version != td.Version {
+		return 0, storage.ErrVersionMismatch
+	}
+
+	ids := []int64{id}
+	children := r.children(id)
+	switch {
+	case len(children) == 0:
+	case mode == storage.DeleteCascade:
+		for _, d := range r.descendants(id) {
+			ids = append(ids, d.ID)
+		}
+	case mode == storage.DeleteReparent:
+		for _, child := range children {
+			child.ParentID = td.ParentID
+			child.Version++
+			r.todos[child.ID] = *child
+		}
+	default:
+		return 0, storage.ErrHasChildren
+	}
+
+	for _, id := range ids {
+		td := r.todos[id]
+		td.DeletedAt = deletedAt
+		td.Version++
+		r.trashed[id] = td
+		delete(r.todos, id)
+	}
+	return int64(len(ids)), nil
+}
+
+//Restore takes todo entity and its subtasks trashed together with it out of the trash
+func (r *toDoRepository) Restore(ctx context.Context, id int64, updatedAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	td, ok := r.trashed[id]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+	if _, ok := r.todos[td.ParentID]; td.ParentID != 0 && !ok {
+		return 0, storage.ErrParentNotFound
+	}
+
+	//subtasks trashed on their own before stay in the trash
+	ids := []int64{id}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range r.trashed {
+			if child.ParentID == ids[i] && child.DeletedAt.Equal(td.DeletedAt) {
+				ids = append(ids, child.ID)
+			}
+		}
+	}
+
+	for _, id := range ids {
+		td := r.trashed[id]
+		td.DeletedAt = time.Time{}
+		td.UpdatedAt = updatedAt
+		td.Version++
+		r.todos[id] = td
+		delete(r.trashed, id)
+	}
+	return int64(len(ids)), nil
+}
+
+//Trashed returns copy of todo entity in the trash by ID
+func (r *toDoRepository) Trashed(ctx context.Context, id int64) (*storage.ToDo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	td, ok := r.trashed[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &td, nil
+}
+
+//Purge removes todo entities of all tenants moved to the trash before the time
+func (r *toDoRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for _, d := range r.datasets {
+		for id, td := range d.trashed {
+			if td.DeletedAt.Before(before) {
+				d.delete(id)
+				purged++
+			}
+		}
+	}
+	return purged, nil
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryTrash(t *testing.T) {
+	ctx := context.Background()
+	r := NewToDoRepository()
+	t1 := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+	t2, t3 := t1.Add(time.Hour), t1.Add(2*time.Hour)
+	create := func(td *storage.ToDo) int64 {
+		id, err := r.Create(ctx, td)
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		return id
+	}
+	ids := func(opts storage.ListOptions) []int64 {
+		list, err := r.List(ctx, opts)
+		if err != nil {
+			t.Fatalf("toDoRepository.List() error = %v", err)
+		}
+		ids := []int64{}
+		for _, td := range list {
+			ids = append(ids, td.ID)
+		}
+		return ids
+	}
+
+	move := create(&storage.ToDo{Title: "move", Status: "TODO"})
+	pack := create(&storage.ToDo{Title: "pack", Status: "TODO", ParentID: move})
+	clean := create(&storage.ToDo{Title: "clean", Status: "TODO", ParentID: move})
+	party := create(&storage.ToDo{Title: "party", Status: "TODO"})
+	if err := r.AddDependency(ctx, party, move); err != nil {
+		t.Fatalf("toDoRepository.AddDependency() error = %v", err)
+	}
+
+	if _, err := r.Trash(ctx, clean, 2, storage.DeleteRestrict, t1); err != storage.ErrVersionMismatch {
+		t.Errorf("toDoRepository.Trash() error = %v, want %v", err, storage.ErrVersionMismatch)
+	}
+	if n, err := r.Trash(ctx, clean, 1, storage.DeleteRestrict, t1); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Trash() = %d, %v, want 1", n, err)
+	}
+	if _, err := r.Trash(ctx, move, 0, storage.DeleteRestrict, t2); err != storage.ErrHasChildren {
+		t.Errorf("toDoRepository.Trash() error = %v, want %v", err, storage.ErrHasChildren)
+	}
+	if n, err := r.Trash(ctx, move, 0, storage.DeleteCascade, t2); err != nil || n != 2 {
+		t.Errorf("toDoRepository.Trash() = %d, %v, want 2", n, err)
+	}
+
+	//todo entities in the trash are found only by List with ShowDeleted
+	if _, err := r.Get(ctx, pack); err != storage.ErrNotFound {
+		t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+	}
+	if got := ids(storage.ListOptions{ShowDeleted: true}); !reflect.DeepEqual(got, []int64{move, pack, clean, party}) {
+		t.Errorf("toDoRepository.List() = %v, want all todo entities", got)
+	}
+	if got := ids(storage.ListOptions{Ready: true, Closed: []string{"DONE"}}); !reflect.DeepEqual(got, []int64{party}) {
+		t.Errorf("toDoRepository.List() = %v, want [%d] ready", got, party)
+	}
+	if deps, err := r.Dependencies(ctx); err != nil || len(deps) != 0 {
+		t.Errorf("toDoRepository.Dependencies() = %v, %v, want none", deps, err)
+	}
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "move"}); err != storage.ErrAlreadyExists {
+		t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+	}
+
+	//subtask trashed on its own stays in the trash
+	if _, err := r.Restore(ctx, clean, t3); err != storage.ErrParentNotFound {
+		t.Errorf("toDoRepository.Restore() error = %v, want %v", err, storage.ErrParentNotFound)
+	}
+	if n, err := r.Restore(ctx, move, t3); err != nil || n != 2 {
+		t.Errorf("toDoRepository.Restore() = %d, %v, want 2", n, err)
+	}
+	if td, err := r.Get(ctx, pack); err != nil || !td.UpdatedAt.Equal(t3) || !td.DeletedAt.IsZero() {
+		t.Errorf("toDoRepository.Get() = %v, %v, want restored todo entity", td, err)
+	}
+
+	//trash is rolled back with the failed batch
+	errs, err := r.Batch(ctx, [][]*storage.BatchOp{
+		{{Kind: storage.BatchTrash, ID: party, DeletedAt: t3}},
+		{{Kind: storage.BatchTrash, ID: 42, DeletedAt: t3}},
+	}, true)
+	if err != nil || !reflect.DeepEqual(errs, []error{nil, storage.ErrNotFound}) {
+		t.Errorf("toDoRepository.Batch() = %v, %v", errs, err)
+	}
+	if got := ids(storage.ListOptions{}); !reflect.DeepEqual(got, []int64{move, pack, party}) {
+		t.Errorf("toDoRepository.List() = %v, want [%d %d %d]", got, move, pack, party)
+	}
+
+	if n, err := r.Purge(ctx, t2); err != nil || n != 1 {
+		t.Errorf("toDoRepository.Purge() = %d, %v, want 1", n, err)
+	}
+	if got := ids(storage.ListOptions{ShowDeleted: true}); !reflect.DeepEqual(got, []int64{move, pack, party}) {
+		t.Errorf("toDoRepository.List() = %v, want [%d %d %d]", got, move, pack, party)
+	}
+	if _, err := r.Create(ctx, &storage.ToDo{Title: "clean"}); err != nil {
+		t.Errorf("toDoRepository.Create() error = %v, title of purged todo entity is free", err)
+	}
+}
@@ -0,0 +1,105 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Batch runs items of operations in one transaction, failed item is rolled back to the savepoint taken before it,
+//atomic batch takes no savepoints and is rolled back as a whole
+func (r *toDoRepository) Batch(ctx context.Context, items [][]*storage.BatchOp, atomic bool) ([]error, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	errs := make([]error, len(items))
+	for i, ops := range items {
+		if !atomic {
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_item"); err != nil {
+				return nil, fmt.Errorf("failed to create savepoint -> %s", err.Error())
+			}
+		}
+
+		if errs[i] = r.batchItem(ctx, tx, ops); errs[i] != nil {
+			if atomic {
+				return errs, nil
+			}
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_item"); err != nil {
+				return nil, fmt.Errorf("failed to roll back to savepoint -> %s", err.Error())
+			}
+			continue
+		}
+
+		if !atomic {
+			if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_item"); err != nil {
+				return nil, fmt.Errorf("failed to release savepoint -> %s", err.Error())
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return errs, nil
+}
+
+//batchItem runs operations of batch item in the transaction
+func (r *toDoRepository) batchItem(ctx context.Context, tx *sql.Tx, ops []*storage.BatchOp) error {
+	for _, op := range ops {
+		var err error
+		switch op.Kind {
+		case storage.BatchCreate:
+			if op.SkipExisting {
+				op.ToDo.ID, err = r.createSkipExisting(ctx, tx, op.ToDo)
+			} else {
+				op.ToDo.ID, err = r.create(ctx, tx, op.ToDo)
+			}
+		case storage.BatchUpdate:
+			op.Rows, err = r.update(ctx, tx, op.ToDo)
+		case storage.BatchDelete:
+			op.Rows, err = r.delete(ctx, tx, op.ID, op.Mode)
+		case storage.BatchTrash:
+			op.Rows, err = r.trash(ctx, tx, op.ID, op.Version, op.Mode, op.DeletedAt)
+		default:
+			err = fmt.Errorf("unknown batch operation %d", op.Kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//createSkipExisting inserts a new todo entity unless its title is already used by the owner in the project.
+//Failed insert aborts the transaction of some databases so it is rolled back to a savepoint.
+func (r *toDoRepository) createSkipExisting(ctx context.Context, tx *sql.Tx, td *storage.ToDo) (int64, error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_create"); err != nil {
+		return 0, fmt.Errorf("failed to create savepoint -> %s", err.Error())
+	}
+	id, err := r.create(ctx, tx, td)
+	if err == storage.ErrAlreadyExists {
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_create"); err != nil {
+			return 0, fmt.Errorf("failed to roll back to savepoint -> %s", err.Error())
+		}
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_create"); err != nil {
+		return 0, fmt.Errorf("failed to release savepoint -> %s", err.Error())
+	}
+	return id, nil
+}
@@ -0,0 +1,80 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryBatch(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	update := func() [][]*storage.BatchOp {
+		return [][]*storage.BatchOp{{{Kind: storage.BatchUpdate, ToDo: &storage.ToDo{ID: 42, Title: "title"}}}}
+	}
+
+	tests := []struct {
+		name     string
+		atomic   bool
+		mock     func()
+		wantErrs []error
+		wantErr  bool
+	}{
+		{
+			name: "Item rolled back to savepoint",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("SAVEPOINT batch_item").WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo WHERE ID=").WithArgs(42, "").WillReturnRows(sqlMock.NewRows([]string{"ActualTimeOfCompletion", "Reminder", "Version"}))
+				mock.ExpectExec("ROLLBACK TO SAVEPOINT batch_item").WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			wantErrs: []error{storage.ErrNotFound},
+		},
+		{
+			name:   "Atomic batch rolled back",
+			atomic: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo WHERE ID=").WithArgs(42, "").WillReturnRows(sqlMock.NewRows([]string{"ActualTimeOfCompletion", "Reminder", "Version"}))
+				mock.ExpectRollback()
+			},
+			wantErrs: []error{storage.ErrNotFound},
+		},
+		{
+			name: "SAVEPOINT failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("SAVEPOINT batch_item").WillReturnError(errors.New("SAVEPOINT failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			errs, err := r.Batch(ctx, update(), tt.atomic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toDoRepository.Batch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("toDoRepository.Batch() = %v, want %v", errs, tt.wantErrs)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
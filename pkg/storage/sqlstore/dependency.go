@@ -0,0 +1,174 @@
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//AddDependency inserts dependency of todo entity on the prerequisite
+func (r *toDoRepository) AddDependency(ctx context.Context, id int64, dependsOnID int64) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := r.parentOf(ctx, tx, id); err != nil {
+		return err
+	}
+	if _, err := r.parentOf(ctx, tx, dependsOnID); err == storage.ErrNotFound {
+		return storage.ErrPrerequisiteNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var count int64
+	if err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDoDependency WHERE ToDoID=? AND DependsOnID=?"), id, dependsOnID).Scan(&count); err != nil {
+		return fmt.Errorf("failed to select from ToDoDependency -> %s", err.Error())
+	}
+	if count > 0 {
+		return storage.ErrDependencyExists
+	}
+
+	//walk prerequisites of the prerequisite level by level, the todo entity must not be met on the way
+	seen := map[int64]bool{dependsOnID: true}
+	for level := []int64{dependsOnID}; len(level) > 0; {
+		for _, p := range level {
+			if p == id {
+				return storage.ErrDependencyCycle
+			}
+		}
+		rows, err := tx.QueryContext(ctx, r.dialect.rebind("SELECT DependsOnID FROM ToDoDependency WHERE ToDoID IN ("+placeholders(len(level))+")"), int64Args(level)...)
+		if err != nil {
+			return fmt.Errorf("failed to select from ToDoDependency -> %s", err.Error())
+		}
+		var next []int64
+		for rows.Next() {
+			var p int64
+			if err := rows.Scan(&p); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to retrieve field values from ToDoDependency row -> %s", err.Error())
+			}
+			if !seen[p] {
+				seen[p] = true
+				next = append(next, p)
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return fmt.Errorf("failed to retrieve data from ToDoDependency -> %s", err.Error())
+		}
+		level = next
+	}
+
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("INSERT INTO ToDoDependency(ToDoID,DependsOnID) VALUES (?,?)"), id, dependsOnID); err != nil {
+		return fmt.Errorf("failed to insert into ToDoDependency -> %s", err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return nil
+}
+
+//RemoveDependency deletes dependency of todo entity on the prerequisite
+func (r *toDoRepository) RemoveDependency(ctx context.Context, id int64, dependsOnID int64) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	res, err := c.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoDependency WHERE ToDoID=? AND DependsOnID=? AND ToDoID IN (SELECT ID FROM ToDo WHERE TenantID=?)"), id, dependsOnID, r.tenant)
+	if err != nil {
+		return fmt.Errorf("failed to delete ToDoDependency -> %s", err.Error())
+	}
+	if _, err := rowsAffected(res); err == storage.ErrNotFound {
+		return storage.ErrDependencyNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+//Prerequisites selects todo entities the todo entity depends on
+func (r *toDoRepository) Prerequisites(ctx context.Context, id int64) ([]*storage.ToDo, error) {
+	//check that todo entity exists
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT "+toDoColumns+" FROM ToDo WHERE ID IN (SELECT DependsOnID FROM ToDoDependency WHERE ToDoID=?) AND DeletedAt IS NULL ORDER BY ID"), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.ToDo{}
+	for rows.Next() {
+		td, err := scanToDo(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDo-> %s", err.Error())
+	}
+	rows.Close()
+
+	if err := r.loadLabels(ctx, c, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+//Dependencies selects all dependencies between todo entities which are not in the trash
+func (r *toDoRepository) Dependencies(ctx context.Context) ([]*storage.Dependency, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT D.ToDoID,D.DependsOnID FROM ToDoDependency D JOIN ToDo T ON T.ID=D.ToDoID JOIN ToDo P ON P.ID=D.DependsOnID "+
+		"WHERE T.DeletedAt IS NULL AND P.DeletedAt IS NULL AND T.TenantID=? ORDER BY D.ToDoID, D.DependsOnID"), r.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDoDependency -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.Dependency{}
+	for rows.Next() {
+		d := &storage.Dependency{}
+		if err := rows.Scan(&d.ToDoID, &d.DependsOnID); err != nil {
+			return nil, fmt.Errorf("failed to retrieve field values from ToDoDependency row -> %s", err.Error())
+		}
+		list = append(list, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDoDependency -> %s", err.Error())
+	}
+	return list, nil
+}
@@ -0,0 +1,160 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryAddDependency(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	parent := func() *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"ParentID"}).AddRow(0)
+	}
+	count := func(n int64) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"COUNT(*)"}).AddRow(n)
+	}
+	prerequisites := func(ids ...int64) *sqlMock.Rows {
+		rows := sqlMock.NewRows([]string{"DependsOnID"})
+		for _, id := range ids {
+			rows.AddRow(id)
+		}
+		return rows
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(2, "").WillReturnRows(parent())
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ToDoDependency WHERE ToDoID=\? AND DependsOnID=\?`).WithArgs(1, 2).WillReturnRows(count(0))
+				mock.ExpectQuery(`SELECT DependsOnID FROM ToDoDependency WHERE ToDoID IN \(\?\)`).WithArgs(2).WillReturnRows(prerequisites(3, 4))
+				mock.ExpectQuery(`SELECT DependsOnID FROM ToDoDependency WHERE ToDoID IN \(\?,\?\)`).WithArgs(3, 4).WillReturnRows(prerequisites(4))
+				mock.ExpectExec(`INSERT INTO ToDoDependency\(ToDoID,DependsOnID\) VALUES \(\?,\?\)`).WithArgs(1, 2).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Cycle",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(2, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1, 2).WillReturnRows(count(0))
+				mock.ExpectQuery("SELECT DependsOnID FROM ToDoDependency").WithArgs(2).WillReturnRows(prerequisites(3))
+				mock.ExpectQuery("SELECT DependsOnID FROM ToDoDependency").WithArgs(3).WillReturnRows(prerequisites(1))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrDependencyCycle,
+		},
+		{
+			name: "Exists",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(2, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1, 2).WillReturnRows(count(1))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrDependencyExists,
+		},
+		{
+			name: "Prerequisite not found",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(2, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrPrerequisiteNotFound,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			if err := r.AddDependency(ctx, 1, 2); err != tt.wantErr {
+				t.Errorf("toDoRepository.AddDependency() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryRemoveDependency(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, Postgres)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+		want    error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectExec(`DELETE FROM ToDoDependency WHERE ToDoID=\$1 AND DependsOnID=\$2 AND ToDoID IN \(SELECT ID FROM ToDo WHERE TenantID=\$3\)`).WithArgs(1, 2, "").WillReturnResult(sqlMock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 2, "").WillReturnResult(sqlMock.NewResult(0, 0))
+			},
+			wantErr: true,
+			want:    storage.ErrDependencyNotFound,
+		},
+		{
+			name: "DELETE failed",
+			mock: func() {
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 2, "").WillReturnError(errors.New("DELETE failed"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			err := r.RemoveDependency(ctx, 1, 2)
+			if (err != nil) != tt.wantErr || (tt.want != nil && err != tt.want) {
+				t.Errorf("toDoRepository.RemoveDependency() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
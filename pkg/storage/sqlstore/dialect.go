@@ -0,0 +1,65 @@
+package sqlstore
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+//Dialect is SQL dialect of the database behind the repository
+type Dialect int
+
+const (
+	//MySQL dialect uses '?' placeholders and LastInsertId
+	MySQL Dialect = iota
+
+	//SQLite3 dialect uses '?' placeholders and LastInsertId
+	SQLite3
+
+	//Postgres dialect uses '$n' placeholders and INSERT ... RETURNING
+	Postgres
+)
+
+//rebind replaces '?' bind variables of the query with placeholders of the dialect
+func (d Dialect) rebind(query string) string {
+	if d != Postgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+//returning reports whether generated ID is read with INSERT ... RETURNING
+//because the driver does not support LastInsertId
+func (d Dialect) returning() bool {
+	return d == Postgres
+}
+
+//isUniqueViolation reports whether err is raised by a unique constraint of the database
+func isUniqueViolation(err error) bool {
+	switch e := err.(type) {
+	case *mysql.MySQLError:
+		//ER_DUP_ENTRY
+		return e.Number == 1062
+	case *pq.Error:
+		//unique_violation
+		return e.Code == "23505"
+	case sqlite3.Error:
+		//duplicate text primary key is reported apart from other unique constraints
+		return e.ExtendedCode == sqlite3.ErrConstraintUnique || e.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+	return false
+}
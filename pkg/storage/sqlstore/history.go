@@ -0,0 +1,82 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//AddHistory inserts a new history entry, the snapshot is stored as JSON object
+func (r *toDoRepository) AddHistory(ctx context.Context, e *storage.HistoryEntry) (int64, error) {
+	snapshot, err := json.Marshal(e.Snapshot)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode history snapshot -> %s", err.Error())
+	}
+
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	query := "INSERT INTO ToDoHistory(ToDoID,Action,Snapshot,Actor,RequestID,CreatedAt,TenantID) VALUES (?,?,?,?,?,?,?)"
+	args := []interface{}{e.ToDoID, string(e.Action), string(snapshot), e.Actor, e.RequestID, nullTime(e.CreatedAt), r.tenant}
+
+	var id int64
+	if r.dialect.returning() {
+		//get ID of created entry from the inserted row
+		err = c.QueryRowContext(ctx, r.dialect.rebind(query+" RETURNING ID"), args...).Scan(&id)
+	} else {
+		var res sql.Result
+		if res, err = c.ExecContext(ctx, query, args...); err == nil {
+			//get ID of created entry
+			if id, err = res.LastInsertId(); err != nil {
+				return 0, fmt.Errorf("failed to retrieve id for created ToDoHistory -> %s", err.Error())
+			}
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert into ToDoHistory -> %s", err.Error())
+	}
+	return id, nil
+}
+
+//History selects history entries of todo entity
+func (r *toDoRepository) History(ctx context.Context, todoID int64) ([]*storage.HistoryEntry, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT ID,ToDoID,Action,Snapshot,Actor,RequestID,CreatedAt FROM ToDoHistory WHERE ToDoID=? AND TenantID=? ORDER BY ID"), todoID, r.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDoHistory -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.HistoryEntry{}
+	for rows.Next() {
+		e := &storage.HistoryEntry{}
+		var action, snapshot string
+		var createdAt timeValue
+		if err := rows.Scan(&e.ID, &e.ToDoID, &action, &snapshot, &e.Actor, &e.RequestID, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to retrieve field values from ToDoHistory row -> %s", err.Error())
+		}
+		if err := json.Unmarshal([]byte(snapshot), &e.Snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot of ToDoHistory row -> %s", err.Error())
+		}
+		e.Action, e.CreatedAt = storage.HistoryAction(action), createdAt.Time
+		list = append(list, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDoHistory -> %s", err.Error())
+	}
+	return list, nil
+}
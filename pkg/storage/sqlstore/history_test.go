@@ -0,0 +1,62 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestHistoryRepository(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	e := &storage.HistoryEntry{
+		ToDoID:    1,
+		Action:    storage.HistoryUpdated,
+		Snapshot:  map[string]string{"title": "title"},
+		Actor:     "alice",
+		RequestID: "host/abc-000001",
+		CreatedAt: tm,
+	}
+
+	mock.ExpectExec(`INSERT INTO ToDoHistory\(ToDoID,Action,Snapshot,Actor,RequestID,CreatedAt,TenantID\) VALUES \(\?,\?,\?,\?,\?,\?,\?\)`).
+		WithArgs(1, "UPDATED", `{"title":"title"}`, "alice", "host/abc-000001", tm, "").WillReturnResult(sqlMock.NewResult(7, 1))
+	if id, err := r.AddHistory(ctx, e); err != nil || id != 7 {
+		t.Errorf("toDoRepository.AddHistory() = %d, %v, want 7", id, err)
+	}
+
+	mock.ExpectExec("INSERT INTO ToDoHistory").WillReturnError(errors.New("INSERT failed"))
+	if _, err := r.AddHistory(ctx, e); err == nil {
+		t.Errorf("toDoRepository.AddHistory() error = nil, want error")
+	}
+
+	columns := []string{"ID", "ToDoID", "Action", "Snapshot", "Actor", "RequestID", "CreatedAt"}
+	mock.ExpectQuery(`SELECT ID,ToDoID,Action,Snapshot,Actor,RequestID,CreatedAt FROM ToDoHistory WHERE ToDoID=\? AND TenantID=\? ORDER BY ID`).WithArgs(1, "").
+		WillReturnRows(sqlMock.NewRows(columns).AddRow(7, 1, "UPDATED", `{"title":"title"}`, "alice", "host/abc-000001", tm))
+	want := *e
+	want.ID = 7
+	if got, err := r.History(ctx, 1); err != nil || !reflect.DeepEqual(got, []*storage.HistoryEntry{&want}) {
+		t.Errorf("toDoRepository.History() = %v, %v, want [%v]", got, err, want)
+	}
+
+	mock.ExpectQuery("SELECT ID,ToDoID").WithArgs(1, "").
+		WillReturnRows(sqlMock.NewRows(columns).AddRow(7, 1, "UPDATED", "not JSON", "alice", "", tm))
+	if _, err := r.History(ctx, 1); err == nil {
+		t.Errorf("toDoRepository.History() error = nil, want error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,1211 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"bitbucket.org/liamstask/goose/lib/goose"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//testDatabase is a database the integration tests of the repository run against
+type testDatabase struct {
+	//name is the name of the subtests of the database
+	name string
+
+	//driver is the name of the SQL driver and of the directory of its migrations
+	driver string
+
+	dialect Dialect
+	goose   goose.SqlDialect
+
+	//dsn returns data source name of the database and function removing the database when the test ends
+	dsn func(t *testing.T) (string, func())
+}
+
+//testDatabases are SQLite database created for every test in a temporary directory and
+//PostgreSQL and MySQL databases named by the environment variables when they are set
+var testDatabases = []testDatabase{
+	{
+		name:    "SQLite",
+		driver:  "sqlite3",
+		dialect: SQLite3,
+		goose:   &goose.Sqlite3Dialect{},
+		dsn: func(t *testing.T) (string, func()) {
+			dir, err := ioutil.TempDir("", "tasq")
+			if err != nil {
+				t.Fatalf("failed to create temporary directory: %v", err)
+			}
+			return "file:" + filepath.Join(dir, "tasq.db") + "?_busy_timeout=5000", func() { os.RemoveAll(dir) }
+		},
+	},
+	{
+		name:    "PostgreSQL",
+		driver:  "postgres",
+		dialect: Postgres,
+		goose:   &goose.PostgresDialect{},
+		dsn:     envDSN("TASQ_TEST_POSTGRES_DSN"),
+	},
+	{
+		name:    "MySQL",
+		driver:  "mysql",
+		dialect: MySQL,
+		goose:   &goose.MySqlDialect{},
+		dsn:     envDSN("TASQ_TEST_MYSQL_DSN"),
+	},
+}
+
+//envDSN returns data source name of the database kept in the environment variable, the test is skipped without it.
+//Every test clears the database so it must be a database created for the tests, MySQL DSN needs parseTime=true.
+func envDSN(name string) func(t *testing.T) (string, func()) {
+	return func(t *testing.T) (string, func()) {
+		dsn := os.Getenv(name)
+		if len(dsn) == 0 {
+			t.Skipf("%s is not set", name)
+		}
+		return dsn, func() {}
+	}
+}
+
+//open opens the database migrated to the latest version without data of previous tests
+func (d testDatabase) open(t *testing.T) (*sql.DB, func()) {
+	dsn, remove := d.dsn(t)
+	db, err := sql.Open(d.driver, dsn)
+	if err != nil {
+		remove()
+		t.Fatalf("failed to open database: %v", err)
+	}
+	cleanup := func() {
+		db.Close()
+		remove()
+	}
+
+	latest, err := goose.GetMostRecentDBVersion(d.migrationsDir())
+	if err != nil {
+		cleanup()
+		t.Fatalf("failed to read migrations: %v", err)
+	}
+	//database kept between tests is cleared by migrating it down first
+	for _, version := range []int64{0, latest} {
+		if err := d.migrate(db, version); err != nil {
+			cleanup()
+			t.Fatalf("failed to run migrations: %v", err)
+		}
+	}
+	return db, cleanup
+}
+
+//migrationsDir returns the directory of migrations of the database
+func (d testDatabase) migrationsDir() string {
+	return filepath.Join("..", "..", "..", "db", "migrations", d.driver)
+}
+
+//migrate runs migrations of the database up or down to the version
+func (d testDatabase) migrate(db *sql.DB, version int64) error {
+	conf := &goose.DBConf{
+		MigrationsDir: d.migrationsDir(),
+		Env:           "test",
+		Driver: goose.DBDriver{
+			Name:    d.driver,
+			Import:  "database/sql",
+			Dialect: d.goose,
+		},
+	}
+	return goose.RunMigrationsOnDb(conf, d.migrationsDir(), version, db)
+}
+
+//forEachDatabase runs the test in a subtest for every test database
+func forEachDatabase(t *testing.T, test func(t *testing.T, db *sql.DB, dialect Dialect)) {
+	for _, d := range testDatabases {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			db, cleanup := d.open(t)
+			defer cleanup()
+			test(t, db, d.dialect)
+		})
+	}
+}
+
+func TestToDoRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		//times are whole seconds which every database keeps
+		tm := time.Date(2019, 10, 17, 20, 34, 58, 0, time.UTC)
+		td := &storage.ToDo{
+			Title:                     "title",
+			Description:               "description",
+			Status:                    "Started",
+			EstimatedTimeOfCompletion: tm,
+			ActualTimeOfCompletion:    tm,
+			Reminder:                  tm,
+			Recurrence:                "FREQ=WEEKLY;BYDAY=MO",
+		}
+
+		id, err := r.Create(ctx, td)
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		td.ID = id
+
+		if _, err := r.Create(ctx, td); err != storage.ErrAlreadyExists {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+		}
+
+		got, err := r.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("toDoRepository.Get() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, td) {
+			t.Errorf("toDoRepository.Get() = %v, want %v", got, td)
+		}
+
+		td.Status = "Completed"
+		if n, err := r.Update(ctx, td); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Update() = %d, %v, want 1", n, err)
+		}
+
+		list, err := r.List(ctx, storage.ListOptions{})
+		if err != nil {
+			t.Fatalf("toDoRepository.List() error = %v", err)
+		}
+		if len(list) != 1 || !reflect.DeepEqual(list[0], td) {
+			t.Errorf("toDoRepository.List() = %v, want [%v]", list, td)
+		}
+
+		//reopen and complete the task again
+		reopened := *td
+		reopened.ActualTimeOfCompletion = time.Time{}
+		reopened.UpdatedAt = tm.Add(time.Hour)
+		if _, err := r.Update(ctx, &reopened); err != nil {
+			t.Fatalf("toDoRepository.Update() error = %v", err)
+		}
+		if reopened.Version != 3 {
+			t.Errorf("toDoRepository.Update() version = %d, want 3", reopened.Version)
+		}
+		td.ActualTimeOfCompletion = tm.Add(2 * time.Hour)
+		if _, err := r.Update(ctx, td); err != storage.ErrVersionMismatch {
+			t.Errorf("toDoRepository.Update() error = %v, want %v", err, storage.ErrVersionMismatch)
+		}
+		td.Version = reopened.Version
+		if _, err := r.Update(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Update() error = %v", err)
+		}
+		completions, err := r.Completions(ctx, id)
+		if err != nil {
+			t.Fatalf("toDoRepository.Completions() error = %v", err)
+		}
+		wantCompletions := []*storage.Completion{
+			{CompletedAt: tm, ReopenedAt: tm.Add(time.Hour)},
+			{CompletedAt: tm.Add(2 * time.Hour)},
+		}
+		if !reflect.DeepEqual(completions, wantCompletions) {
+			t.Errorf("toDoRepository.Completions() = %v, want %v", completions, wantCompletions)
+		}
+
+		//page by estimated time of completion in descending order
+		other := *td
+		other.Title = "other"
+		other.EstimatedTimeOfCompletion = tm.Add(time.Hour)
+		if other.ID, err = r.Create(ctx, &other); err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		opts := storage.ListOptions{SortBy: storage.SortByEstimatedTimeOfCompletion, Descending: true, Limit: 1}
+		list, err = r.List(ctx, opts)
+		if err != nil || len(list) != 1 || list[0].ID != other.ID {
+			t.Fatalf("toDoRepository.List() = %v, %v, want [%v]", list, err, &other)
+		}
+		opts.After = list[0]
+		list, err = r.List(ctx, opts)
+		if err != nil || len(list) != 1 || list[0].ID != id {
+			t.Fatalf("toDoRepository.List() = %v, %v, want [%v]", list, err, td)
+		}
+		opts.DueFrom = tm.Add(time.Minute)
+		if list, err = r.List(ctx, opts); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.List() = %v, %v, want []", list, err)
+		}
+
+		//both todo entities remind at tm
+		if list, err = r.DueReminders(ctx, tm.Add(-time.Second), nil); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want []", list, err)
+		}
+		if list, err = r.DueReminders(ctx, tm, nil); err != nil || len(list) != 2 || list[0].ID != id || list[1].ID != other.ID {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [%v %v]", list, err, td, &other)
+		}
+		if list, err = r.DueReminders(ctx, tm, []string{"Completed", "Cancelled"}); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [] of closed todo entities", list, err)
+		}
+		if err := r.MarkReminderSent(ctx, id, tm, tm.Add(time.Second)); err != nil {
+			t.Errorf("toDoRepository.MarkReminderSent() error = %v", err)
+		}
+		if err := r.MarkReminderSent(ctx, id, tm, tm.Add(time.Second)); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.MarkReminderSent() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if err := r.MarkReminderSent(ctx, other.ID, tm.Add(time.Hour), tm.Add(time.Second)); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.MarkReminderSent() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if list, err = r.DueReminders(ctx, tm, nil); err != nil || len(list) != 1 || list[0].ID != other.ID {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [%v]", list, err, &other)
+		}
+
+		//claimed reminder is not due until the lease expires or it is released
+		if err := r.ClaimReminder(ctx, other.ID, tm, tm, tm.Add(time.Minute)); err != nil {
+			t.Errorf("toDoRepository.ClaimReminder() error = %v", err)
+		}
+		if err := r.ClaimReminder(ctx, other.ID, tm, tm.Add(time.Second), tm.Add(time.Hour)); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.ClaimReminder() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if list, err = r.DueReminders(ctx, tm, nil); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want []", list, err)
+		}
+		if list, err = r.DueReminders(ctx, tm.Add(time.Minute), nil); err != nil || len(list) != 1 || list[0].ID != other.ID {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [%v]", list, err, &other)
+		}
+		if err := r.ReleaseReminder(ctx, other.ID, tm); err != nil {
+			t.Errorf("toDoRepository.ReleaseReminder() error = %v", err)
+		}
+		if list, err = r.DueReminders(ctx, tm, nil); err != nil || len(list) != 1 || list[0].ID != other.ID {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [%v]", list, err, &other)
+		}
+		if err := r.ReleaseReminder(ctx, id, tm); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.ReleaseReminder() error = %v, want %v", err, storage.ErrNotFound)
+		}
+
+		//changed reminder is due again
+		td.Reminder = tm.Add(-time.Hour)
+		if _, err := r.Update(ctx, td); err != nil {
+			t.Fatalf("toDoRepository.Update() error = %v", err)
+		}
+		if list, err = r.DueReminders(ctx, tm, nil); err != nil || len(list) != 2 || list[0].ID != id {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want [%v %v]", list, err, td, &other)
+		}
+
+		if _, err := r.Delete(ctx, other.ID, storage.DeleteRestrict); err != nil {
+			t.Errorf("toDoRepository.Delete() error = %v", err)
+		}
+
+		if n, err := r.Delete(ctx, id, storage.DeleteRestrict); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Delete() = %d, %v, want 1", n, err)
+		}
+		if _, err := r.Get(ctx, id); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if _, err := r.Delete(ctx, id, storage.DeleteRestrict); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Delete() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if _, err := r.Completions(ctx, id); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Completions() error = %v, want %v", err, storage.ErrNotFound)
+		}
+	})
+}
+
+func TestProjectRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 4, 10, 10, 0, 0, 0, time.UTC)
+		p := &storage.Project{Name: "work", Description: "description", CreatedAt: tm, UpdatedAt: tm}
+
+		id, err := r.CreateProject(ctx, p)
+		if err != nil {
+			t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+		}
+		p.ID = id
+		if _, err := r.CreateProject(ctx, p); err != storage.ErrProjectAlreadyExists {
+			t.Errorf("toDoRepository.CreateProject() error = %v, want %v", err, storage.ErrProjectAlreadyExists)
+		}
+
+		p.Archived = true
+		p.UpdatedAt = tm.Add(time.Hour)
+		if n, err := r.UpdateProject(ctx, p); err != nil || n != 1 {
+			t.Errorf("toDoRepository.UpdateProject() = %d, %v, want 1", n, err)
+		}
+		if got, err := r.GetProject(ctx, id); err != nil || !reflect.DeepEqual(got, p) {
+			t.Errorf("toDoRepository.GetProject() = %v, %v, want %v", got, err, p)
+		}
+		if _, err := r.GetProject(ctx, 42); err != storage.ErrProjectNotFound {
+			t.Errorf("toDoRepository.GetProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+		}
+		if list, err := r.ListProjects(ctx, false); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.ListProjects() = %v, %v, want []", list, err)
+		}
+		if list, err := r.ListProjects(ctx, true); err != nil || len(list) != 1 || !reflect.DeepEqual(list[0], p) {
+			t.Errorf("toDoRepository.ListProjects() = %v, %v, want [%v]", list, err, p)
+		}
+
+		//titles are unique in a project
+		for _, td := range []*storage.ToDo{{Title: "title"}, {Title: "title", ProjectID: id, ActualTimeOfCompletion: tm}} {
+			if _, err := r.Create(ctx, td); err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+		}
+		if _, err := r.Create(ctx, &storage.ToDo{Title: "title", ProjectID: id}); err != storage.ErrAlreadyExists {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+		}
+		list, err := r.List(ctx, storage.ListOptions{ProjectID: id})
+		if err != nil || len(list) != 1 || list[0].ProjectID != id {
+			t.Errorf("toDoRepository.List() = %v, %v, want task of the project", list, err)
+		}
+
+		if _, err := r.DeleteProject(ctx, id, false); err != storage.ErrProjectNotEmpty {
+			t.Errorf("toDoRepository.DeleteProject() error = %v, want %v", err, storage.ErrProjectNotEmpty)
+		}
+		if n, err := r.DeleteProject(ctx, id, true); err != nil || n != 1 {
+			t.Errorf("toDoRepository.DeleteProject() = %d, %v, want 1", n, err)
+		}
+		if list, err := r.List(ctx, storage.ListOptions{}); err != nil || len(list) != 1 || list[0].ProjectID != 0 {
+			t.Errorf("toDoRepository.List() = %v, %v, want task without project", list, err)
+		}
+		if _, err := r.DeleteProject(ctx, id, true); err != storage.ErrProjectNotFound {
+			t.Errorf("toDoRepository.DeleteProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+		}
+	})
+}
+
+func TestToDoRepositoryHierarchyIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 4, 20, 10, 0, 0, 0, time.UTC)
+
+		//1
+		//├── 2
+		//│   └── 4
+		//└── 3
+		for _, td := range []*storage.ToDo{{Title: "1"}, {Title: "2", ParentID: 1}, {Title: "3", ParentID: 1}, {Title: "4", ParentID: 2}} {
+			if _, err := r.Create(ctx, td); err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+		}
+		if _, err := r.Create(ctx, &storage.ToDo{Title: "orphan", ParentID: 42}); err != storage.ErrParentNotFound {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrParentNotFound)
+		}
+
+		ids := func(list []*storage.ToDo) []int64 {
+			ids := []int64{}
+			for _, td := range list {
+				ids = append(ids, td.ID)
+			}
+			return ids
+		}
+		if list, err := r.Descendants(ctx, 1); err != nil || !reflect.DeepEqual(ids(list), []int64{2, 3, 4}) {
+			t.Errorf("toDoRepository.Descendants() = %v, %v, want [2 3 4]", ids(list), err)
+		}
+		if list, err := r.List(ctx, storage.ListOptions{ParentID: 2}); err != nil || !reflect.DeepEqual(ids(list), []int64{4}) {
+			t.Errorf("toDoRepository.List() = %v, %v, want [4]", ids(list), err)
+		}
+
+		if _, err := r.Move(ctx, 1, 4, tm); err != storage.ErrCycle {
+			t.Errorf("toDoRepository.Move() error = %v, want %v", err, storage.ErrCycle)
+		}
+		if n, err := r.Move(ctx, 2, 3, tm); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Move() = %d, %v, want 1", n, err)
+		}
+		if list, err := r.Descendants(ctx, 1); err != nil || !reflect.DeepEqual(ids(list), []int64{3, 2, 4}) {
+			t.Errorf("toDoRepository.Descendants() = %v, %v, want [3 2 4]", ids(list), err)
+		}
+
+		if _, err := r.Delete(ctx, 3, storage.DeleteRestrict); err != storage.ErrHasChildren {
+			t.Errorf("toDoRepository.Delete() error = %v, want %v", err, storage.ErrHasChildren)
+		}
+		if n, err := r.Delete(ctx, 3, storage.DeleteReparent); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Delete() = %d, %v, want 1", n, err)
+		}
+		if td, err := r.Get(ctx, 2); err != nil || td.ParentID != 1 {
+			t.Errorf("toDoRepository.Get() = %v, %v, want subtask of 1", td, err)
+		}
+		if n, err := r.Delete(ctx, 1, storage.DeleteCascade); err != nil || n != 3 {
+			t.Errorf("toDoRepository.Delete() = %d, %v, want 3", n, err)
+		}
+		if list, err := r.List(ctx, storage.ListOptions{}); err != nil || len(list) != 0 {
+			t.Errorf("toDoRepository.List() = %v, %v, want []", list, err)
+		}
+	})
+}
+
+func TestToDoRepositoryDependenciesIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+
+		//review <- deploy <- announce
+		for _, td := range []*storage.ToDo{{Title: "review", Status: "DONE"}, {Title: "deploy", Status: "TODO"}, {Title: "announce", Status: "TODO"}, {Title: "docs", Status: "CANCELLED"}} {
+			if _, err := r.Create(ctx, td); err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+		}
+		for _, tt := range []struct {
+			id          int64
+			dependsOnID int64
+			wantErr     error
+		}{
+			{2, 1, nil},
+			{3, 2, nil},
+			{3, 2, storage.ErrDependencyExists},
+			{1, 1, storage.ErrDependencyCycle},
+			{1, 3, storage.ErrDependencyCycle},
+			{42, 1, storage.ErrNotFound},
+			{1, 42, storage.ErrPrerequisiteNotFound},
+		} {
+			if err := r.AddDependency(ctx, tt.id, tt.dependsOnID); err != tt.wantErr {
+				t.Errorf("toDoRepository.AddDependency(%d, %d) error = %v, want %v", tt.id, tt.dependsOnID, err, tt.wantErr)
+			}
+		}
+
+		ids := func(list []*storage.ToDo) []int64 {
+			ids := []int64{}
+			for _, td := range list {
+				ids = append(ids, td.ID)
+			}
+			return ids
+		}
+		if list, err := r.Prerequisites(ctx, 3); err != nil || !reflect.DeepEqual(ids(list), []int64{2}) {
+			t.Errorf("toDoRepository.Prerequisites() = %v, %v, want [2]", ids(list), err)
+		}
+		want := []*storage.Dependency{{ToDoID: 2, DependsOnID: 1}, {ToDoID: 3, DependsOnID: 2}}
+		if got, err := r.Dependencies(ctx); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.Dependencies() = %v, %v, want %v", got, err, want)
+		}
+		closed := []string{"DONE", "CANCELLED"}
+		if list, err := r.List(ctx, storage.ListOptions{Ready: true, Closed: closed}); err != nil || !reflect.DeepEqual(ids(list), []int64{2}) {
+			t.Errorf("toDoRepository.List() = %v, %v, want [2]", ids(list), err)
+		}
+		if list, err := r.List(ctx, storage.ListOptions{Ready: true}); err != nil || !reflect.DeepEqual(ids(list), []int64{1, 4}) {
+			t.Errorf("toDoRepository.List() = %v, %v, want [1 4]", ids(list), err)
+		}
+
+		if err := r.RemoveDependency(ctx, 3, 1); err != storage.ErrDependencyNotFound {
+			t.Errorf("toDoRepository.RemoveDependency() error = %v, want %v", err, storage.ErrDependencyNotFound)
+		}
+		if err := r.RemoveDependency(ctx, 3, 2); err != nil {
+			t.Errorf("toDoRepository.RemoveDependency() error = %v", err)
+		}
+		if _, err := r.Delete(ctx, 1, storage.DeleteRestrict); err != nil {
+			t.Fatalf("toDoRepository.Delete() error = %v", err)
+		}
+		if got, err := r.Dependencies(ctx); err != nil || len(got) != 0 {
+			t.Errorf("toDoRepository.Dependencies() = %v, %v, want []", got, err)
+		}
+	})
+}
+
+func TestToDoRepositoryLabelsIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 5, 10, 10, 0, 0, 0, time.UTC)
+
+		projectID, err := r.CreateProject(ctx, &storage.Project{Name: "work"})
+		if err != nil {
+			t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+		}
+		for _, td := range []*storage.ToDo{
+			{Title: "report", Labels: []string{"work", "urgent"}, ProjectID: projectID},
+			{Title: "groceries", Labels: []string{"home"}},
+			{Title: "taxes"},
+		} {
+			if _, err := r.Create(ctx, td); err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+		}
+
+		if err := r.AddLabels(ctx, 3, []string{"home", "urgent", "home"}, tm); err != nil {
+			t.Fatalf("toDoRepository.AddLabels() error = %v", err)
+		}
+		if err := r.AddLabels(ctx, 42, []string{"home"}, tm); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.AddLabels() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		td, err := r.Get(ctx, 3)
+		if err != nil || !reflect.DeepEqual(td.Labels, []string{"home", "urgent"}) || !td.UpdatedAt.Equal(tm) {
+			t.Errorf("toDoRepository.Get() = %v, %v", td, err)
+		}
+		if _, err := r.Update(ctx, &storage.ToDo{ID: 3, Title: "taxes"}); err != nil {
+			t.Fatalf("toDoRepository.Update() error = %v", err)
+		}
+		if td, _ := r.Get(ctx, 3); !reflect.DeepEqual(td.Labels, []string{"home", "urgent"}) {
+			t.Errorf("toDoRepository.Update() changed labels to %v", td.Labels)
+		}
+
+		ids := func(list []*storage.ToDo) []int64 {
+			ids := []int64{}
+			for _, td := range list {
+				ids = append(ids, td.ID)
+			}
+			return ids
+		}
+		for _, tt := range []struct {
+			opts storage.ListOptions
+			want []int64
+		}{
+			{storage.ListOptions{Labels: []string{"home", "urgent"}}, []int64{3}},
+			{storage.ListOptions{Labels: []string{"home", "urgent"}, AnyLabel: true}, []int64{1, 2, 3}},
+			{storage.ListOptions{Labels: []string{"garden"}}, []int64{}},
+		} {
+			if list, err := r.List(ctx, tt.opts); err != nil || !reflect.DeepEqual(ids(list), tt.want) {
+				t.Errorf("toDoRepository.List(%+v) = %v, %v, want %v", tt.opts, ids(list), err, tt.want)
+			}
+		}
+
+		want := []*storage.LabelCount{{Name: "home", Count: 2}, {Name: "urgent", Count: 2}, {Name: "work", Count: 1}}
+		if got, err := r.ListLabels(ctx, 0, ""); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+		}
+		want = []*storage.LabelCount{{Name: "urgent", Count: 1}, {Name: "work", Count: 1}}
+		if got, err := r.ListLabels(ctx, projectID, ""); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+		}
+
+		if err := r.RemoveLabels(ctx, 3, []string{"urgent", "garden"}, tm); err != nil {
+			t.Fatalf("toDoRepository.RemoveLabels() error = %v", err)
+		}
+		if _, err := r.Delete(ctx, 2, storage.DeleteRestrict); err != nil {
+			t.Fatalf("toDoRepository.Delete() error = %v", err)
+		}
+		if _, err := r.DeleteProject(ctx, projectID, true); err != nil {
+			t.Fatalf("toDoRepository.DeleteProject() error = %v", err)
+		}
+		want = []*storage.LabelCount{{Name: "home", Count: 1}}
+		if got, err := r.ListLabels(ctx, 0, ""); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+		}
+	})
+}
+
+func TestToDoRepositoryBatchIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		id, err := r.Create(ctx, &storage.ToDo{Title: "report"})
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		titles := func() []string {
+			list, _ := r.List(ctx, storage.ListOptions{})
+			titles := []string{}
+			for _, td := range list {
+				titles = append(titles, td.Title)
+			}
+			return titles
+		}
+		create := func(title string) *storage.BatchOp {
+			return &storage.BatchOp{Kind: storage.BatchCreate, ToDo: &storage.ToDo{Title: title, Labels: []string{"import"}}}
+		}
+
+		//atomic batch is rolled back by the failed item
+		errs, err := r.Batch(ctx, [][]*storage.BatchOp{{create("groceries")}, {create("report")}, {create("taxes")}}, true)
+		if err != nil || !reflect.DeepEqual(errs, []error{nil, storage.ErrAlreadyExists, nil}) {
+			t.Errorf("toDoRepository.Batch() = %v, %v", errs, err)
+		}
+		if got := titles(); !reflect.DeepEqual(got, []string{"report"}) {
+			t.Errorf("toDoRepository.Batch() left %v, want [report]", got)
+		}
+
+		//only failed items are rolled back to their savepoints, operations of an item fail together
+		skipped := &storage.BatchOp{Kind: storage.BatchCreate, ToDo: &storage.ToDo{Title: "report"}, SkipExisting: true}
+		update := &storage.BatchOp{Kind: storage.BatchUpdate, ToDo: &storage.ToDo{ID: id, Title: "weekly report"}}
+		errs, err = r.Batch(ctx, [][]*storage.BatchOp{
+			{create("groceries")},
+			{create("taxes"), {Kind: storage.BatchDelete, ID: 42}},
+			{skipped},
+			{update},
+		}, false)
+		if err != nil || !reflect.DeepEqual(errs, []error{nil, storage.ErrNotFound, nil, nil}) {
+			t.Errorf("toDoRepository.Batch() = %v, %v", errs, err)
+		}
+		if got := titles(); !reflect.DeepEqual(got, []string{"weekly report", "groceries"}) {
+			t.Errorf("toDoRepository.Batch() left %v, want [weekly report groceries]", got)
+		}
+		if skipped.ToDo.ID != 0 || update.Rows != 1 {
+			t.Errorf("toDoRepository.Batch() skipped ID = %d, updated rows = %d", skipped.ToDo.ID, update.Rows)
+		}
+		want := []*storage.LabelCount{{Name: "import", Count: 1}}
+		if got, err := r.ListLabels(ctx, 0, ""); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want %v", got, err, want)
+		}
+	})
+}
+
+func TestToDoRepositoryTrashIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		t1 := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+		t2, t3 := t1.Add(time.Hour), t1.Add(2*time.Hour)
+		create := func(td *storage.ToDo) int64 {
+			id, err := r.Create(ctx, td)
+			if err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+			return id
+		}
+		ids := func(opts storage.ListOptions) []int64 {
+			list, err := r.List(ctx, opts)
+			if err != nil {
+				t.Fatalf("toDoRepository.List() error = %v", err)
+			}
+			ids := []int64{}
+			for _, td := range list {
+				ids = append(ids, td.ID)
+			}
+			return ids
+		}
+
+		move := create(&storage.ToDo{Title: "move", Status: "TODO"})
+		pack := create(&storage.ToDo{Title: "pack", Status: "TODO", ParentID: move})
+		clean := create(&storage.ToDo{Title: "clean", Status: "TODO", ParentID: move})
+		party := create(&storage.ToDo{Title: "party", Status: "TODO"})
+		if err := r.AddDependency(ctx, party, move); err != nil {
+			t.Fatalf("toDoRepository.AddDependency() error = %v", err)
+		}
+
+		if n, err := r.Trash(ctx, clean, 0, storage.DeleteRestrict, t1); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Trash() = %d, %v, want 1", n, err)
+		}
+		if _, err := r.Trash(ctx, move, 0, storage.DeleteRestrict, t2); err != storage.ErrHasChildren {
+			t.Errorf("toDoRepository.Trash() error = %v, want %v", err, storage.ErrHasChildren)
+		}
+		if n, err := r.Trash(ctx, move, 0, storage.DeleteCascade, t2); err != nil || n != 2 {
+			t.Errorf("toDoRepository.Trash() = %d, %v, want 2", n, err)
+		}
+		if _, err := r.Trash(ctx, move, 0, storage.DeleteCascade, t2); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Trash() error = %v, want %v", err, storage.ErrNotFound)
+		}
+
+		//todo entities in the trash are found only by List with ShowDeleted
+		if _, err := r.Get(ctx, pack); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if got := ids(storage.ListOptions{}); !reflect.DeepEqual(got, []int64{party}) {
+			t.Errorf("toDoRepository.List() = %v, want [%d]", got, party)
+		}
+		if got := ids(storage.ListOptions{ShowDeleted: true}); !reflect.DeepEqual(got, []int64{move, pack, clean, party}) {
+			t.Errorf("toDoRepository.List() = %v, want all todo entities", got)
+		}
+		if got := ids(storage.ListOptions{Ready: true, Closed: []string{"DONE"}}); !reflect.DeepEqual(got, []int64{party}) {
+			t.Errorf("toDoRepository.List() = %v, want [%d] ready", got, party)
+		}
+		if deps, err := r.Dependencies(ctx); err != nil || len(deps) != 0 {
+			t.Errorf("toDoRepository.Dependencies() = %v, %v, want none", deps, err)
+		}
+		if _, err := r.Create(ctx, &storage.ToDo{Title: "move"}); err != storage.ErrAlreadyExists {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+		}
+
+		//subtask trashed on its own stays in the trash
+		if _, err := r.Restore(ctx, clean, t3); err != storage.ErrParentNotFound {
+			t.Errorf("toDoRepository.Restore() error = %v, want %v", err, storage.ErrParentNotFound)
+		}
+		if n, err := r.Restore(ctx, move, t3); err != nil || n != 2 {
+			t.Errorf("toDoRepository.Restore() = %d, %v, want 2", n, err)
+		}
+		if td, err := r.Get(ctx, pack); err != nil || !td.UpdatedAt.Equal(t3) || !td.DeletedAt.IsZero() {
+			t.Errorf("toDoRepository.Get() = %v, %v, want restored todo entity", td, err)
+		}
+		if _, err := r.Restore(ctx, move, t3); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Restore() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if prerequisites, err := r.Prerequisites(ctx, party); err != nil || len(prerequisites) != 1 {
+			t.Errorf("toDoRepository.Prerequisites() = %v, %v, want restored prerequisite", prerequisites, err)
+		}
+
+		if n, err := r.Purge(ctx, t2); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Purge() = %d, %v, want 1", n, err)
+		}
+		if got := ids(storage.ListOptions{ShowDeleted: true}); !reflect.DeepEqual(got, []int64{move, pack, party}) {
+			t.Errorf("toDoRepository.List() = %v, want [%d %d %d]", got, move, pack, party)
+		}
+		if _, err := r.Trash(ctx, move, 0, storage.DeleteCascade, t3); err != nil {
+			t.Fatalf("toDoRepository.Trash() error = %v", err)
+		}
+		if n, err := r.Purge(ctx, t3.Add(time.Second)); err != nil || n != 2 {
+			t.Errorf("toDoRepository.Purge() = %d, %v, want 2", n, err)
+		}
+		if deps, err := r.Dependencies(ctx); err != nil || len(deps) != 0 {
+			t.Errorf("toDoRepository.Dependencies() = %v, %v, want none", deps, err)
+		}
+		if _, err := r.Create(ctx, &storage.ToDo{Title: "move"}); err != nil {
+			t.Errorf("toDoRepository.Create() error = %v, title of purged todo entity is free", err)
+		}
+	})
+}
+
+func TestHistoryRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+		entries := []*storage.HistoryEntry{
+			{ToDoID: 1, Action: storage.HistoryCreated, Snapshot: map[string]string{"title": "report"}, Actor: "alice", RequestID: "host/abc-000001", CreatedAt: tm},
+			{ToDoID: 2, Action: storage.HistoryCreated, Snapshot: map[string]string{"title": "groceries"}, Actor: "anonymous", CreatedAt: tm},
+			{ToDoID: 1, Action: storage.HistoryUpdated, Snapshot: map[string]string{"title": "weekly report", "status": "DONE"}, Actor: "bob", CreatedAt: tm.Add(time.Hour)},
+		}
+		for _, e := range entries {
+			id, err := r.AddHistory(ctx, e)
+			if err != nil {
+				t.Fatalf("toDoRepository.AddHistory() error = %v", err)
+			}
+			e.ID = id
+		}
+
+		got, err := r.History(ctx, 1)
+		if err != nil || !reflect.DeepEqual(got, []*storage.HistoryEntry{entries[0], entries[2]}) {
+			t.Errorf("toDoRepository.History() = %v, %v, want %v", got, err, []*storage.HistoryEntry{entries[0], entries[2]})
+		}
+		if got, err := r.History(ctx, 42); err != nil || len(got) != 0 {
+			t.Errorf("toDoRepository.History() = %v, %v, want empty list", got, err)
+		}
+	})
+}
+
+func TestToDoRepositorySharesIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 6, 20, 10, 0, 0, 0, time.UTC)
+		create := func(td *storage.ToDo) int64 {
+			id, err := r.Create(ctx, td)
+			if err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+			return id
+		}
+		ids := func(opts storage.ListOptions) []int64 {
+			list, err := r.List(ctx, opts)
+			if err != nil {
+				t.Fatalf("toDoRepository.List() error = %v", err)
+			}
+			ids := []int64{}
+			for _, td := range list {
+				ids = append(ids, td.ID)
+			}
+			return ids
+		}
+
+		legacy := create(&storage.ToDo{Title: "legacy"})
+		report := create(&storage.ToDo{Title: "report", Owner: "alice", Labels: []string{"work"}})
+		//titles are unique among todo entities of one owner
+		other := create(&storage.ToDo{Title: "report", Owner: "bob"})
+		if _, err := r.Create(ctx, &storage.ToDo{Title: "report", Owner: "alice"}); err != storage.ErrAlreadyExists {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+		}
+
+		if n, err := r.AssignOwner(ctx, "nobody"); err != nil || n != 1 {
+			t.Errorf("toDoRepository.AssignOwner() = %d, %v, want 1", n, err)
+		}
+		if n, err := r.AssignOwner(ctx, "nobody"); err != nil || n != 0 {
+			t.Errorf("toDoRepository.AssignOwner() = %d, %v, want 0", n, err)
+		}
+		if td, err := r.Get(ctx, legacy); err != nil || td.Owner != "nobody" {
+			t.Errorf("toDoRepository.Get() = %v, %v, want owner nobody", td, err)
+		}
+
+		//update does not change owner
+		if _, err := r.Update(ctx, &storage.ToDo{ID: report, Title: "report", Owner: "bob"}); err != nil {
+			t.Fatalf("toDoRepository.Update() error = %v", err)
+		}
+		if td, _ := r.Get(ctx, report); td.Owner != "alice" {
+			t.Errorf("toDoRepository.Update() changed owner to %s", td.Owner)
+		}
+
+		if err := r.Share(ctx, report, "bob", storage.ShareViewer); err != nil {
+			t.Fatalf("toDoRepository.Share() error = %v", err)
+		}
+		if err := r.Share(ctx, report, "bob", storage.ShareEditor); err != nil {
+			t.Fatalf("toDoRepository.Share() error = %v", err)
+		}
+		if err := r.Share(ctx, report, "carol", storage.ShareViewer); err != nil {
+			t.Fatalf("toDoRepository.Share() error = %v", err)
+		}
+		if err := r.Share(ctx, 42, "bob", storage.ShareViewer); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Share() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if role, err := r.ShareRole(ctx, report, "bob"); err != nil || role != storage.ShareEditor {
+			t.Errorf("toDoRepository.ShareRole() = %v, %v, want %v", role, err, storage.ShareEditor)
+		}
+		if role, err := r.ShareRole(ctx, other, "alice"); err != nil || role != "" {
+			t.Errorf("toDoRepository.ShareRole() = %v, %v, want no role", role, err)
+		}
+
+		shares, err := r.Shares(ctx, report)
+		want := []*storage.Share{
+			{ToDoID: report, User: "bob", Role: storage.ShareEditor},
+			{ToDoID: report, User: "carol", Role: storage.ShareViewer},
+		}
+		if err != nil || !reflect.DeepEqual(shares, want) {
+			t.Errorf("toDoRepository.Shares() = %v, %v, want %v", shares, err, want)
+		}
+		if _, err := r.Shares(ctx, 42); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Shares() error = %v, want %v", err, storage.ErrNotFound)
+		}
+
+		for _, tt := range []struct {
+			user string
+			want []int64
+		}{
+			{"alice", []int64{report}},
+			{"bob", []int64{report, other}},
+			{"nobody", []int64{legacy}},
+			{"dave", []int64{}},
+			{"", []int64{legacy, report, other}},
+		} {
+			if got := ids(storage.ListOptions{VisibleTo: tt.user}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toDoRepository.List() visible to %q = %v, want %v", tt.user, got, tt.want)
+			}
+		}
+		if labels, err := r.ListLabels(ctx, 0, "carol"); err != nil || len(labels) != 1 {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want label of shared todo entity", labels, err)
+		}
+		if labels, err := r.ListLabels(ctx, 0, "dave"); err != nil || len(labels) != 0 {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want no labels", labels, err)
+		}
+
+		if err := r.Unshare(ctx, report, "carol"); err != nil {
+			t.Fatalf("toDoRepository.Unshare() error = %v", err)
+		}
+		if err := r.Unshare(ctx, report, "carol"); err != storage.ErrShareNotFound {
+			t.Errorf("toDoRepository.Unshare() error = %v, want %v", err, storage.ErrShareNotFound)
+		}
+
+		//shares stay with todo entity in the trash and are deleted with it
+		if _, err := r.Trash(ctx, report, 0, storage.DeleteRestrict, tm); err != nil {
+			t.Fatalf("toDoRepository.Trash() error = %v", err)
+		}
+		if td, err := r.Trashed(ctx, report); err != nil || td.Owner != "alice" {
+			t.Errorf("toDoRepository.Trashed() = %v, %v, want todo entity of alice", td, err)
+		}
+		if _, err := r.Trashed(ctx, other); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Trashed() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if role, _ := r.ShareRole(ctx, report, "bob"); role != storage.ShareEditor {
+			t.Errorf("toDoRepository.ShareRole() = %v, want %v", role, storage.ShareEditor)
+		}
+		if n, err := r.Purge(ctx, tm.Add(time.Second)); err != nil || n != 1 {
+			t.Errorf("toDoRepository.Purge() = %d, %v, want 1", n, err)
+		}
+		if role, _ := r.ShareRole(ctx, report, "bob"); role != "" {
+			t.Errorf("toDoRepository.ShareRole() = %v, want no role of purged todo entity", role)
+		}
+	})
+}
+
+func TestTenantRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		root := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+		for _, tenant := range []*storage.Tenant{
+			{ID: "blue", Name: "Blue team", MaxToDos: 10, CreatedAt: tm, UpdatedAt: tm},
+			{ID: "red", Name: "Red team", CreatedAt: tm, UpdatedAt: tm},
+		} {
+			if err := root.CreateTenant(ctx, tenant); err != nil {
+				t.Fatalf("toDoRepository.CreateTenant() error = %v", err)
+			}
+		}
+		if err := root.CreateTenant(ctx, &storage.Tenant{ID: "red", CreatedAt: tm, UpdatedAt: tm}); err != storage.ErrTenantAlreadyExists {
+			t.Errorf("toDoRepository.CreateTenant() error = %v, want %v", err, storage.ErrTenantAlreadyExists)
+		}
+		if _, err := root.UpdateTenant(ctx, &storage.Tenant{ID: "red", Name: "Red team", Suspended: true, UpdatedAt: tm.Add(time.Hour)}); err != nil {
+			t.Fatalf("toDoRepository.UpdateTenant() error = %v", err)
+		}
+		want := &storage.Tenant{ID: "red", Name: "Red team", Suspended: true, CreatedAt: tm, UpdatedAt: tm.Add(time.Hour)}
+		if got, err := root.GetTenant(ctx, "red"); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("toDoRepository.GetTenant() = %v, %v, want %v", got, err, want)
+		}
+		if _, err := root.GetTenant(ctx, "green"); err != storage.ErrTenantNotFound {
+			t.Errorf("toDoRepository.GetTenant() error = %v, want %v", err, storage.ErrTenantNotFound)
+		}
+		if list, err := root.ListTenants(ctx); err != nil || len(list) != 2 || list[0].ID != "blue" || list[1].ID != "red" {
+			t.Errorf("toDoRepository.ListTenants() = %v, %v, want blue and red", list, err)
+		}
+
+		blue, red := root.ForTenant("blue"), root.ForTenant("red")
+		create := func(r storage.Repository, td *storage.ToDo) int64 {
+			id, err := r.Create(ctx, td)
+			if err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+			return id
+		}
+		//titles and project names are unique within a tenant only
+		report := create(blue, &storage.ToDo{Title: "report", Reminder: tm, Labels: []string{"work"}})
+		draft := create(blue, &storage.ToDo{Title: "draft", ParentID: report})
+		other := create(red, &storage.ToDo{Title: "report", Reminder: tm})
+		create(root, &storage.ToDo{Title: "report"})
+		for _, r := range []storage.Repository{blue, red} {
+			if _, err := r.CreateProject(ctx, &storage.Project{Name: "work", CreatedAt: tm, UpdatedAt: tm}); err != nil {
+				t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+			}
+		}
+		if err := blue.AddDependency(ctx, draft, report); err != nil {
+			t.Fatalf("toDoRepository.AddDependency() error = %v", err)
+		}
+		if err := blue.Share(ctx, report, "bob", storage.ShareViewer); err != nil {
+			t.Fatalf("toDoRepository.Share() error = %v", err)
+		}
+		if _, err := blue.AddHistory(ctx, &storage.HistoryEntry{ToDoID: report, Action: storage.HistoryCreated, Actor: "alice", CreatedAt: tm}); err != nil {
+			t.Fatalf("toDoRepository.AddHistory() error = %v", err)
+		}
+
+		if td, err := blue.Get(ctx, report); err != nil || td.Tenant != "blue" {
+			t.Errorf("toDoRepository.Get() = %v, %v, want todo entity of blue", td, err)
+		}
+		if _, err := red.Get(ctx, report); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if _, err := red.Create(ctx, &storage.ToDo{Title: "subtask", ParentID: report}); err != storage.ErrParentNotFound {
+			t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrParentNotFound)
+		}
+		if err := red.AddDependency(ctx, other, report); err != storage.ErrPrerequisiteNotFound {
+			t.Errorf("toDoRepository.AddDependency() error = %v, want %v", err, storage.ErrPrerequisiteNotFound)
+		}
+		if _, err := red.Trash(ctx, report, 0, storage.DeleteRestrict, tm); err != storage.ErrNotFound {
+			t.Errorf("toDoRepository.Trash() error = %v, want %v", err, storage.ErrNotFound)
+		}
+		if role, err := red.ShareRole(ctx, report, "bob"); err != nil || role != "" {
+			t.Errorf("toDoRepository.ShareRole() = %v, %v, want no role", role, err)
+		}
+		if list, err := red.List(ctx, storage.ListOptions{}); err != nil || len(list) != 1 || list[0].ID != other {
+			t.Errorf("toDoRepository.List() = %v, %v, want [%d]", list, err, other)
+		}
+		if deps, err := red.Dependencies(ctx); err != nil || len(deps) != 0 {
+			t.Errorf("toDoRepository.Dependencies() = %v, %v, want no dependencies", deps, err)
+		}
+		if labels, err := red.ListLabels(ctx, 0, ""); err != nil || len(labels) != 0 {
+			t.Errorf("toDoRepository.ListLabels() = %v, %v, want no labels", labels, err)
+		}
+		if history, err := red.History(ctx, report); err != nil || len(history) != 0 {
+			t.Errorf("toDoRepository.History() = %v, %v, want no entries", history, err)
+		}
+		if n, err := blue.CountToDos(ctx); err != nil || n != 2 {
+			t.Errorf("toDoRepository.CountToDos() = %d, %v, want 2", n, err)
+		}
+
+		//reminders are sent for all tenants
+		if due, err := root.DueReminders(ctx, tm, nil); err != nil || len(due) != 2 {
+			t.Errorf("toDoRepository.DueReminders() = %v, %v, want 2 reminders", due, err)
+		}
+
+		if n, err := root.DeleteTenant(ctx, "blue"); err != nil || n != 2 {
+			t.Errorf("toDoRepository.DeleteTenant() = %d, %v, want 2", n, err)
+		}
+		if _, err := root.DeleteTenant(ctx, "blue"); err != storage.ErrTenantNotFound {
+			t.Errorf("toDoRepository.DeleteTenant() error = %v, want %v", err, storage.ErrTenantNotFound)
+		}
+		for _, table := range []string{"ToDo", "Project", "ToDoHistory", "ToDoShare", "ToDoDependency", "ToDoLabel"} {
+			var n int
+			if err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&n); err != nil {
+				t.Fatalf("failed to count %s -> %v", table, err)
+			}
+			if want := map[string]int{"ToDo": 2, "Project": 1}[table]; n != want {
+				t.Errorf("%s has %d rows after DeleteTenant(), want %d", table, n, want)
+			}
+		}
+	})
+}
+
+func TestTenantMigrationIntegration(t *testing.T) {
+	ctx := context.Background()
+	//beforeTenant is the version of the migration preceding the tenant migration
+	const beforeTenant = 20200620100000
+	for _, d := range testDatabases {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			db, cleanup := d.open(t)
+			defer cleanup()
+
+			//data stored before tenants belongs to no tenant after the migration
+			if err := d.migrate(db, beforeTenant); err != nil {
+				t.Fatalf("failed to roll back migrations: %v", err)
+			}
+			tm := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+			if _, err := db.ExecContext(ctx, d.dialect.rebind("INSERT INTO Project (Name, Description, Archived, CreatedAt, UpdatedAt) VALUES (?, ?, ?, ?, ?)"),
+				"work", "", false, tm, tm); err != nil {
+				t.Fatalf("failed to insert into Project: %v", err)
+			}
+			if _, err := db.ExecContext(ctx, d.dialect.rebind("INSERT INTO ToDo (Title, Description, Status, Owner, CreatedAt, UpdatedAt) VALUES (?, ?, ?, ?, ?, ?)"),
+				"report", "", "TODO", "alice", tm, tm); err != nil {
+				t.Fatalf("failed to insert into ToDo: %v", err)
+			}
+			latest, err := goose.GetMostRecentDBVersion(d.migrationsDir())
+			if err != nil {
+				t.Fatalf("failed to read migrations: %v", err)
+			}
+			if err := d.migrate(db, latest); err != nil {
+				t.Fatalf("failed to run migrations: %v", err)
+			}
+
+			root := NewToDoRepository(db, d.dialect)
+			if err := root.CreateTenant(ctx, &storage.Tenant{ID: "blue", Name: "Blue team", CreatedAt: tm, UpdatedAt: tm}); err != nil {
+				t.Fatalf("toDoRepository.CreateTenant() error = %v", err)
+			}
+			blue := root.ForTenant("blue")
+			if td, err := root.Get(ctx, 1); err != nil || td.Title != "report" || td.Owner != "alice" || td.Tenant != "" {
+				t.Errorf("toDoRepository.Get() = %v, %v, want report of alice without tenant", td, err)
+			}
+			if _, err := blue.Get(ctx, 1); err != storage.ErrNotFound {
+				t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+			}
+			if p, err := root.GetProject(ctx, 1); err != nil || p.Name != "work" {
+				t.Errorf("toDoRepository.GetProject() = %v, %v, want work", p, err)
+			}
+			if _, err := blue.GetProject(ctx, 1); err != storage.ErrProjectNotFound {
+				t.Errorf("toDoRepository.GetProject() error = %v, want %v", err, storage.ErrProjectNotFound)
+			}
+
+			//migrated titles and project names stay unique without tenant only
+			if _, err := root.Create(ctx, &storage.ToDo{Title: "report", Owner: "alice", CreatedAt: tm, UpdatedAt: tm}); err != storage.ErrAlreadyExists {
+				t.Errorf("toDoRepository.Create() error = %v, want %v", err, storage.ErrAlreadyExists)
+			}
+			if _, err := blue.Create(ctx, &storage.ToDo{Title: "report", Owner: "alice", CreatedAt: tm, UpdatedAt: tm}); err != nil {
+				t.Errorf("toDoRepository.Create() error = %v", err)
+			}
+			if _, err := root.CreateProject(ctx, &storage.Project{Name: "work", CreatedAt: tm, UpdatedAt: tm}); err != storage.ErrProjectAlreadyExists {
+				t.Errorf("toDoRepository.CreateProject() error = %v, want %v", err, storage.ErrProjectAlreadyExists)
+			}
+			if _, err := blue.CreateProject(ctx, &storage.Project{Name: "work", CreatedAt: tm, UpdatedAt: tm}); err != nil {
+				t.Errorf("toDoRepository.CreateProject() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryListNullSortKeyIntegration(t *testing.T) {
+	ctx := context.Background()
+	forEachDatabase(t, func(t *testing.T, db *sql.DB, dialect Dialect) {
+		r := NewToDoRepository(db, dialect)
+		tm := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+		//tasks 3 and 4 have no reminder and no estimated time of completion which are stored as NULL
+		for i, at := range []time.Time{tm.Add(time.Hour), tm, {}, {}} {
+			td := &storage.ToDo{
+				Title:                     fmt.Sprintf("task %d", i+1),
+				Status:                    "TODO",
+				EstimatedTimeOfCompletion: at,
+				Reminder:                  at,
+				CreatedAt:                 tm,
+				UpdatedAt:                 tm,
+			}
+			if _, err := r.Create(ctx, td); err != nil {
+				t.Fatalf("toDoRepository.Create() error = %v", err)
+			}
+		}
+
+		//NULL is before every time like zero time of the memory repository
+		tests := []struct {
+			name string
+			opts storage.ListOptions
+			want []int64
+		}{
+			{"Reminder", storage.ListOptions{SortBy: storage.SortByReminder}, []int64{3, 4, 2, 1}},
+			{"Reminder descending", storage.ListOptions{SortBy: storage.SortByReminder, Descending: true}, []int64{1, 2, 4, 3}},
+			{"Estimated time of completion", storage.ListOptions{SortBy: storage.SortByEstimatedTimeOfCompletion}, []int64{3, 4, 2, 1}},
+			{"Estimated time of completion descending", storage.ListOptions{SortBy: storage.SortByEstimatedTimeOfCompletion, Descending: true}, []int64{1, 2, 4, 3}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				//one task per page, the last task of the page is passed like it is decoded from page token
+				opts := tt.opts
+				opts.Limit = 1
+				var got []int64
+				for len(got) <= len(tt.want) {
+					list, err := r.List(ctx, opts)
+					if err != nil {
+						t.Fatalf("toDoRepository.List() error = %v", err)
+					}
+					if len(list) == 0 {
+						break
+					}
+					got = append(got, list[0].ID)
+					opts.After = &storage.ToDo{ID: list[0].ID, Reminder: list[0].Reminder, EstimatedTimeOfCompletion: list[0].EstimatedTimeOfCompletion}
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("toDoRepository.List() pages = %v, want %v", got, tt.want)
+				}
+
+				//the whole list is in the same order
+				opts.Limit, opts.After = 0, nil
+				list, err := r.List(ctx, opts)
+				if err != nil {
+					t.Fatalf("toDoRepository.List() error = %v", err)
+				}
+				var all []int64
+				for _, td := range list {
+					all = append(all, td.ID)
+				}
+				if !reflect.DeepEqual(all, tt.want) {
+					t.Errorf("toDoRepository.List() = %v, want %v", all, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestMigrationsIntegration(t *testing.T) {
+	ctx := context.Background()
+	for _, d := range testDatabases {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			db, cleanup := d.open(t)
+			defer cleanup()
+
+			//seed creates data in tables of every migration, down migrations run over it
+			seed := func(t *testing.T) {
+				root := NewToDoRepository(db, d.dialect)
+				tm := time.Date(2020, 7, 10, 10, 0, 0, 0, time.UTC)
+				if err := root.CreateTenant(ctx, &storage.Tenant{ID: "blue", Name: "Blue team", CreatedAt: tm, UpdatedAt: tm}); err != nil {
+					t.Fatalf("toDoRepository.CreateTenant() error = %v", err)
+				}
+				r := root.ForTenant("blue")
+				projectID, err := r.CreateProject(ctx, &storage.Project{Name: "work", CreatedAt: tm, UpdatedAt: tm})
+				if err != nil {
+					t.Fatalf("toDoRepository.CreateProject() error = %v", err)
+				}
+				parent, err := r.Create(ctx, &storage.ToDo{Title: "release", Status: "TODO", ProjectID: projectID, Labels: []string{"urgent"}, Owner: "alice", Reminder: tm, CreatedAt: tm, UpdatedAt: tm})
+				if err != nil {
+					t.Fatalf("toDoRepository.Create() error = %v", err)
+				}
+				child, err := r.Create(ctx, &storage.ToDo{Title: "build", Status: "TODO", ParentID: parent, Owner: "alice", CreatedAt: tm, UpdatedAt: tm})
+				if err != nil {
+					t.Fatalf("toDoRepository.Create() error = %v", err)
+				}
+				if err := r.AddDependency(ctx, parent, child); err != nil {
+					t.Fatalf("toDoRepository.AddDependency() error = %v", err)
+				}
+				if err := r.Share(ctx, parent, "bob", storage.ShareEditor); err != nil {
+					t.Fatalf("toDoRepository.Share() error = %v", err)
+				}
+				if _, err := r.AddHistory(ctx, &storage.HistoryEntry{ToDoID: parent, Action: storage.HistoryCreated, Snapshot: map[string]string{"title": "release"}, Actor: "alice", CreatedAt: tm}); err != nil {
+					t.Fatalf("toDoRepository.AddHistory() error = %v", err)
+				}
+			}
+			seed(t)
+
+			latest, err := goose.GetMostRecentDBVersion(d.migrationsDir())
+			if err != nil {
+				t.Fatalf("failed to read migrations: %v", err)
+			}
+			migrations, err := goose.CollectMigrations(d.migrationsDir(), 0, latest)
+			if err != nil {
+				t.Fatalf("failed to read migrations: %v", err)
+			}
+
+			//every migration is rolled back one by one with the data in place and applied again
+			for i := len(migrations) - 1; i >= 0; i-- {
+				m := migrations[i]
+				if err := d.migrate(db, m.Version-1); err != nil {
+					t.Fatalf("failed to roll back %s: %v", filepath.Base(m.Source), err)
+				}
+			}
+			if err := d.migrate(db, latest); err != nil {
+				t.Fatalf("failed to run migrations: %v", err)
+			}
+			seed(t)
+		})
+	}
+}
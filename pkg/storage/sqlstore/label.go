@@ -0,0 +1,220 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//queryer is implemented by both connection and transaction
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+//AddLabels inserts labels of todo entity
+func (r *toDoRepository) AddLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error {
+	return r.changeLabels(ctx, id, updatedAt, func(tx *sql.Tx) error {
+		return r.addLabels(ctx, tx, id, labels)
+	})
+}
+
+//RemoveLabels deletes labels of todo entity
+func (r *toDoRepository) RemoveLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error {
+	return r.changeLabels(ctx, id, updatedAt, func(tx *sql.Tx) error {
+		if len(labels) == 0 {
+			return nil
+		}
+		args := append([]interface{}{id}, stringArgs(labels)...)
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoLabel WHERE ToDoID=? AND LabelID IN (SELECT ID FROM Label WHERE Name IN ("+placeholders(len(labels))+"))"), args...); err != nil {
+			return fmt.Errorf("failed to delete ToDoLabel -> %s", err.Error())
+		}
+		return nil
+	})
+}
+
+//changeLabels runs change of labels of existing todo entity and updates its UpdatedAt in a transaction
+func (r *toDoRepository) changeLabels(ctx context.Context, id int64, updatedAt time.Time, change func(tx *sql.Tx) error) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := r.parentOf(ctx, tx, id); err != nil {
+		return err
+	}
+	if err := change(tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET UpdatedAt=?, Version=Version+1 WHERE ID=?"), nullTime(updatedAt), id); err != nil {
+		return fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return nil
+}
+
+//addLabels inserts labels todo entity does not have yet, missing labels are created
+func (r *toDoRepository) addLabels(ctx context.Context, tx *sql.Tx, id int64, labels []string) error {
+	for _, name := range labels {
+		labelID, err := r.labelID(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDoLabel WHERE ToDoID=? AND LabelID=?"), id, labelID).Scan(&count); err != nil {
+			return fmt.Errorf("failed to select from ToDoLabel -> %s", err.Error())
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("INSERT INTO ToDoLabel(ToDoID,LabelID) VALUES (?,?)"), id, labelID); err != nil {
+			return fmt.Errorf("failed to insert into ToDoLabel -> %s", err.Error())
+		}
+	}
+	return nil
+}
+
+//labelID selects ID of the label by name and inserts the label when it does not exist
+func (r *toDoRepository) labelID(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT ID FROM Label WHERE Name=?"), name).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, fmt.Errorf("failed to select from Label -> %s", err.Error())
+	}
+
+	query := "INSERT INTO Label(Name) VALUES (?)"
+	if r.dialect.returning() {
+		err = tx.QueryRowContext(ctx, r.dialect.rebind(query+" RETURNING ID"), name).Scan(&id)
+	} else {
+		var res sql.Result
+		if res, err = tx.ExecContext(ctx, query, name); err == nil {
+			id, err = res.LastInsertId()
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert into Label -> %s", err.Error())
+	}
+	return id, nil
+}
+
+//ListLabels counts todo entities by label
+func (r *toDoRepository) ListLabels(ctx context.Context, projectID int64, user string) ([]*storage.LabelCount, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	//todo entities in the trash are not counted
+	query := "SELECT L.Name, COUNT(*) FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID JOIN ToDo T ON T.ID=TL.ToDoID WHERE T.DeletedAt IS NULL"
+	var args []interface{}
+	if projectID != 0 {
+		query += " AND T.ProjectID=?"
+		args = append(args, projectID)
+	}
+	if len(user) > 0 {
+		query += " AND (T.Owner=? OR T.ID IN (SELECT ToDoID FROM ToDoShare WHERE UserName=?))"
+		args = append(args, user, user)
+	}
+	query += " AND T.TenantID=? GROUP BY L.Name ORDER BY L.Name"
+	args = append(args, r.tenant)
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDoLabel -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.LabelCount{}
+	for rows.Next() {
+		l := &storage.LabelCount{}
+		if err := rows.Scan(&l.Name, &l.Count); err != nil {
+			return nil, fmt.Errorf("failed to retrieve field values from ToDoLabel row -> %s", err.Error())
+		}
+		list = append(list, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDoLabel -> %s", err.Error())
+	}
+	return list, nil
+}
+
+//loadLabels selects labels of todo entities
+func (r *toDoRepository) loadLabels(ctx context.Context, q queryer, list []*storage.ToDo) error {
+	if len(list) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*storage.ToDo, len(list))
+	ids := make([]int64, 0, len(list))
+	for _, td := range list {
+		byID[td.ID] = td
+		ids = append(ids, td.ID)
+	}
+
+	rows, err := q.QueryContext(ctx, r.dialect.rebind("SELECT TL.ToDoID, L.Name FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID WHERE TL.ToDoID IN ("+placeholders(len(ids))+")"), int64Args(ids)...)
+	if err != nil {
+		return fmt.Errorf("failed to select from ToDoLabel -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return fmt.Errorf("failed to retrieve field values from ToDoLabel row -> %s", err.Error())
+		}
+		if td := byID[id]; td != nil {
+			td.Labels = append(td.Labels, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to retrieve data from ToDoLabel -> %s", err.Error())
+	}
+
+	for _, td := range list {
+		sort.Strings(td.Labels)
+	}
+	return nil
+}
+
+//stringArgs converts strings to statement arguments
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+//distinct returns values without duplicates in the original order
+func distinct(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var list []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			list = append(list, v)
+		}
+	}
+	return list
+}
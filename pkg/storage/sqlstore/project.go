@@ -0,0 +1,211 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//projectColumns are columns of Project table in the order scanProject reads them
+const projectColumns = "ID,Name,Description,Archived,CreatedAt,UpdatedAt"
+
+//CreateProject inserts a new project
+func (r *toDoRepository) CreateProject(ctx context.Context, p *storage.Project) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	query := "INSERT INTO Project(Name,Description,Archived,CreatedAt,UpdatedAt,TenantID) VALUES (?,?,?,?,?,?)"
+	args := []interface{}{p.Name, p.Description, p.Archived, nullTime(p.CreatedAt), nullTime(p.UpdatedAt), r.tenant}
+
+	var id int64
+	if r.dialect.returning() {
+		//get ID of created project from the inserted row
+		err = c.QueryRowContext(ctx, r.dialect.rebind(query+" RETURNING ID"), args...).Scan(&id)
+	} else {
+		var res sql.Result
+		if res, err = c.ExecContext(ctx, query, args...); err == nil {
+			//get ID of created project
+			if id, err = res.LastInsertId(); err != nil {
+				return 0, fmt.Errorf("failed to retrieve id for created Project -> %s", err.Error())
+			}
+		}
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, storage.ErrProjectAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to insert into Project -> %s", err.Error())
+	}
+	return id, nil
+}
+
+//GetProject selects project by ID
+func (r *toDoRepository) GetProject(ctx context.Context, id int64) (*storage.Project, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT "+projectColumns+" FROM Project WHERE ID=? AND TenantID=?"), id, r.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from Project -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to retrieve data from Project -> %s", err.Error())
+		}
+		return nil, storage.ErrProjectNotFound
+	}
+	return scanProject(rows)
+}
+
+//UpdateProject updates every column of project
+func (r *toDoRepository) UpdateProject(ctx context.Context, p *storage.Project) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	res, err := c.ExecContext(ctx, r.dialect.rebind("UPDATE Project SET Name=?, Description=?, Archived=?, UpdatedAt=? WHERE ID=? AND TenantID=?"),
+		p.Name, p.Description, p.Archived, nullTime(p.UpdatedAt), p.ID, r.tenant)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, storage.ErrProjectAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to update Project -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err == storage.ErrNotFound {
+		return 0, storage.ErrProjectNotFound
+	}
+	return rows, err
+}
+
+//DeleteProject deletes project and, when cascade is true, its todo entities with their completions
+func (r *toDoRepository) DeleteProject(ctx context.Context, id int64, cascade bool) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	//delete project first so that no todo entity is added to it meanwhile
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM Project WHERE ID=? AND TenantID=?"), id, r.tenant)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete Project -> %s", err.Error())
+	}
+	if _, err := rowsAffected(res); err == storage.ErrNotFound {
+		return 0, storage.ErrProjectNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	var tasks int64
+	if err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDo WHERE ProjectID=?"), id).Scan(&tasks); err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	if tasks > 0 {
+		if !cascade {
+			return 0, storage.ErrProjectNotEmpty
+		}
+
+		//subtasks of deleted todo entities in other projects become top level todo entities
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET ParentID=0, Version=Version+1 WHERE ProjectID<>? AND ParentID IN (SELECT ID FROM (SELECT ID FROM ToDo WHERE ProjectID=?) Deleted)"), id, id); err != nil {
+			return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+		}
+
+		//delete completion history of todo entities of the project
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoCompletion WHERE ToDoID IN (SELECT ID FROM ToDo WHERE ProjectID=?)"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete ToDoCompletion -> %s", err.Error())
+		}
+		//delete labels of todo entities of the project
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoLabel WHERE ToDoID IN (SELECT ID FROM ToDo WHERE ProjectID=?)"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete ToDoLabel -> %s", err.Error())
+		}
+
+		//delete shares of todo entities of the project
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoShare WHERE ToDoID IN (SELECT ID FROM ToDo WHERE ProjectID=?)"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete ToDoShare -> %s", err.Error())
+		}
+
+		//delete dependencies of todo entities of the project and on them
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoDependency WHERE ToDoID IN (SELECT ID FROM ToDo WHERE ProjectID=?) OR DependsOnID IN (SELECT ID FROM ToDo WHERE ProjectID=?)"), id, id); err != nil {
+			return 0, fmt.Errorf("failed to delete ToDoDependency -> %s", err.Error())
+		}
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDo WHERE ProjectID=?"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete ToDo -> %s", err.Error())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return tasks, nil
+}
+
+//ListProjects selects projects sorted by name
+func (r *toDoRepository) ListProjects(ctx context.Context, includeArchived bool) ([]*storage.Project, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	query := "SELECT " + projectColumns + " FROM Project WHERE TenantID=?"
+	args := []interface{}{r.tenant}
+	if !includeArchived {
+		query += " AND Archived=?"
+		args = append(args, false)
+	}
+	rows, err := c.QueryContext(ctx, r.dialect.rebind(query+" ORDER BY Name, ID"), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from Project -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.Project{}
+	for rows.Next() {
+		p, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from Project -> %s", err.Error())
+	}
+	return list, nil
+}
+
+//scanProject reads project from the current row
+func scanProject(rows *sql.Rows) (*storage.Project, error) {
+	p := new(storage.Project)
+	var createdAt, updatedAt timeValue
+	if err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.Archived, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to retrieve field values from Project row -> %s", err.Error())
+	}
+	p.CreatedAt = createdAt.Time
+	p.UpdatedAt = updatedAt.Time
+	return p, nil
+}
@@ -0,0 +1,115 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestProjectRepositoryDelete(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	count := func(n int) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"COUNT(*)"}).AddRow(n)
+	}
+
+	tests := []struct {
+		name    string
+		cascade bool
+		mock    func()
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "Empty",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM Project WHERE").WithArgs(1, "").WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ToDo WHERE ProjectID=\?`).WithArgs(1).WillReturnRows(count(0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Cascade",
+			cascade: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM Project WHERE").WithArgs(1, "").WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(count(2))
+				mock.ExpectExec(`UPDATE ToDo SET ParentID=0, Version=Version\+1 WHERE ProjectID<>\? AND ParentID IN`).WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDoCompletion WHERE ToDoID IN \(SELECT ID FROM ToDo WHERE ProjectID=\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDoLabel WHERE ToDoID IN \(SELECT ID FROM ToDo WHERE ProjectID=\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDoShare WHERE ToDoID IN \(SELECT ID FROM ToDo WHERE ProjectID=\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDoDependency WHERE ToDoID IN \(SELECT ID FROM ToDo WHERE ProjectID=\?\) OR DependsOnID IN \(SELECT ID FROM ToDo WHERE ProjectID=\?\)`).WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDo WHERE ProjectID=\?`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			want: 2,
+		},
+		{
+			name: "Not empty",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM Project WHERE").WithArgs(1, "").WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(count(2))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrProjectNotEmpty,
+		},
+		{
+			name:    "Not Found",
+			cascade: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM Project WHERE").WithArgs(1, "").WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrProjectNotFound,
+		},
+		{
+			name:    "DELETE failed",
+			cascade: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM Project WHERE").WithArgs(1, "").WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(count(2))
+				mock.ExpectExec("UPDATE ToDo SET ParentID=0").WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoCompletion").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM ToDoLabel").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoShare").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDo WHERE").WithArgs(1).WillReturnError(errors.New("DELETE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("DELETE failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.DeleteProject(ctx, 1, tt.cascade)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.DeleteProject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (tt.wantErr == storage.ErrProjectNotFound || tt.wantErr == storage.ErrProjectNotEmpty) && err != tt.wantErr {
+				t.Errorf("toDoRepository.DeleteProject() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.DeleteProject() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Share replaces the share of todo entity with the user
+func (r *toDoRepository) Share(ctx context.Context, id int64, user string, role storage.ShareRole) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := r.parentOf(ctx, tx, id); err != nil {
+		return err
+	}
+
+	//the previous role of the user is replaced
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoShare WHERE ToDoID=? AND UserName=?"), id, user); err != nil {
+		return fmt.Errorf("failed to delete ToDoShare -> %s", err.Error())
+	}
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("INSERT INTO ToDoShare(ToDoID,UserName,Role) VALUES (?,?,?)"), id, user, string(role)); err != nil {
+		return fmt.Errorf("failed to insert into ToDoShare -> %s", err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return nil
+}
+
+//Unshare deletes the share of todo entity with the user
+func (r *toDoRepository) Unshare(ctx context.Context, id int64, user string) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	res, err := c.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoShare WHERE ToDoID=? AND UserName=? AND ToDoID IN (SELECT ID FROM ToDo WHERE TenantID=?)"), id, user, r.tenant)
+	if err != nil {
+		return fmt.Errorf("failed to delete ToDoShare -> %s", err.Error())
+	}
+	if _, err := rowsAffected(res); err == storage.ErrNotFound {
+		return storage.ErrShareNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+//Shares selects shares of todo entity
+func (r *toDoRepository) Shares(ctx context.Context, id int64) ([]*storage.Share, error) {
+	//check that todo entity exists
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT ToDoID,UserName,Role FROM ToDoShare WHERE ToDoID=? ORDER BY UserName"), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDoShare -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.Share{}
+	for rows.Next() {
+		sh := &storage.Share{}
+		var role string
+		if err := rows.Scan(&sh.ToDoID, &sh.User, &role); err != nil {
+			return nil, fmt.Errorf("failed to retrieve field values from ToDoShare row -> %s", err.Error())
+		}
+		sh.Role = storage.ShareRole(role)
+		list = append(list, sh)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDoShare -> %s", err.Error())
+	}
+	return list, nil
+}
+
+//ShareRole selects role of the user on todo entity
+func (r *toDoRepository) ShareRole(ctx context.Context, id int64, user string) (storage.ShareRole, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	var role string
+	err = c.QueryRowContext(ctx, r.dialect.rebind("SELECT Role FROM ToDoShare WHERE ToDoID=? AND UserName=? AND ToDoID IN (SELECT ID FROM ToDo WHERE TenantID=?)"), id, user, r.tenant).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to select from ToDoShare -> %s", err.Error())
+	}
+	return storage.ShareRole(role), nil
+}
+
+//AssignOwner updates owner of todo entities without owner
+func (r *toDoRepository) AssignOwner(ctx context.Context, owner string) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	res, err := c.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET Owner=? WHERE Owner='' AND TenantID=?"), owner, r.tenant)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve rows affected value -> %s", err.Error())
+	}
+	return rows, nil
+}
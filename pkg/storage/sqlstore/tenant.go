@@ -0,0 +1,181 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//tenantColumns are columns of Tenant table in the order scanTenant reads them
+const tenantColumns = "ID,Name,MaxToDos,Suspended,CreatedAt,UpdatedAt"
+
+//CreateTenant inserts a new tenant
+func (r *toDoRepository) CreateTenant(ctx context.Context, t *storage.Tenant) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	_, err = c.ExecContext(ctx, r.dialect.rebind("INSERT INTO Tenant("+tenantColumns+") VALUES (?,?,?,?,?,?)"),
+		t.ID, t.Name, t.MaxToDos, t.Suspended, nullTime(t.CreatedAt), nullTime(t.UpdatedAt))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return storage.ErrTenantAlreadyExists
+		}
+		return fmt.Errorf("failed to insert into Tenant -> %s", err.Error())
+	}
+	return nil
+}
+
+//GetTenant selects tenant by ID
+func (r *toDoRepository) GetTenant(ctx context.Context, id string) (*storage.Tenant, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT "+tenantColumns+" FROM Tenant WHERE ID=?"), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from Tenant -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to retrieve data from Tenant -> %s", err.Error())
+		}
+		return nil, storage.ErrTenantNotFound
+	}
+	return scanTenant(rows)
+}
+
+//UpdateTenant updates every column of tenant except its creation time
+func (r *toDoRepository) UpdateTenant(ctx context.Context, t *storage.Tenant) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	res, err := c.ExecContext(ctx, r.dialect.rebind("UPDATE Tenant SET Name=?, MaxToDos=?, Suspended=?, UpdatedAt=? WHERE ID=?"),
+		t.Name, t.MaxToDos, t.Suspended, nullTime(t.UpdatedAt), t.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update Tenant -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err == storage.ErrNotFound {
+		return 0, storage.ErrTenantNotFound
+	}
+	return rows, err
+}
+
+//ListTenants selects tenants sorted by ID
+func (r *toDoRepository) ListTenants(ctx context.Context) ([]*storage.Tenant, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, "SELECT "+tenantColumns+" FROM Tenant ORDER BY ID")
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from Tenant -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.Tenant{}
+	for rows.Next() {
+		t, err := scanTenant(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from Tenant -> %s", err.Error())
+	}
+	return list, nil
+}
+
+//DeleteTenant deletes tenant with its todo entities, their completions, labels, shares and dependencies,
+//its projects and its history entries
+func (r *toDoRepository) DeleteTenant(ctx context.Context, id string) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	//delete tenant first so that its users are rejected meanwhile
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM Tenant WHERE ID=?"), id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete Tenant -> %s", err.Error())
+	}
+	if _, err := rowsAffected(res); err == storage.ErrNotFound {
+		return 0, storage.ErrTenantNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	const todos = "SELECT ID FROM ToDo WHERE TenantID=?"
+	for _, table := range []string{"ToDoCompletion", "ToDoLabel", "ToDoShare"} {
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM "+table+" WHERE ToDoID IN ("+todos+")"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete %s -> %s", table, err.Error())
+		}
+	}
+	//dependencies never cross tenants
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoDependency WHERE ToDoID IN ("+todos+")"), id); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoDependency -> %s", err.Error())
+	}
+	res, err = tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDo WHERE TenantID=?"), id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ToDo -> %s", err.Error())
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve rows affected value -> %s", err.Error())
+	}
+	for _, table := range []string{"Project", "ToDoHistory"} {
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM "+table+" WHERE TenantID=?"), id); err != nil {
+			return 0, fmt.Errorf("failed to delete %s -> %s", table, err.Error())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return deleted, nil
+}
+
+//ForTenant returns repository on the same database scoped to the tenant
+func (r *toDoRepository) ForTenant(id string) storage.Repository {
+	return &toDoRepository{db: r.db, dialect: r.dialect, tenant: id}
+}
+
+//scanTenant reads tenant from the current row
+func scanTenant(rows *sql.Rows) (*storage.Tenant, error) {
+	t := new(storage.Tenant)
+	var createdAt, updatedAt timeValue
+	if err := rows.Scan(&t.ID, &t.Name, &t.MaxToDos, &t.Suspended, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to retrieve field values from Tenant row -> %s", err.Error())
+	}
+	t.CreatedAt = createdAt.Time
+	t.UpdatedAt = updatedAt.Time
+	return t, nil
+}
@@ -0,0 +1,946 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//toDoRepository is a storage.Repository backed by SQL database.
+//Queries are written with '?' bind variables and unquoted identifiers
+//and are rebound to the dialect of the database before execution.
+//Rows of todo entities, projects and history entries belong to the tenant in their TenantID column,
+//other rows belong to the tenant of their todo entity.
+type toDoRepository struct {
+	db      *sql.DB
+	dialect Dialect
+
+	//tenant is the tenant the repository is scoped to, empty for data stored without tenant
+	tenant string
+}
+
+//NewToDoRepository creates ToDo repository on top of the database connection pool
+func NewToDoRepository(db *sql.DB, dialect Dialect) storage.Repository {
+	return &toDoRepository{db: db, dialect: dialect}
+}
+
+//connect returns SQL database connection from the pool
+func (r *toDoRepository) connect(ctx context.Context) (*sql.Conn, error) {
+	c, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database -> %s", err.Error())
+	}
+	return c, nil
+}
+
+//toDoColumns are columns of ToDo table in the order scanToDo reads them
+const toDoColumns = "ID,Title,Description,Status,EstimatedTimeOfCompletion,ActualTimeOfCompletion,Reminder,CreatedAt,UpdatedAt,Recurrence,ProjectID,ParentID,DeletedAt,Version,Owner,TenantID"
+
+//Create inserts a new todo entity
+func (r *toDoRepository) Create(ctx context.Context, td *storage.ToDo) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	id, err := r.create(ctx, tx, td)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return id, nil
+}
+
+//create inserts a new todo entity in the transaction
+func (r *toDoRepository) create(ctx context.Context, tx *sql.Tx, td *storage.ToDo) (int64, error) {
+	//subtask is added only to existing parent
+	if td.ParentID != 0 {
+		if _, err := r.parentOf(ctx, tx, td.ParentID); err == storage.ErrNotFound {
+			return 0, storage.ErrParentNotFound
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	//insert todo entity data
+	query := "INSERT INTO ToDo(Title,Description,Status,EstimatedTimeOfCompletion,ActualTimeOfCompletion,Reminder,CreatedAt,UpdatedAt,Recurrence,ProjectID,ParentID,Owner,TenantID) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)"
+	args := []interface{}{td.Title, td.Description, td.Status, nullTime(td.EstimatedTimeOfCompletion), nullTime(td.ActualTimeOfCompletion), nullTime(td.Reminder), nullTime(td.CreatedAt), nullTime(td.UpdatedAt), td.Recurrence, td.ProjectID, td.ParentID, td.Owner, r.tenant}
+
+	var id int64
+	var err error
+	if r.dialect.returning() {
+		//get ID of created todo entity from the inserted row
+		err = tx.QueryRowContext(ctx, r.dialect.rebind(query+" RETURNING ID"), args...).Scan(&id)
+	} else {
+		var res sql.Result
+		if res, err = tx.ExecContext(ctx, query, args...); err == nil {
+			//get ID of created todo entity
+			if id, err = res.LastInsertId(); err != nil {
+				return 0, fmt.Errorf("failed to retrieve id for created ToDo -> %s", err.Error())
+			}
+		}
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, storage.ErrAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to insert into ToDo -> %s", err.Error())
+	}
+
+	if !td.ActualTimeOfCompletion.IsZero() {
+		if err := r.openCompletion(ctx, tx, id, td.ActualTimeOfCompletion); err != nil {
+			return 0, err
+		}
+	}
+	if err := r.addLabels(ctx, tx, id, td.Labels); err != nil {
+		return 0, err
+	}
+
+	//version of inserted row is set by the column default
+	td.Version = 1
+	td.Tenant = r.tenant
+	return id, nil
+}
+
+//Get selects todo entity by ID, todo entity in the trash is not found
+func (r *toDoRepository) Get(ctx context.Context, id int64) (*storage.ToDo, error) {
+	return r.get(ctx, id, "DeletedAt IS NULL")
+}
+
+//Trashed selects todo entity in the trash by ID
+func (r *toDoRepository) Trashed(ctx context.Context, id int64) (*storage.ToDo, error) {
+	return r.get(ctx, id, "DeletedAt IS NOT NULL")
+}
+
+//get selects todo entity by ID which matches the condition
+func (r *toDoRepository) get(ctx context.Context, id int64, cond string) (*storage.ToDo, error) {
+	//get SQL connection from  the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	//query todo entity by ID
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT "+toDoColumns+" FROM ToDo WHERE ID=? AND "+cond+" AND TenantID=?"), id, r.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to retrieve data from ToDo -> %s", err.Error())
+		}
+		return nil, storage.ErrNotFound
+	}
+
+	td, err := scanToDo(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows.Next() {
+		return nil, fmt.Errorf("found multiple ToDo rows with ID='%d'", id)
+	}
+	rows.Close()
+
+	if err := r.loadLabels(ctx, c, []*storage.ToDo{td}); err != nil {
+		return nil, err
+	}
+	return td, nil
+}
+
+//Update updates every column of todo entity and records completion history
+func (r *toDoRepository) Update(ctx context.Context, td *storage.ToDo) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	rows, err := r.update(ctx, tx, td)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
+
+//update updates every column of todo entity and records completion history in the transaction
+func (r *toDoRepository) update(ctx context.Context, tx *sql.Tx, td *storage.ToDo) (int64, error) {
+	//get completion and reminder times and version stored before the update
+	var completed, reminder timeValue
+	var version int64
+	err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo WHERE ID=? AND DeletedAt IS NULL AND TenantID=?"), td.ID, r.tenant).Scan(&completed, &reminder, &version)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	if td.Version != 0 && td.Version != version {
+		return 0, storage.ErrVersionMismatch
+	}
+
+	//update todo entity, changed reminder has to be claimed and delivered again
+	query := "UPDATE ToDo SET Title=?, Description=?, Status=?, EstimatedTimeOfCompletion=?, ActualTimeOfCompletion=?,Reminder=?, UpdatedAt=?, Recurrence=?, ProjectID=?, Version=Version+1"
+	if !reminder.Equal(td.Reminder) {
+		query += ", ReminderSentAt=NULL, ReminderClaimedUntil=NULL"
+	}
+	args := []interface{}{td.Title, td.Description, td.Status, nullTime(td.EstimatedTimeOfCompletion), nullTime(td.ActualTimeOfCompletion), nullTime(td.Reminder), nullTime(td.UpdatedAt), td.Recurrence, td.ProjectID, td.ID}
+	query += " WHERE ID=? AND DeletedAt IS NULL"
+	if td.Version != 0 {
+		//concurrent update changed the version after it was checked
+		query += " AND Version=?"
+		args = append(args, td.Version)
+	}
+	res, err := tx.ExecContext(ctx, r.dialect.rebind(query), args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, storage.ErrAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err == storage.ErrNotFound && td.Version != 0 {
+		return 0, storage.ErrVersionMismatch
+	}
+	if err != nil {
+		return 0, err
+	}
+	td.Version = version + 1
+
+	switch {
+	case completed.IsZero() && !td.ActualTimeOfCompletion.IsZero():
+		err = r.openCompletion(ctx, tx, td.ID, td.ActualTimeOfCompletion)
+	case !completed.IsZero() && td.ActualTimeOfCompletion.IsZero():
+		//close the open completion, todo entity is reopened
+		_, err = tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDoCompletion SET ReopenedAt=? WHERE ToDoID=? AND ReopenedAt IS NULL"), nullTime(td.UpdatedAt), td.ID)
+		if err != nil {
+			err = fmt.Errorf("failed to update ToDoCompletion -> %s", err.Error())
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+//openCompletion inserts completion of todo entity which is not reopened yet
+func (r *toDoRepository) openCompletion(ctx context.Context, tx *sql.Tx, id int64, completedAt time.Time) error {
+	_, err := tx.ExecContext(ctx, r.dialect.rebind("INSERT INTO ToDoCompletion(ToDoID,CompletedAt) VALUES (?,?)"), id, completedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert into ToDoCompletion -> %s", err.Error())
+	}
+	return nil
+}
+
+//Delete deletes todo entity, its completions and dependencies by ID and handles its subtasks according to the mode
+func (r *toDoRepository) Delete(ctx context.Context, id int64, mode storage.DeleteMode) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	rows, err := r.delete(ctx, tx, id, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
+
+//delete deletes todo entity and handles its subtasks according to the mode in the transaction
+func (r *toDoRepository) delete(ctx context.Context, tx *sql.Tx, id int64, mode storage.DeleteMode) (int64, error) {
+	parentID, err := r.parentOf(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := []int64{id}
+	switch mode {
+	case storage.DeleteCascade:
+		descendants, err := r.descendantIDs(ctx, tx, id, "")
+		if err != nil {
+			return 0, err
+		}
+		ids = append(ids, descendants...)
+	case storage.DeleteReparent:
+		//subtasks take place of the deleted todo entity
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET ParentID=?, Version=Version+1 WHERE ParentID=?"), parentID, id); err != nil {
+			return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+		}
+	default:
+		var children int64
+		if err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDo WHERE ParentID=?"), id).Scan(&children); err != nil {
+			return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+		}
+		if children > 0 {
+			return 0, storage.ErrHasChildren
+		}
+	}
+
+	//delete completion history of todo entities
+	args := int64Args(ids)
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoCompletion WHERE ToDoID IN ("+placeholders(len(ids))+")"), args...); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoCompletion -> %s", err.Error())
+	}
+
+	//delete labels of todo entities
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoLabel WHERE ToDoID IN ("+placeholders(len(ids))+")"), args...); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoLabel -> %s", err.Error())
+	}
+
+	//delete shares of todo entities
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoShare WHERE ToDoID IN ("+placeholders(len(ids))+")"), args...); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoShare -> %s", err.Error())
+	}
+
+	//delete dependencies of todo entities and on them
+	in := placeholders(len(ids))
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoDependency WHERE ToDoID IN ("+in+") OR DependsOnID IN ("+in+")"), append(args, args...)...); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoDependency -> %s", err.Error())
+	}
+
+	//delete todo entities
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDo WHERE ID IN ("+placeholders(len(ids))+")"), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ToDo -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+//Move makes todo entity a subtask of another todo entity
+func (r *toDoRepository) Move(ctx context.Context, id int64, parentID int64, updatedAt time.Time) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := r.parentOf(ctx, tx, id); err != nil {
+		return 0, err
+	}
+
+	//walk up from the new parent, the todo entity must not be met on the way
+	seen := map[int64]bool{}
+	for ancestor := parentID; ancestor != 0; {
+		if ancestor == id || seen[ancestor] {
+			return 0, storage.ErrCycle
+		}
+		seen[ancestor] = true
+		if ancestor, err = r.parentOf(ctx, tx, ancestor); err == storage.ErrNotFound {
+			return 0, storage.ErrParentNotFound
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET ParentID=?, UpdatedAt=?, Version=Version+1 WHERE ID=?"), parentID, nullTime(updatedAt), id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
+
+//Descendants selects subtasks of todo entity at all levels level by level
+func (r *toDoRepository) Descendants(ctx context.Context, id int64) ([]*storage.ToDo, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := r.parentOf(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	list := []*storage.ToDo{}
+	seen := map[int64]bool{id: true}
+	for level := []int64{id}; len(level) > 0; {
+		rows, err := tx.QueryContext(ctx, r.dialect.rebind("SELECT "+toDoColumns+" FROM ToDo WHERE ParentID IN ("+placeholders(len(level))+") AND DeletedAt IS NULL ORDER BY ID"), int64Args(level)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+		}
+		children := map[int64][]*storage.ToDo{}
+		for rows.Next() {
+			td, err := scanToDo(rows)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+			children[td.ParentID] = append(children[td.ParentID], td)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve data from ToDo-> %s", err.Error())
+		}
+
+		//subtasks are grouped by parents in the order the parents were returned
+		var next []int64
+		for _, parentID := range level {
+			for _, td := range children[parentID] {
+				if seen[td.ID] {
+					continue
+				}
+				seen[td.ID] = true
+				list = append(list, td)
+				next = append(next, td.ID)
+			}
+		}
+		level = next
+	}
+
+	if err := r.loadLabels(ctx, tx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+//parentOf selects parent ID of todo entity or returns storage.ErrNotFound when it does not exist, it is in the trash
+//or it belongs to another tenant
+func (r *toDoRepository) parentOf(ctx context.Context, tx *sql.Tx, id int64) (int64, error) {
+	var parentID int64
+	err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT ParentID FROM ToDo WHERE ID=? AND DeletedAt IS NULL AND TenantID=?"), id, r.tenant).Scan(&parentID)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	return parentID, nil
+}
+
+//descendantIDs selects IDs of subtasks of todo entity at all levels,
+//only subtasks matching the condition with its arguments are selected and walked when it is not empty
+func (r *toDoRepository) descendantIDs(ctx context.Context, tx *sql.Tx, id int64, cond string, condArgs ...interface{}) ([]int64, error) {
+	query := "SELECT ID FROM ToDo WHERE ParentID IN (%s)"
+	if len(cond) > 0 {
+		query += " AND " + cond
+	}
+
+	var ids []int64
+	seen := map[int64]bool{id: true}
+	for level := []int64{id}; len(level) > 0; {
+		rows, err := tx.QueryContext(ctx, r.dialect.rebind(fmt.Sprintf(query, placeholders(len(level)))), append(int64Args(level), condArgs...)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+		}
+		var next []int64
+		for rows.Next() {
+			var child int64
+			if err := rows.Scan(&child); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to retrieve field values from ToDo row -> %s", err.Error())
+			}
+			if !seen[child] {
+				seen[child] = true
+				next = append(next, child)
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve data from ToDo-> %s", err.Error())
+		}
+		ids = append(ids, next...)
+		level = next
+	}
+	return ids, nil
+}
+
+//Completions selects completion history of todo entity
+func (r *toDoRepository) Completions(ctx context.Context, id int64) ([]*storage.Completion, error) {
+	//check that todo entity exists
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	rows, err := c.QueryContext(ctx, r.dialect.rebind("SELECT CompletedAt,ReopenedAt FROM ToDoCompletion WHERE ToDoID=? ORDER BY ID"), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDoCompletion -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.Completion{}
+	for rows.Next() {
+		var completedAt, reopenedAt timeValue
+		if err := rows.Scan(&completedAt, &reopenedAt); err != nil {
+			return nil, fmt.Errorf("failed to retrieve field values from ToDoCompletion row -> %s", err.Error())
+		}
+		list = append(list, &storage.Completion{CompletedAt: completedAt.Time, ReopenedAt: reopenedAt.Time})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDoCompletion -> %s", err.Error())
+	}
+	return list, nil
+}
+
+//DueReminders selects open todo entities of all tenants which reminder is due, not delivered and not claimed
+func (r *toDoRepository) DueReminders(ctx context.Context, until time.Time, closed []string) ([]*storage.ToDo, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	query := "SELECT " + toDoColumns + " FROM ToDo WHERE Reminder<=? AND ReminderSentAt IS NULL AND (ReminderClaimedUntil IS NULL OR ReminderClaimedUntil<=?) AND DeletedAt IS NULL"
+	args := []interface{}{until, until}
+	if len(closed) > 0 {
+		query += " AND Status NOT IN (" + placeholders(len(closed)) + ")"
+		args = append(args, stringArgs(closed)...)
+	}
+	rows, err := c.QueryContext(ctx, r.dialect.rebind(query+" ORDER BY Reminder, ID"), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.ToDo{}
+	for rows.Next() {
+		td, err := scanToDo(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDo-> %s", err.Error())
+	}
+	rows.Close()
+
+	if err := r.loadLabels(ctx, c, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+//ClaimReminder claims the current reminder of todo entity of any tenant until leaseUntil
+func (r *toDoRepository) ClaimReminder(ctx context.Context, id int64, reminder time.Time, now time.Time, leaseUntil time.Time) error {
+	//only one of concurrent callers claims the reminder which is not claimed or which lease expired
+	return r.updateReminder(ctx, id, reminder, true,
+		"UPDATE ToDo SET ReminderClaimedUntil=? WHERE ID=? AND ReminderSentAt IS NULL AND (ReminderClaimedUntil IS NULL OR ReminderClaimedUntil<=?)",
+		leaseUntil, id, now)
+}
+
+//ReleaseReminder drops the claim of the current reminder of todo entity of any tenant
+func (r *toDoRepository) ReleaseReminder(ctx context.Context, id int64, reminder time.Time) error {
+	//reminder which is not claimed any more is released too
+	return r.updateReminder(ctx, id, reminder, false,
+		"UPDATE ToDo SET ReminderClaimedUntil=NULL WHERE ID=? AND ReminderSentAt IS NULL", id)
+}
+
+//MarkReminderSent records delivery of the current reminder of todo entity of any tenant
+func (r *toDoRepository) MarkReminderSent(ctx context.Context, id int64, reminder time.Time, sentAt time.Time) error {
+	//only one of concurrent callers updates the row
+	return r.updateReminder(ctx, id, reminder, true,
+		"UPDATE ToDo SET ReminderSentAt=?, ReminderClaimedUntil=NULL WHERE ID=? AND ReminderSentAt IS NULL", sentAt, id)
+}
+
+//updateReminder runs the update of todo entity of any tenant which Reminder is the reminder and is not delivered,
+//ErrNotFound is returned when the update changes no row and changed is set
+func (r *toDoRepository) updateReminder(ctx context.Context, id int64, reminder time.Time, changed bool, query string, args ...interface{}) error {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	//reminder is compared here because databases store timestamps with different precision and format
+	var stored timeValue
+	err = tx.QueryRowContext(ctx, r.dialect.rebind("SELECT Reminder FROM ToDo WHERE ID=? AND ReminderSentAt IS NULL AND DeletedAt IS NULL"), id).Scan(&stored)
+	if err == sql.ErrNoRows || (err == nil && !stored.Equal(reminder)) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+
+	res, err := tx.ExecContext(ctx, r.dialect.rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	if changed {
+		if _, err := rowsAffected(res); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return nil
+}
+
+//CountToDos counts todo entities including those in the trash
+func (r *toDoRepository) CountToDos(ctx context.Context) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	var count int64
+	if err := c.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDo WHERE TenantID=?"), r.tenant).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	return count, nil
+}
+
+//sortColumns maps sort fields to ToDo table columns
+var sortColumns = map[storage.SortField]string{
+	storage.SortByID:                        "ID",
+	storage.SortByTitle:                     "Title",
+	storage.SortByStatus:                    "Status",
+	storage.SortByEstimatedTimeOfCompletion: "EstimatedTimeOfCompletion",
+	storage.SortByReminder:                  "Reminder",
+}
+
+//List selects todo entities matching the options
+func (r *toDoRepository) List(ctx context.Context, opts storage.ListOptions) ([]*storage.ToDo, error) {
+	query, args, err := listQuery(r.tenant, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	//get todo entity list
+	rows, err := c.QueryContext(ctx, r.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	defer rows.Close()
+
+	list := []*storage.ToDo{}
+	for rows.Next() {
+		td, err := scanToDo(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, td)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from ToDo-> %s", err.Error())
+	}
+	rows.Close()
+
+	if err := r.loadLabels(ctx, c, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+//listQuery builds SELECT statement and its arguments for the list options of the tenant
+func listQuery(tenant string, opts storage.ListOptions) (string, []interface{}, error) {
+	sortBy := opts.SortBy
+	if sortBy == "" {
+		sortBy = storage.SortByID
+	}
+	column, ok := sortColumns[sortBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported sort field '%s'", sortBy)
+	}
+
+	var where []string
+	var args []interface{}
+	if !opts.ShowDeleted {
+		where = append(where, "DeletedAt IS NULL")
+	}
+	if len(opts.IDs) > 0 {
+		where = append(where, "ID IN ("+placeholders(len(opts.IDs))+")")
+		args = append(args, int64Args(opts.IDs)...)
+	}
+	if len(opts.Status) > 0 {
+		where = append(where, "Status=?")
+		args = append(args, opts.Status)
+	}
+	if opts.ProjectID != 0 {
+		where = append(where, "ProjectID=?")
+		args = append(args, opts.ProjectID)
+	}
+	if opts.ParentID != 0 {
+		where = append(where, "ParentID=?")
+		args = append(args, opts.ParentID)
+	}
+	if len(opts.VisibleTo) > 0 {
+		where = append(where, "(Owner=? OR ID IN (SELECT ToDoID FROM ToDoShare WHERE UserName=?))")
+		args = append(args, opts.VisibleTo, opts.VisibleTo)
+	}
+	if opts.Ready {
+		//open todo entity without open prerequisites, prerequisites in the trash do not count
+		if len(opts.Closed) == 0 {
+			where = append(where, "NOT EXISTS (SELECT 1 FROM ToDoDependency D JOIN ToDo P ON P.ID=D.DependsOnID WHERE D.ToDoID=ToDo.ID AND P.DeletedAt IS NULL)")
+		} else {
+			closed := placeholders(len(opts.Closed))
+			where = append(where, "Status NOT IN ("+closed+")",
+				"NOT EXISTS (SELECT 1 FROM ToDoDependency D JOIN ToDo P ON P.ID=D.DependsOnID WHERE D.ToDoID=ToDo.ID AND P.DeletedAt IS NULL AND P.Status NOT IN ("+closed+"))")
+			for i := 0; i < 2; i++ {
+				for _, st := range opts.Closed {
+					args = append(args, st)
+				}
+			}
+		}
+	}
+	if len(opts.Labels) > 0 {
+		labels := distinct(opts.Labels)
+		filter := "ID IN (SELECT TL.ToDoID FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID WHERE L.Name IN (" + placeholders(len(labels)) + ")"
+		args = append(args, stringArgs(labels)...)
+		if !opts.AnyLabel {
+			//todo entity has every label when all of them are joined
+			filter += " GROUP BY TL.ToDoID HAVING COUNT(*)=?"
+			args = append(args, len(labels))
+		}
+		where = append(where, filter+")")
+	}
+	if !opts.ReminderFrom.IsZero() {
+		where = append(where, "Reminder>=?")
+		args = append(args, opts.ReminderFrom)
+	}
+	if !opts.ReminderTo.IsZero() {
+		where = append(where, "Reminder<?")
+		args = append(args, opts.ReminderTo)
+	}
+	if !opts.DueFrom.IsZero() {
+		where = append(where, "EstimatedTimeOfCompletion>=?")
+		args = append(args, opts.DueFrom)
+	}
+	if !opts.DueTo.IsZero() {
+		where = append(where, "EstimatedTimeOfCompletion<?")
+		args = append(args, opts.DueTo)
+	}
+
+	order, cmp := "ASC", ">"
+	if opts.Descending {
+		order, cmp = "DESC", "<"
+	}
+
+	if opts.After != nil {
+		//continue after the last entity of the previous page, ID breaks ties of the sort column
+		if sortBy == storage.SortByID {
+			where = append(where, "ID"+cmp+"?")
+			args = append(args, opts.After.ID)
+		} else if !nullableSortColumns[sortBy] {
+			key := sortKey(opts.After, sortBy)
+			where = append(where, fmt.Sprintf("(%s%s? OR (%s=? AND ID%s?))", column, cmp, column, cmp))
+			args = append(args, key, key, opts.After.ID)
+		} else {
+			cond, condArgs := afterNullable(column, opts.After, sortBy, opts.Descending)
+			where = append(where, cond)
+			args = append(args, condArgs...)
+		}
+	}
+	where = append(where, "TenantID=?")
+	args = append(args, tenant)
+
+	query := "SELECT " + toDoColumns + " FROM ToDo WHERE " + strings.Join(where, " AND ")
+	if sortBy == storage.SortByID {
+		query += " ORDER BY ID " + order
+	} else if !nullableSortColumns[sortBy] {
+		query += fmt.Sprintf(" ORDER BY %s %s, ID %s", column, order, order)
+	} else {
+		//NULL sorts before every time like zero time of the memory repository, the dialects disagree on it
+		nulls := "DESC"
+		if opts.Descending {
+			nulls = "ASC"
+		}
+		query += fmt.Sprintf(" ORDER BY %s IS NULL %s, %s %s, ID %s", column, nulls, column, order, order)
+	}
+	if opts.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, opts.Limit)
+	}
+	return query, args, nil
+}
+
+//nullableSortColumns are sort fields whose columns are NULL when the time is not set
+var nullableSortColumns = map[storage.SortField]bool{
+	storage.SortByEstimatedTimeOfCompletion: true,
+	storage.SortByReminder:                  true,
+}
+
+//afterNullable returns condition selecting todo entities after the last one in the order of nullable time column,
+//NULL is before every time and zero time of the last entity stands for NULL
+func afterNullable(column string, last *storage.ToDo, sortBy storage.SortField, descending bool) (string, []interface{}) {
+	key := sortKey(last, sortBy).(time.Time)
+	switch {
+	case key.IsZero() && !descending:
+		return fmt.Sprintf("((%s IS NULL AND ID>?) OR %s IS NOT NULL)", column, column), []interface{}{last.ID}
+	case key.IsZero():
+		return fmt.Sprintf("(%s IS NULL AND ID<?)", column), []interface{}{last.ID}
+	case !descending:
+		return fmt.Sprintf("(%s>? OR (%s=? AND ID>?))", column, column), []interface{}{key, key, last.ID}
+	}
+	return fmt.Sprintf("(%s<? OR (%s=? AND ID<?) OR %s IS NULL)", column, column, column), []interface{}{key, key, last.ID}
+}
+
+//sortKey returns value of the sort field of todo entity
+func sortKey(td *storage.ToDo, sortBy storage.SortField) interface{} {
+	switch sortBy {
+	case storage.SortByTitle:
+		return td.Title
+	case storage.SortByStatus:
+		return td.Status
+	case storage.SortByEstimatedTimeOfCompletion:
+		return td.EstimatedTimeOfCompletion
+	case storage.SortByReminder:
+		return td.Reminder
+	}
+	return td.ID
+}
+
+//scanToDo reads todo entity from the current row
+func scanToDo(rows *sql.Rows) (*storage.ToDo, error) {
+	td := new(storage.ToDo)
+	var estimatedTimeOfCompletion, actualTimeOfCompletion, reminder, createdAt, updatedAt, deletedAt timeValue
+	if err := rows.Scan(&td.ID, &td.Title, &td.Description, &td.Status, &estimatedTimeOfCompletion, &actualTimeOfCompletion, &reminder, &createdAt, &updatedAt, &td.Recurrence, &td.ProjectID, &td.ParentID, &deletedAt, &td.Version, &td.Owner, &td.Tenant); err != nil {
+		return nil, fmt.Errorf("failed to retrieve field values from ToDo row -> %s", err.Error())
+	}
+	td.EstimatedTimeOfCompletion = estimatedTimeOfCompletion.Time
+	td.ActualTimeOfCompletion = actualTimeOfCompletion.Time
+	td.Reminder = reminder.Time
+	td.CreatedAt = createdAt.Time
+	td.UpdatedAt = updatedAt.Time
+	td.DeletedAt = deletedAt.Time
+	return td, nil
+}
+
+//placeholders returns n comma separated bind variables for IN clause
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+//int64Args converts IDs to statement arguments
+func int64Args(ids []int64) []interface{} {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return args
+}
+
+//rowsAffected returns number of rows affected by the statement or storage.ErrNotFound if there are none
+func rowsAffected(res sql.Result) (int64, error) {
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve rows affected value -> %s", err.Error())
+	}
+	if rows == 0 {
+		return 0, storage.ErrNotFound
+	}
+	return rows, nil
+}
+
+//timeValue scans nullable timestamp column, NULL is read as zero time
+type timeValue struct {
+	time.Time
+}
+
+//Scan implements sql.Scanner interface
+func (t *timeValue) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		t.Time = time.Time{}
+	case time.Time:
+		t.Time = v
+	default:
+		return fmt.Errorf("unsupported timestamp value type %T", value)
+	}
+	return nil
+}
+
+//nullTime converts zero time to NULL column value
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
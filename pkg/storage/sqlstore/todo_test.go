@@ -0,0 +1,1012 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryCreate(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+
+	tests := []struct {
+		name    string
+		td      *storage.ToDo
+		mock    func()
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			td: &storage.ToDo{
+				Title:                     "title",
+				Description:               "description",
+				Status:                    "status",
+				EstimatedTimeOfCompletion: tm,
+				ActualTimeOfCompletion:    tm,
+				Reminder:                  tm,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO ToDo\(`).WithArgs("title", "description", "status", tm, tm, tm, nil, nil, "", 0, 0, "", "").WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO ToDoCompletion").WithArgs(1, tm).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "INSERT failed",
+			td: &storage.ToDo{
+				Title:                     "title",
+				Description:               "description",
+				Status:                    "status",
+				EstimatedTimeOfCompletion: tm,
+				ActualTimeOfCompletion:    tm,
+				Reminder:                  tm,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO ToDo\(`).WithArgs("title", "description", "status", tm, tm, tm, nil, nil, "", 0, 0, "", "").WillReturnError(errors.New("INSERT failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "LastInsertId failed",
+			td: &storage.ToDo{
+				Title:                     "title",
+				Description:               "description",
+				Status:                    "status",
+				EstimatedTimeOfCompletion: tm,
+				ActualTimeOfCompletion:    tm,
+				Reminder:                  tm,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO ToDo\(`).WithArgs("title", "description", "status", tm, tm, tm, nil, nil, "", 0, 0, "", "").WillReturnResult(sqlMock.NewErrorResult(errors.New("LastInsertId failed")))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Not completed",
+			td: &storage.ToDo{
+				Title:                     "title",
+				EstimatedTimeOfCompletion: tm,
+				Reminder:                  tm,
+				CreatedAt:                 tm,
+				UpdatedAt:                 tm,
+				ProjectID:                 3,
+				ParentID:                  5,
+				Owner:                     "alice",
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(5, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}).AddRow(0))
+				mock.ExpectExec(`INSERT INTO ToDo\(`).WithArgs("title", "", "", tm, nil, tm, tm, tm, "", 3, 5, "alice", "").WillReturnResult(sqlMock.NewResult(2, 1))
+				mock.ExpectCommit()
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Create(ctx, tt.td)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toDoRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.Create() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryCreatePostgres(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, Postgres)
+	tm := time.Now().In(time.UTC)
+	td := &storage.ToDo{
+		Title:                     "title",
+		Description:               "description",
+		Status:                    "status",
+		EstimatedTimeOfCompletion: tm,
+		ActualTimeOfCompletion:    tm,
+		Reminder:                  tm,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO ToDo\(.+\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8,\$9,\$10,\$11,\$12,\$13\) RETURNING ID`).WithArgs("title", "description", "status", tm, tm, tm, nil, nil, "", 0, 0, "", "").WillReturnRows(sqlMock.NewRows([]string{"ID"}).AddRow(7))
+	mock.ExpectExec(`INSERT INTO ToDoCompletion\(ToDoID,CompletedAt\) VALUES \(\$1,\$2\)`).WithArgs(7, tm).WillReturnResult(sqlMock.NewResult(1, 1))
+	mock.ExpectCommit()
+	got, err := r.Create(ctx, td)
+	if err != nil {
+		t.Fatalf("toDoRepository.Create() error = %v", err)
+	}
+	if got != 7 {
+		t.Errorf("toDoRepository.Create() = %v, want %v", got, 7)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO ToDo").WillReturnError(errors.New("INSERT failed"))
+	mock.ExpectRollback()
+	if _, err := r.Create(ctx, td); err == nil {
+		t.Errorf("toDoRepository.Create() error = nil, want INSERT failed")
+	}
+}
+
+func TestToDoRepositoryGet(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	columns := []string{"ID", "Title", "Description", "Status", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder", "CreatedAt", "UpdatedAt", "Recurrence", "ProjectID", "ParentID", "DeletedAt", "Version", "Owner", "TenantID"}
+
+	tests := []struct {
+		name    string
+		id      int64
+		mock    func()
+		want    *storage.ToDo
+		wantErr error
+	}{
+		{
+			name: "OK",
+			id:   1,
+			mock: func() {
+				rows := sqlMock.NewRows(columns).AddRow(1, "title", "description", "status", tm, nil, tm, tm, tm, "FREQ=DAILY", 2, 1, nil, 2, "alice", "")
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1, "").WillReturnRows(rows)
+				labels := sqlMock.NewRows([]string{"ToDoID", "Name"}).AddRow(1, "work").AddRow(1, "home")
+				mock.ExpectQuery(`SELECT TL.ToDoID, L.Name FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID WHERE TL.ToDoID IN \(\?\)`).WithArgs(1).WillReturnRows(labels)
+			},
+			want: &storage.ToDo{
+				ID:                        1,
+				Title:                     "title",
+				Description:               "description",
+				Status:                    "status",
+				EstimatedTimeOfCompletion: tm,
+				Reminder:                  tm,
+				CreatedAt:                 tm,
+				UpdatedAt:                 tm,
+				Recurrence:                "FREQ=DAILY",
+				ProjectID:                 2,
+				ParentID:                  1,
+				Owner:                     "alice",
+				Labels:                    []string{"home", "work"},
+				Version:                   2,
+			},
+		},
+		{
+			name: "SELECT failed",
+			id:   1,
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1, "").WillReturnError(errors.New("SELECT failed"))
+			},
+			wantErr: errors.New("SELECT failed"),
+		},
+		{
+			name: "Not found",
+			id:   1,
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows(columns))
+			},
+			wantErr: storage.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Get(ctx, tt.id)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == storage.ErrNotFound && err != storage.ErrNotFound {
+				t.Errorf("toDoRepository.Get() error = %v, want %v", err, storage.ErrNotFound)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toDoRepository.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	td := &storage.ToDo{
+		ID:                        1,
+		Title:                     "new title",
+		Description:               "new description",
+		Status:                    "Completed",
+		EstimatedTimeOfCompletion: tm,
+		ActualTimeOfCompletion:    tm,
+		Reminder:                  tm,
+		UpdatedAt:                 tm,
+	}
+	reopened := *td
+	reopened.Status = "Started"
+	reopened.ActualTimeOfCompletion = time.Time{}
+	stale := *td
+	stale.Version = 1
+	read := *td
+	read.Version = 2
+
+	//stored returns row with actual time of completion, reminder and version stored before the update
+	stored := func(completed, reminder interface{}) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"ActualTimeOfCompletion", "Reminder", "Version"}).AddRow(completed, reminder, 2)
+	}
+
+	tests := []struct {
+		name    string
+		td      *storage.ToDo
+		mock    func()
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "OK",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO ToDoCompletion").WithArgs(1, tm).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Already completed",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(tm, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Reopened",
+			td:   &reopened,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(tm, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Started", tm, nil, tm, tm, "", 0, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE ToDoCompletion SET ReopenedAt").WithArgs(tm, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Reminder changed",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(tm, tm.Add(-time.Hour)))
+				mock.ExpectExec(`UPDATE ToDo SET .+, ReminderSentAt=NULL, ReminderClaimedUntil=NULL WHERE ID=\?`).WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "UPDATE failed",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1).WillReturnError(errors.New("UPDATE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("UPDATE failed"),
+		},
+		{
+			name: "RowsAffected failed",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1).WillReturnResult(sqlMock.NewErrorResult(errors.New("RowsAffected failed")))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("RowsAffected failed"),
+		},
+		{
+			name: "Not Found",
+			td:   td,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ActualTimeOfCompletion", "Reminder", "Version"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Version",
+			td:   &read,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectExec(`UPDATE ToDo SET .+ WHERE ID=\? AND DeletedAt IS NULL AND Version=\?`).WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1, 2).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO ToDoCompletion").WithArgs(1, tm).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Version mismatch",
+			td:   &stale,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrVersionMismatch,
+		},
+		{
+			name: "Changed concurrently",
+			td:   &read,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ActualTimeOfCompletion,Reminder,Version FROM ToDo").WithArgs(1, "").WillReturnRows(stored(nil, tm))
+				mock.ExpectExec("UPDATE ToDo SET").WithArgs("new title", "new description", "Completed", tm, tm, tm, tm, "", 0, 1, 2).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrVersionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			//Update sets the new version of todo entity
+			td := *tt.td
+			got, err := r.Update(ctx, &td)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (tt.wantErr == storage.ErrNotFound || tt.wantErr == storage.ErrVersionMismatch) && err != tt.wantErr {
+				t.Errorf("toDoRepository.Update() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && td.Version != 3 {
+				t.Errorf("toDoRepository.Update() version = %d, want 3", td.Version)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.Update() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryDelete(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	ids := func(ids ...int64) *sqlMock.Rows {
+		rows := sqlMock.NewRows([]string{"ID"})
+		for _, id := range ids {
+			rows.AddRow(id)
+		}
+		return rows
+	}
+	children := func(n int) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"COUNT(*)"}).AddRow(n)
+	}
+	parent := func() *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"ParentID"}).AddRow(0)
+	}
+
+	tests := []struct {
+		name    string
+		mode    storage.DeleteMode
+		mock    func()
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\? AND DeletedAt IS NULL AND TenantID=\?`).WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ToDo WHERE ParentID=\?`).WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec(`DELETE FROM ToDoCompletion WHERE ToDoID IN \(\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 2))
+				mock.ExpectExec(`DELETE FROM ToDoLabel WHERE ToDoID IN \(\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM ToDoShare WHERE ToDoID IN \(\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM ToDoDependency WHERE ToDoID IN \(\?\) OR DependsOnID IN \(\?\)`).WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM ToDo WHERE ID IN \(\?\)`).WithArgs(1).WillReturnResult(sqlMock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Has children",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(2))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrHasChildren,
+		},
+		{
+			name: "Cascade",
+			mode: storage.DeleteCascade,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?\)`).WithArgs(1).WillReturnRows(ids(2, 3))
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?,\?\)`).WithArgs(2, 3).WillReturnRows(ids(4))
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?\)`).WithArgs(4).WillReturnRows(ids())
+				mock.ExpectExec(`DELETE FROM ToDoCompletion WHERE ToDoID IN \(\?,\?,\?,\?\)`).WithArgs(1, 2, 3, 4).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoLabel").WithArgs(1, 2, 3, 4).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoShare").WithArgs(1, 2, 3, 4).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 2, 3, 4, 1, 2, 3, 4).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM ToDo WHERE ID IN \(\?,\?,\?,\?\)`).WithArgs(1, 2, 3, 4).WillReturnResult(sqlMock.NewResult(0, 4))
+				mock.ExpectCommit()
+			},
+			want: 4,
+		},
+		{
+			name: "Reparent",
+			mode: storage.DeleteReparent,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}).AddRow(7))
+				mock.ExpectExec(`UPDATE ToDo SET ParentID=\?, Version=Version\+1 WHERE ParentID=\?`).WithArgs(7, 1).WillReturnResult(sqlMock.NewResult(0, 2))
+				mock.ExpectExec("DELETE FROM ToDoCompletion").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoLabel").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoShare").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDo WHERE").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Reparent not found",
+			mode: storage.DeleteReparent,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "DELETE failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec("DELETE FROM ToDoCompletion").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoLabel").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoShare").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDo WHERE").WithArgs(1).WillReturnError(errors.New("DELETE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("DELETE failed"),
+		},
+		{
+			name: "RowsAffected failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec("DELETE FROM ToDoCompletion").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoLabel").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoShare").WithArgs(1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDoDependency").WithArgs(1, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM ToDo WHERE").WithArgs(1).WillReturnResult(sqlMock.NewErrorResult(errors.New("RowsAffected failed")))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("RowsAffected failed"),
+		},
+		{
+			name: "Not Found",
+			mode: storage.DeleteCascade,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Delete(ctx, 1, tt.mode)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.Delete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (tt.wantErr == storage.ErrNotFound || tt.wantErr == storage.ErrHasChildren) && err != tt.wantErr {
+				t.Errorf("toDoRepository.Delete() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.Delete() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryList(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	t1 := time.Now().In(time.UTC)
+	tm1 := time.Date(2019, 9, 27, 8, 30, 45, 56478, time.UTC)
+	t2 := time.Now().In(time.UTC)
+	tm2 := time.Date(2019, 10, 26, 8, 30, 50, 23474, time.UTC)
+	columns := []string{"ID", "Title", "Description", "Status", "EstimatedTimeOfCompletion", "ActualTimeOfCompletion", "Reminder", "CreatedAt", "UpdatedAt", "Recurrence", "ProjectID", "ParentID", "DeletedAt", "Version", "Owner", "TenantID"}
+
+	tests := []struct {
+		name    string
+		opts    storage.ListOptions
+		mock    func()
+		want    []*storage.ToDo
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				rows := sqlMock.NewRows(columns).AddRow(1, "title 1", "description 1", "Completed", t1, tm1, t1, nil, nil, "", 0, 0, nil, 2, "alice", "").AddRow(2, "title 2", "description 2", "InProgress", t2, tm2, t2, nil, nil, "", 0, 0, nil, 1, "bob", "")
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WillReturnRows(rows)
+				labels := sqlMock.NewRows([]string{"ToDoID", "Name"}).AddRow(1, "work").AddRow(2, "home").AddRow(1, "home")
+				mock.ExpectQuery("SELECT (.+) FROM ToDoLabel").WithArgs(1, 2).WillReturnRows(labels)
+			},
+			want: []*storage.ToDo{
+				{
+					ID:                        1,
+					Title:                     "title 1",
+					Description:               "description 1",
+					Status:                    "Completed",
+					EstimatedTimeOfCompletion: t1,
+					ActualTimeOfCompletion:    tm1,
+					Reminder:                  t1,
+					Labels:                    []string{"home", "work"},
+					Version:                   2,
+					Owner:                     "alice",
+				},
+				{
+					ID:                        2,
+					Title:                     "title 2",
+					Description:               "description 2",
+					Status:                    "InProgress",
+					EstimatedTimeOfCompletion: t2,
+					ActualTimeOfCompletion:    tm2,
+					Reminder:                  t2,
+					Labels:                    []string{"home"},
+					Version:                   1,
+					Owner:                     "bob",
+				},
+			},
+		},
+		{
+			name: "Empty",
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WillReturnRows(sqlMock.NewRows(columns))
+			},
+			want: []*storage.ToDo{},
+		},
+		{
+			name: "SELECT failed",
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM ToDo").WillReturnError(errors.New("SELECT failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Filtered page",
+			opts: storage.ListOptions{
+				Status:     "Completed",
+				ProjectID:  4,
+				VisibleTo:  "alice",
+				Labels:     []string{"work", "home", "work"},
+				DueFrom:    tm1,
+				SortBy:     storage.SortByTitle,
+				Descending: true,
+				After:      &storage.ToDo{ID: 3, Title: "title 3"},
+				Limit:      2,
+			},
+			mock: func() {
+				rows := sqlMock.NewRows(columns).AddRow(1, "title 1", "description 1", "Completed", t1, tm1, t1, nil, nil, "", 4, 0, nil, 2, "alice", "")
+				mock.ExpectQuery(`SELECT (.+) FROM ToDo WHERE DeletedAt IS NULL AND Status=\? AND ProjectID=\? AND \(Owner=\? OR ID IN \(SELECT ToDoID FROM ToDoShare WHERE UserName=\?\)\) AND ID IN \(SELECT TL.ToDoID FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID WHERE L.Name IN \(\?,\?\) GROUP BY TL.ToDoID HAVING COUNT\(\*\)=\?\) AND EstimatedTimeOfCompletion>=\? AND \(Title<\? OR \(Title=\? AND ID<\?\)\) AND TenantID=\? ORDER BY Title DESC, ID DESC LIMIT \?`).
+					WithArgs("Completed", 4, "alice", "alice", "work", "home", 2, tm1, "title 3", "title 3", 3, "", 2).WillReturnRows(rows)
+				mock.ExpectQuery("SELECT (.+) FROM ToDoLabel").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"ToDoID", "Name"}))
+			},
+			want: []*storage.ToDo{
+				{
+					ID:                        1,
+					Title:                     "title 1",
+					Description:               "description 1",
+					Status:                    "Completed",
+					EstimatedTimeOfCompletion: t1,
+					ActualTimeOfCompletion:    tm1,
+					Reminder:                  t1,
+					ProjectID:                 4,
+					Version:                   2,
+					Owner:                     "alice",
+				},
+			},
+		},
+		{
+			name: "Ready with any label",
+			opts: storage.ListOptions{
+				Ready:    true,
+				Closed:   []string{"DONE", "CANCELLED"},
+				Labels:   []string{"work", "home"},
+				AnyLabel: true,
+			},
+			mock: func() {
+				mock.ExpectQuery(`SELECT (.+) FROM ToDo WHERE DeletedAt IS NULL AND Status NOT IN \(\?,\?\) AND NOT EXISTS \(SELECT 1 FROM ToDoDependency D JOIN ToDo P ON P.ID=D.DependsOnID WHERE D.ToDoID=ToDo.ID AND P.DeletedAt IS NULL AND P.Status NOT IN \(\?,\?\)\) AND ID IN \(SELECT TL.ToDoID FROM ToDoLabel TL JOIN Label L ON L.ID=TL.LabelID WHERE L.Name IN \(\?,\?\)\) AND TenantID=\? ORDER BY ID ASC`).
+					WithArgs("DONE", "CANCELLED", "DONE", "CANCELLED", "work", "home", "").WillReturnRows(sqlMock.NewRows(columns))
+			},
+			want: []*storage.ToDo{},
+		},
+		{
+			name: "Visible with IDs",
+			opts: storage.ListOptions{IDs: []int64{5, 2}, VisibleTo: "alice"},
+			mock: func() {
+				mock.ExpectQuery(`SELECT (.+) FROM ToDo WHERE DeletedAt IS NULL AND ID IN \(\?,\?\) AND \(Owner=\? OR ID IN \(SELECT ToDoID FROM ToDoShare WHERE UserName=\?\)\) AND TenantID=\? ORDER BY ID ASC`).
+					WithArgs(5, 2, "alice", "alice", "").WillReturnRows(sqlMock.NewRows(columns))
+			},
+			want: []*storage.ToDo{},
+		},
+		{
+			name: "Page after task without reminder",
+			opts: storage.ListOptions{
+				SortBy: storage.SortByReminder,
+				After:  &storage.ToDo{ID: 3},
+				Limit:  2,
+			},
+			mock: func() {
+				mock.ExpectQuery(`SELECT (.+) FROM ToDo WHERE DeletedAt IS NULL AND \(\(Reminder IS NULL AND ID>\?\) OR Reminder IS NOT NULL\) AND TenantID=\? ORDER BY Reminder IS NULL DESC, Reminder ASC, ID ASC LIMIT \?`).
+					WithArgs(3, "", 2).WillReturnRows(sqlMock.NewRows(columns))
+			},
+			want: []*storage.ToDo{},
+		},
+		{
+			name: "Descending page after task with reminder",
+			opts: storage.ListOptions{
+				SortBy:     storage.SortByReminder,
+				Descending: true,
+				After:      &storage.ToDo{ID: 3, Reminder: t1},
+			},
+			mock: func() {
+				mock.ExpectQuery(`SELECT (.+) FROM ToDo WHERE DeletedAt IS NULL AND \(Reminder<\? OR \(Reminder=\? AND ID<\?\) OR Reminder IS NULL\) AND TenantID=\? ORDER BY Reminder IS NULL ASC, Reminder DESC, ID DESC`).
+					WithArgs(t1, t1, 3, "").WillReturnRows(sqlMock.NewRows(columns))
+			},
+			want: []*storage.ToDo{},
+		},
+		{
+			name:    "Unsupported sort field",
+			opts:    storage.ListOptions{SortBy: "description"},
+			mock:    func() {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.List(ctx, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toDoRepository.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toDoRepository.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryMarkReminderSent(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	sentAt := tm.Add(time.Second)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT Reminder FROM ToDo WHERE ID=\? AND ReminderSentAt IS NULL`).WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec(`UPDATE ToDo SET ReminderSentAt=\?, ReminderClaimedUntil=NULL WHERE ID=\? AND ReminderSentAt IS NULL`).WithArgs(sentAt, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Already sent",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Reminder changed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm.Add(time.Hour)))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Sent concurrently",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec("UPDATE ToDo SET ReminderSentAt").WithArgs(sentAt, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "UPDATE failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec("UPDATE ToDo SET ReminderSentAt").WithArgs(sentAt, 1).WillReturnError(errors.New("UPDATE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("UPDATE failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			err := r.MarkReminderSent(ctx, 1, tm, sentAt)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.MarkReminderSent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == storage.ErrNotFound && err != storage.ErrNotFound {
+				t.Errorf("toDoRepository.MarkReminderSent() error = %v, want %v", err, storage.ErrNotFound)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryClaimReminder(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	leaseUntil := tm.Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT Reminder FROM ToDo WHERE ID=\? AND ReminderSentAt IS NULL`).WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec(`UPDATE ToDo SET ReminderClaimedUntil=\? WHERE ID=\? AND ReminderSentAt IS NULL AND \(ReminderClaimedUntil IS NULL OR ReminderClaimedUntil<=\?\)`).WithArgs(leaseUntil, 1, tm).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Already sent",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Reminder changed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm.Add(time.Hour)))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Claimed by other",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec("UPDATE ToDo SET ReminderClaimedUntil").WithArgs(leaseUntil, 1, tm).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "UPDATE failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Reminder FROM ToDo").WithArgs(1).WillReturnRows(sqlMock.NewRows([]string{"Reminder"}).AddRow(tm))
+				mock.ExpectExec("UPDATE ToDo SET ReminderClaimedUntil").WithArgs(leaseUntil, 1, tm).WillReturnError(errors.New("UPDATE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("UPDATE failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			err := r.ClaimReminder(ctx, 1, tm, tm, leaseUntil)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.ClaimReminder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == storage.ErrNotFound && err != storage.ErrNotFound {
+				t.Errorf("toDoRepository.ClaimReminder() error = %v, want %v", err, storage.ErrNotFound)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryMove(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	parent := func(id int64) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"ParentID"}).AddRow(id)
+	}
+
+	tests := []struct {
+		name     string
+		parentID int64
+		mock     func()
+		wantErr  error
+	}{
+		{
+			name:     "OK",
+			parentID: 3,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(1, "").WillReturnRows(parent(0))
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(3, "").WillReturnRows(parent(2))
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\?`).WithArgs(2, "").WillReturnRows(parent(0))
+				mock.ExpectExec(`UPDATE ToDo SET ParentID=\?, UpdatedAt=\?, Version=Version\+1 WHERE ID=\?`).WithArgs(3, tm, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Top level",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent(2))
+				mock.ExpectExec("UPDATE ToDo SET ParentID").WithArgs(0, tm, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "Cycle",
+			parentID: 3,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent(0))
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(3, "").WillReturnRows(parent(1))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrCycle,
+		},
+		{
+			name:     "Parent not found",
+			parentID: 3,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent(0))
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(3, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrParentNotFound,
+		},
+		{
+			name:     "Not Found",
+			parentID: 3,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Move(ctx, 1, tt.parentID, tm)
+			if err != tt.wantErr {
+				t.Errorf("toDoRepository.Move() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != 1 {
+				t.Errorf("toDoRepository.Move() = %v, want 1", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Trash sets DeletedAt of todo entity and handles its subtasks according to the mode
+func (r *toDoRepository) Trash(ctx context.Context, id int64, version int64, mode storage.DeleteMode, deletedAt time.Time) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	rows, err := r.trash(ctx, tx, id, version, mode, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
+
+//trash sets DeletedAt of todo entity and handles its subtasks according to the mode in the transaction
+func (r *toDoRepository) trash(ctx context.Context, tx *sql.Tx, id int64, version int64, mode storage.DeleteMode, deletedAt time.Time) (int64, error) {
+	var stored int64
+	err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT Version FROM ToDo WHERE ID=? AND DeletedAt IS NULL AND TenantID=?"), id, r.tenant).Scan(&stored)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	if version != 0 && stored != version {
+		return 0, storage.ErrVersionMismatch
+	}
+
+	ids := []int64{id}
+	switch mode {
+	case storage.DeleteCascade:
+		descendants, err := r.descendantIDs(ctx, tx, id, "DeletedAt IS NULL")
+		if err != nil {
+			return 0, err
+		}
+		ids = append(ids, descendants...)
+	case storage.DeleteReparent:
+		parentID, err := r.parentOf(ctx, tx, id)
+		if err != nil {
+			return 0, err
+		}
+		//subtasks take place of the trashed todo entity
+		if _, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET ParentID=?, Version=Version+1 WHERE ParentID=? AND DeletedAt IS NULL"), parentID, id); err != nil {
+			return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+		}
+	default:
+		var children int64
+		if err := tx.QueryRowContext(ctx, r.dialect.rebind("SELECT COUNT(*) FROM ToDo WHERE ParentID=? AND DeletedAt IS NULL"), id).Scan(&children); err != nil {
+			return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+		}
+		if children > 0 {
+			return 0, storage.ErrHasChildren
+		}
+	}
+
+	args := append([]interface{}{deletedAt}, int64Args(ids)...)
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET DeletedAt=?, Version=Version+1 WHERE ID IN ("+placeholders(len(ids))+") AND DeletedAt IS NULL"), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	return rowsAffected(res)
+}
+
+//Restore clears DeletedAt of todo entity and its subtasks trashed together with it
+func (r *toDoRepository) Restore(ctx context.Context, id int64, updatedAt time.Time) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	var parentID int64
+	err = tx.QueryRowContext(ctx, r.dialect.rebind("SELECT ParentID FROM ToDo WHERE ID=? AND DeletedAt IS NOT NULL AND TenantID=?"), id, r.tenant).Scan(&parentID)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to select from ToDo -> %s", err.Error())
+	}
+	if parentID != 0 {
+		if _, err := r.parentOf(ctx, tx, parentID); err == storage.ErrNotFound {
+			return 0, storage.ErrParentNotFound
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	//subtasks trashed on their own before stay in the trash,
+	//DeletedAt is compared in the database because databases store timestamps with different precision
+	descendants, err := r.descendantIDs(ctx, tx, id, "DeletedAt=(SELECT DeletedAt FROM ToDo WHERE ID=?)", id)
+	if err != nil {
+		return 0, err
+	}
+	ids := append([]int64{id}, descendants...)
+
+	args := append([]interface{}{nullTime(updatedAt)}, int64Args(ids)...)
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("UPDATE ToDo SET DeletedAt=NULL, UpdatedAt=?, Version=Version+1 WHERE ID IN ("+placeholders(len(ids))+")"), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update ToDo -> %s", err.Error())
+	}
+	rows, err := rowsAffected(res)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
+
+//Purge deletes todo entities moved to the trash before the time with their completions, dependencies, labels and shares
+func (r *toDoRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	//get SQL connection from the connection pool
+	c, err := r.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction -> %s", err.Error())
+	}
+	defer tx.Rollback()
+
+	const purged = "SELECT ID FROM ToDo WHERE DeletedAt<?"
+
+	//delete completion history of purged todo entities
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoCompletion WHERE ToDoID IN ("+purged+")"), before); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoCompletion -> %s", err.Error())
+	}
+
+	//delete labels of purged todo entities
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoLabel WHERE ToDoID IN ("+purged+")"), before); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoLabel -> %s", err.Error())
+	}
+
+	//delete shares of purged todo entities
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoShare WHERE ToDoID IN ("+purged+")"), before); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoShare -> %s", err.Error())
+	}
+
+	//delete dependencies of purged todo entities and on them
+	if _, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDoDependency WHERE ToDoID IN ("+purged+") OR DependsOnID IN ("+purged+")"), before, before); err != nil {
+		return 0, fmt.Errorf("failed to delete ToDoDependency -> %s", err.Error())
+	}
+
+	res, err := tx.ExecContext(ctx, r.dialect.rebind("DELETE FROM ToDo WHERE DeletedAt<?"), before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ToDo -> %s", err.Error())
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve rows affected value -> %s", err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction -> %s", err.Error())
+	}
+	return rows, nil
+}
@@ -0,0 +1,241 @@
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestToDoRepositoryTrash(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	ids := func(ids ...int64) *sqlMock.Rows {
+		rows := sqlMock.NewRows([]string{"ID"})
+		for _, id := range ids {
+			rows.AddRow(id)
+		}
+		return rows
+	}
+	children := func(n int) *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"COUNT(*)"}).AddRow(n)
+	}
+	version := func() *sqlMock.Rows {
+		return sqlMock.NewRows([]string{"Version"}).AddRow(2)
+	}
+
+	tests := []struct {
+		name    string
+		mode    storage.DeleteMode
+		version int64
+		mock    func()
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT Version FROM ToDo WHERE ID=\? AND DeletedAt IS NULL AND TenantID=\?`).WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ToDo WHERE ParentID=\? AND DeletedAt IS NULL`).WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec(`UPDATE ToDo SET DeletedAt=\?, Version=Version\+1 WHERE ID IN \(\?\) AND DeletedAt IS NULL`).WithArgs(tm, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Has children",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(1))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrHasChildren,
+		},
+		{
+			name: "Cascade",
+			mode: storage.DeleteCascade,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?\) AND DeletedAt IS NULL`).WithArgs(1).WillReturnRows(ids(2, 3))
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?,\?\) AND DeletedAt IS NULL`).WithArgs(2, 3).WillReturnRows(ids())
+				mock.ExpectExec(`UPDATE ToDo SET DeletedAt=\?, Version=Version\+1 WHERE ID IN \(\?,\?,\?\) AND DeletedAt IS NULL`).WithArgs(tm, 1, 2, 3).WillReturnResult(sqlMock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			want: 3,
+		},
+		{
+			name: "Reparent",
+			mode: storage.DeleteReparent,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\? AND DeletedAt IS NULL`).WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"ParentID"}).AddRow(7))
+				mock.ExpectExec(`UPDATE ToDo SET ParentID=\?, Version=Version\+1 WHERE ParentID=\? AND DeletedAt IS NULL`).WithArgs(7, 1).WillReturnResult(sqlMock.NewResult(0, 2))
+				mock.ExpectExec("UPDATE ToDo SET DeletedAt").WithArgs(tm, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name: "Not found",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec("UPDATE ToDo SET DeletedAt").WithArgs(tm, 1).WillReturnResult(sqlMock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Missing",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"Version"}))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "UPDATE failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(version())
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec("UPDATE ToDo SET DeletedAt").WithArgs(tm, 1).WillReturnError(errors.New("UPDATE failed"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("UPDATE failed"),
+		},
+		{
+			name:    "Version",
+			version: 2,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT Version FROM ToDo WHERE ID=\? AND DeletedAt IS NULL`).WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"Version"}).AddRow(2))
+				mock.ExpectQuery("SELECT COUNT").WithArgs(1).WillReturnRows(children(0))
+				mock.ExpectExec("UPDATE ToDo SET DeletedAt").WithArgs(tm, 1).WillReturnResult(sqlMock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want: 1,
+		},
+		{
+			name:    "Version mismatch",
+			version: 1,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT Version FROM ToDo").WithArgs(1, "").WillReturnRows(sqlMock.NewRows([]string{"Version"}).AddRow(2))
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrVersionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Trash(ctx, 1, tt.version, tt.mode, tm)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("toDoRepository.Trash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (tt.wantErr == storage.ErrNotFound || tt.wantErr == storage.ErrHasChildren || tt.wantErr == storage.ErrVersionMismatch) && err != tt.wantErr {
+				t.Errorf("toDoRepository.Trash() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.Trash() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestToDoRepositoryRestore(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlMock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	r := NewToDoRepository(db, MySQL)
+	tm := time.Now().In(time.UTC)
+	parent := func(ids ...int64) *sqlMock.Rows {
+		rows := sqlMock.NewRows([]string{"ParentID"})
+		for _, id := range ids {
+			rows.AddRow(id)
+		}
+		return rows
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\? AND DeletedAt IS NOT NULL`).WithArgs(1, "").WillReturnRows(parent(7))
+				mock.ExpectQuery(`SELECT ParentID FROM ToDo WHERE ID=\? AND DeletedAt IS NULL`).WithArgs(7, "").WillReturnRows(parent(0))
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?\) AND DeletedAt=\(SELECT DeletedAt FROM ToDo WHERE ID=\?\)`).WithArgs(1, 1).
+					WillReturnRows(sqlMock.NewRows([]string{"ID"}).AddRow(2))
+				mock.ExpectQuery(`SELECT ID FROM ToDo WHERE ParentID IN \(\?\) AND DeletedAt=`).WithArgs(2, 1).WillReturnRows(sqlMock.NewRows([]string{"ID"}))
+				mock.ExpectExec(`UPDATE ToDo SET DeletedAt=NULL, UpdatedAt=\?, Version=Version\+1 WHERE ID IN \(\?,\?\)`).WithArgs(tm, 1, 2).WillReturnResult(sqlMock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			want: 2,
+		},
+		{
+			name: "Not in trash",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent())
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrNotFound,
+		},
+		{
+			name: "Parent in trash",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(1, "").WillReturnRows(parent(7))
+				mock.ExpectQuery("SELECT ParentID FROM ToDo").WithArgs(7, "").WillReturnRows(parent())
+				mock.ExpectRollback()
+			},
+			wantErr: storage.ErrParentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := r.Restore(ctx, 1, tm)
+			if err != tt.wantErr {
+				t.Errorf("toDoRepository.Restore() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("toDoRepository.Restore() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
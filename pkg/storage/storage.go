@@ -0,0 +1,596 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+//ErrNotFound is returned by a repository when the requested ToDo does not exist
+var ErrNotFound = errors.New("ToDo is not found")
+
+//ErrAlreadyExists is returned by a repository when a ToDo with the same title is already stored in the project
+var ErrAlreadyExists = errors.New("ToDo with the same title already exists")
+
+//ErrParentNotFound is returned by a repository when the parent of a ToDo does not exist
+var ErrParentNotFound = errors.New("parent ToDo is not found")
+
+//ErrHasChildren is returned by a repository when a ToDo to delete still has subtasks
+var ErrHasChildren = errors.New("ToDo has subtasks")
+
+//ErrCycle is returned by a repository when a ToDo would become a subtask of itself
+var ErrCycle = errors.New("ToDo can't be a subtask of itself or of its subtasks")
+
+//ErrPrerequisiteNotFound is returned by a repository when the ToDo to depend on does not exist
+var ErrPrerequisiteNotFound = errors.New("prerequisite ToDo is not found")
+
+//ErrDependencyExists is returned by a repository when a ToDo already depends on the prerequisite
+var ErrDependencyExists = errors.New("ToDo already depends on the prerequisite")
+
+//ErrDependencyNotFound is returned by a repository when a ToDo does not depend on the prerequisite
+var ErrDependencyNotFound = errors.New("ToDo does not depend on the prerequisite")
+
+//ErrDependencyCycle is returned by a repository when a ToDo would depend on itself directly or through other ToDos
+var ErrDependencyCycle = errors.New("ToDo can't depend on itself or on ToDos depending on it")
+
+//ErrVersionMismatch is returned by a repository when a ToDo was changed since the expected version was read
+var ErrVersionMismatch = errors.New("ToDo was changed since the version was read")
+
+//ErrShareNotFound is returned by a repository when a ToDo is not shared with the user
+var ErrShareNotFound = errors.New("ToDo is not shared with the user")
+
+//ErrTenantNotFound is returned by a repository when the requested Tenant does not exist
+var ErrTenantNotFound = errors.New("Tenant is not found")
+
+//ErrTenantAlreadyExists is returned by a repository when a Tenant with the same ID is already stored
+var ErrTenantAlreadyExists = errors.New("Tenant with the same ID already exists")
+
+//ErrProjectNotFound is returned by a repository when the requested Project does not exist
+var ErrProjectNotFound = errors.New("Project is not found")
+
+//ErrProjectAlreadyExists is returned by a repository when a Project with the same name is already stored
+var ErrProjectAlreadyExists = errors.New("Project with the same name already exists")
+
+//ErrProjectNotEmpty is returned by a repository when a Project to delete still has ToDos
+var ErrProjectNotEmpty = errors.New("Project has tasks")
+
+//ToDo is the persisted representation of a todo task
+type ToDo struct {
+	//ID is the unique identifier assigned by the storage backend
+	ID int64
+
+	//Title of the task
+	Title string
+
+	//Description of the task
+	Description string
+
+	//Status of the task
+	Status string
+
+	//EstimatedTimeOfCompletion is the planned completion date and time
+	EstimatedTimeOfCompletion time.Time
+
+	//ActualTimeOfCompletion is the date and time the task was completed, zero while it is not completed
+	ActualTimeOfCompletion time.Time
+
+	//Reminder is the date and time to remind the task
+	Reminder time.Time
+
+	//CreatedAt is the date and time the task was created, zero when it is unknown
+	CreatedAt time.Time
+
+	//UpdatedAt is the date and time the task was last changed
+	UpdatedAt time.Time
+
+	//Recurrence is iCalendar RRULE the task repeats by, empty when the task does not repeat
+	Recurrence string
+
+	//ProjectID is the project the task belongs to, 0 when it is not in any project
+	ProjectID int64
+
+	//ParentID is the task this task is a subtask of, 0 for top level task
+	ParentID int64
+
+	//Labels of the task sorted by name, nil when the task has no labels
+	Labels []string
+
+	//DeletedAt is the date and time the task was moved to the trash, zero when it is not in the trash
+	DeletedAt time.Time
+
+	//Version is incremented by the repository on every change of the task, new task has version 1
+	Version int64
+
+	//Owner is the user the task belongs to, titles are unique among tasks of the owner in a project
+	Owner string
+
+	//Tenant is the tenant the task belongs to, empty for tasks stored without tenant.
+	//It is set by the repository from the tenant the repository is scoped to.
+	Tenant string
+}
+
+//Project is the persisted representation of a group of todo tasks
+type Project struct {
+	//ID is the unique identifier assigned by the storage backend
+	ID int64
+
+	//Name of the project
+	Name string
+
+	//Description of the project
+	Description string
+
+	//Archived project keeps its tasks but accepts no new ones
+	Archived bool
+
+	//CreatedAt is the date and time the project was created
+	CreatedAt time.Time
+
+	//UpdatedAt is the date and time the project was last changed
+	UpdatedAt time.Time
+}
+
+//Tenant is a team which data is kept apart from data of other tenants
+type Tenant struct {
+	//ID is the unique identifier of the tenant chosen by the administrator
+	ID string
+
+	//Name of the tenant
+	Name string
+
+	//MaxToDos is the maximum number of tasks of the tenant including tasks in the trash, 0 means no limit
+	MaxToDos int64
+
+	//Suspended tenant keeps its data but its users can't access it
+	Suspended bool
+
+	//CreatedAt is the date and time the tenant was created
+	CreatedAt time.Time
+
+	//UpdatedAt is the date and time the tenant was last changed
+	UpdatedAt time.Time
+}
+
+//Dependency is an edge of dependency graph: todo entity ToDoID can't be completed before DependsOnID
+type Dependency struct {
+	//ToDoID is the dependent todo entity
+	ToDoID int64
+
+	//DependsOnID is the prerequisite todo entity
+	DependsOnID int64
+}
+
+//LabelCount is a label and the number of todo entities having it
+type LabelCount struct {
+	//Name of the label
+	Name string
+
+	//Count is the number of todo entities having the label
+	Count int64
+}
+
+//Completion is a period todo entity stayed completed
+type Completion struct {
+	//CompletedAt is the date and time the task was completed
+	CompletedAt time.Time
+
+	//ReopenedAt is the date and time the task was reopened, zero while it is still completed
+	ReopenedAt time.Time
+}
+
+//ShareRole is what a user the todo entity is shared with may do with it
+type ShareRole string
+
+const (
+	//ShareViewer may read the todo entity
+	ShareViewer ShareRole = "VIEWER"
+
+	//ShareEditor may read and change the todo entity
+	ShareEditor ShareRole = "EDITOR"
+)
+
+//Share grants a user other than the owner access to todo entity
+type Share struct {
+	//ToDoID is the shared todo entity
+	ToDoID int64
+
+	//User the todo entity is shared with
+	User string
+
+	//Role of the user
+	Role ShareRole
+}
+
+//HistoryAction is kind of change recorded in the history
+type HistoryAction string
+
+const (
+	//HistoryCreated is creation of todo entity
+	HistoryCreated HistoryAction = "CREATED"
+
+	//HistoryUpdated is update of todo entity
+	HistoryUpdated HistoryAction = "UPDATED"
+
+	//HistoryDeleted is deletion of todo entity
+	HistoryDeleted HistoryAction = "DELETED"
+
+	//HistoryRestored is restoration of todo entity from the trash
+	HistoryRestored HistoryAction = "RESTORED"
+)
+
+//HistoryEntry is an immutable record of a change of todo entity
+type HistoryEntry struct {
+	//ID is assigned by the repository, later entries have greater IDs
+	ID int64
+
+	//ToDoID is the ID of the changed todo entity
+	ToDoID int64
+
+	//Action is kind of change
+	Action HistoryAction
+
+	//Snapshot holds formatted fields of todo entity after the change by field name, unset fields are missing
+	Snapshot map[string]string
+
+	//Actor is the caller who made the change
+	Actor string
+
+	//RequestID is the ID of the request which made the change
+	RequestID string
+
+	//CreatedAt is the date and time of the change
+	CreatedAt time.Time
+}
+
+//SortField is the todo entity field List sorts by
+type SortField string
+
+const (
+	//SortByID sorts by ID
+	SortByID SortField = "id"
+
+	//SortByTitle sorts by Title
+	SortByTitle SortField = "title"
+
+	//SortByStatus sorts by Status
+	SortByStatus SortField = "status"
+
+	//SortByEstimatedTimeOfCompletion sorts by EstimatedTimeOfCompletion
+	SortByEstimatedTimeOfCompletion SortField = "estimatedTimeOfCompletion"
+
+	//SortByReminder sorts by Reminder
+	SortByReminder SortField = "reminder"
+)
+
+//DeleteMode defines what happens to subtasks of deleted todo entity
+type DeleteMode int
+
+const (
+	//DeleteRestrict deletes only todo entity without subtasks
+	DeleteRestrict DeleteMode = iota
+
+	//DeleteCascade deletes todo entity together with its subtasks at all levels
+	DeleteCascade
+
+	//DeleteReparent moves subtasks to the parent of deleted todo entity
+	DeleteReparent
+)
+
+//BatchOpKind is the kind of change made by batch operation
+type BatchOpKind int
+
+const (
+	//BatchCreate creates todo entity like Create
+	BatchCreate BatchOpKind = iota
+
+	//BatchUpdate updates todo entity like Update
+	BatchUpdate
+
+	//BatchDelete deletes todo entity like Delete
+	BatchDelete
+
+	//BatchTrash moves todo entity to the trash like Trash
+	BatchTrash
+)
+
+//BatchOp is a change of todo entity made by Batch
+type BatchOp struct {
+	//Kind of the change
+	Kind BatchOpKind
+
+	//ToDo is created or updated todo entity, Batch sets ID of created todo entity and Version like Create and Update
+	ToDo *ToDo
+
+	//SkipExisting makes create succeed without creating anything when the title is already used by the owner in the project,
+	//ID of the todo entity is left 0
+	SkipExisting bool
+
+	//ID of deleted or trashed todo entity
+	ID int64
+
+	//Mode of the delete or trash
+	Mode DeleteMode
+
+	//DeletedAt is the time todo entity is moved to the trash at
+	DeletedAt time.Time
+
+	//Version is the expected version of trashed todo entity like in Trash
+	Version int64
+
+	//Rows is number of updated, deleted or trashed entities set by Batch
+	Rows int64
+}
+
+//ListOptions filters, sorts and limits todo entities returned by List.
+//Zero value returns all todo entities sorted by ID.
+type ListOptions struct {
+	//IDs returns only todo entities with the IDs when it is not empty
+	IDs []int64
+
+	//Status returns only todo entities with the status when it is not empty
+	Status string
+
+	//ReminderFrom returns only todo entities with Reminder at or after the time when it is not zero
+	ReminderFrom time.Time
+
+	//ReminderTo returns only todo entities with Reminder before the time when it is not zero
+	ReminderTo time.Time
+
+	//DueFrom returns only todo entities with EstimatedTimeOfCompletion at or after the time when it is not zero
+	DueFrom time.Time
+
+	//DueTo returns only todo entities with EstimatedTimeOfCompletion before the time when it is not zero
+	DueTo time.Time
+
+	//ProjectID returns only todo entities of the project when it is not 0
+	ProjectID int64
+
+	//ParentID returns only subtasks of the todo entity when it is not 0
+	ParentID int64
+
+	//Ready returns only todo entities which status is not in Closed and which prerequisites
+	//all have status in Closed when it is true
+	Ready bool
+
+	//Closed are statuses of todo entities which do not block dependent todo entities, used by Ready
+	Closed []string
+
+	//Labels returns only todo entities having all the labels when it is not empty
+	Labels []string
+
+	//AnyLabel returns todo entities having at least one of Labels instead of all of them
+	AnyLabel bool
+
+	//SortBy is the sort field, ID is always used as the tie breaker
+	SortBy SortField
+
+	//Descending reverses the sort order
+	Descending bool
+
+	//After returns only todo entities sorted after it (keyset pagination).
+	//Only ID and the SortBy field of After are used.
+	After *ToDo
+
+	//Limit is the maximum number of returned todo entities, 0 means no limit
+	Limit int
+
+	//ShowDeleted returns todo entities in the trash too
+	ShowDeleted bool
+
+	//VisibleTo returns only todo entities owned by or shared with the user when it is not empty
+	VisibleTo string
+}
+
+//ToDoRepository is the persistence contract of the ToDo service.
+//Every storage backend implements it so that the service does not depend on a particular database.
+//Repository works with todo entities of the tenant it is scoped to except DueReminders, ClaimReminder, ReleaseReminder,
+//MarkReminderSent and Purge which serve background jobs and work with todo entities of all tenants.
+//Todo entities in the trash are not found by any method except Trashed, List with ShowDeleted, Restore and Purge,
+//their titles stay in use until they are purged.
+type ToDoRepository interface {
+	//Create stores a new todo entity and returns its ID.
+	//Completion is opened when ActualTimeOfCompletion is set, Labels are stored with todo entity, td.Version is set to 1.
+	//ErrAlreadyExists is returned when the title is already used by the owner in the project,
+	//ErrParentNotFound when ParentID is set and the parent does not exist.
+	Create(ctx context.Context, td *ToDo) (int64, error)
+
+	//Get returns todo entity by ID or ErrNotFound
+	Get(ctx context.Context, id int64) (*ToDo, error)
+
+	//Trashed returns todo entity in the trash by ID or ErrNotFound
+	Trashed(ctx context.Context, id int64) (*ToDo, error)
+
+	//Update overwrites todo entity identified by td.ID and returns number of updated entities.
+	//Completion is opened when ActualTimeOfCompletion becomes set and
+	//the open completion is closed at UpdatedAt when ActualTimeOfCompletion is cleared.
+	//Changed Reminder is delivered again even if the previous one was delivered.
+	//ParentID and Labels are kept as they are stored, they are changed by Move, AddLabels and RemoveLabels, Owner is never changed.
+	//When td.Version is not 0 todo entity is updated only if it is the stored version, td.Version is set to the new version.
+	//ErrNotFound is returned when nothing was updated, ErrAlreadyExists when the new title is already used by the owner in the project
+	//and ErrVersionMismatch when the stored version is different.
+	Update(ctx context.Context, td *ToDo) (int64, error)
+
+	//Delete removes todo entity, its completions, dependencies, labels, shares and reminder delivery by ID and returns number of deleted entities.
+	//Subtasks are handled according to the mode, ErrHasChildren is returned by DeleteRestrict when there are subtasks.
+	//ErrNotFound is returned when nothing was deleted.
+	Delete(ctx context.Context, id int64, mode DeleteMode) (int64, error)
+
+	//Trash moves todo entity to the trash by setting its DeletedAt and returns number of trashed entities.
+	//Subtasks are handled according to the mode, subtasks trashed by DeleteCascade get the same DeletedAt.
+	//When version is not 0 todo entity is trashed only if it is the stored version.
+	//ErrHasChildren is returned by DeleteRestrict when there are subtasks, ErrNotFound when nothing was trashed
+	//and ErrVersionMismatch when the stored version is different.
+	Trash(ctx context.Context, id int64, version int64, mode DeleteMode, deletedAt time.Time) (int64, error)
+
+	//Restore takes todo entity out of the trash together with its subtasks trashed at the same time,
+	//sets their UpdatedAt and returns number of restored entities.
+	//ErrNotFound is returned when todo entity is not in the trash, ErrParentNotFound when its parent is not restored.
+	Restore(ctx context.Context, id int64, updatedAt time.Time) (int64, error)
+
+	//Purge removes todo entities moved to the trash before the time like Delete and returns number of removed entities
+	Purge(ctx context.Context, before time.Time) (int64, error)
+
+	//Move makes todo entity together with its subtasks a subtask of parentID, 0 makes it top level todo entity.
+	//It returns number of updated entities, ErrNotFound when todo entity does not exist,
+	//ErrParentNotFound when the parent does not exist and ErrCycle when the parent is the todo entity or its subtask.
+	Move(ctx context.Context, id int64, parentID int64, updatedAt time.Time) (int64, error)
+
+	//Descendants returns subtasks of todo entity at all levels in breadth-first order,
+	//subtasks of the same parent are sorted by ID. ErrNotFound is returned when todo entity does not exist.
+	Descendants(ctx context.Context, id int64) ([]*ToDo, error)
+
+	//AddDependency makes todo entity depend on the prerequisite dependsOnID.
+	//ErrNotFound is returned when todo entity does not exist, ErrPrerequisiteNotFound when the prerequisite does not exist,
+	//ErrDependencyExists when the dependency is already stored and ErrDependencyCycle when the prerequisite is the todo entity
+	//or depends on it directly or through other todo entities.
+	AddDependency(ctx context.Context, id int64, dependsOnID int64) error
+
+	//RemoveDependency removes dependency of todo entity on the prerequisite dependsOnID or returns ErrDependencyNotFound
+	RemoveDependency(ctx context.Context, id int64, dependsOnID int64) error
+
+	//Prerequisites returns todo entities the todo entity directly depends on sorted by ID
+	//or ErrNotFound when todo entity does not exist
+	Prerequisites(ctx context.Context, id int64) ([]*ToDo, error)
+
+	//Dependencies returns all dependencies sorted by ToDoID and DependsOnID
+	Dependencies(ctx context.Context) ([]*Dependency, error)
+
+	//AddLabels adds labels todo entity does not have yet and sets its UpdatedAt or returns ErrNotFound
+	AddLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error
+
+	//RemoveLabels removes labels todo entity has and sets its UpdatedAt or returns ErrNotFound
+	RemoveLabels(ctx context.Context, id int64, labels []string, updatedAt time.Time) error
+
+	//ListLabels returns labels of at least one todo entity sorted by name,
+	//only todo entities of the project are counted when projectID is not 0
+	//and only todo entities owned by or shared with the user when user is not empty
+	ListLabels(ctx context.Context, projectID int64, user string) ([]*LabelCount, error)
+
+	//List returns todo entities selected by the options
+	List(ctx context.Context, opts ListOptions) ([]*ToDo, error)
+
+	//CountToDos returns number of todo entities including those in the trash
+	CountToDos(ctx context.Context) (int64, error)
+
+	//Completions returns completion history of todo entity from the oldest to the latest or ErrNotFound
+	Completions(ctx context.Context, id int64) ([]*Completion, error)
+
+	//DueReminders returns todo entities which Reminder is set, is not after the time, is not delivered yet
+	//and is not claimed by a lease lasting after the time sorted by Reminder.
+	//Todo entities which status is in closed are finished and need no reminder, they are not returned.
+	DueReminders(ctx context.Context, until time.Time, closed []string) ([]*ToDo, error)
+
+	//ClaimReminder claims the reminder of todo entity for delivery until leaseUntil, the reminder is not due
+	//until then unless it is released. Only one caller succeeds for the same reminder, ErrNotFound is returned
+	//when todo entity does not exist, its Reminder is not the reminder any more, the reminder is already delivered
+	//or it is claimed by a lease lasting after now.
+	ClaimReminder(ctx context.Context, id int64, reminder time.Time, now time.Time, leaseUntil time.Time) error
+
+	//ReleaseReminder drops the claim of the reminder of todo entity which is not delivered,
+	//so the reminder is due again. ErrNotFound is returned when todo entity does not exist,
+	//its Reminder is not the reminder any more or the reminder is already delivered.
+	ReleaseReminder(ctx context.Context, id int64, reminder time.Time) error
+
+	//MarkReminderSent records that the reminder of todo entity is delivered at sentAt and drops its claim.
+	//Only one caller succeeds for the same reminder, ErrNotFound is returned when todo entity does not exist,
+	//its Reminder is not the reminder any more or the reminder is already delivered.
+	MarkReminderSent(ctx context.Context, id int64, reminder time.Time, sentAt time.Time) error
+
+	//Batch runs items of operations in one transaction, operations of an item succeed or fail together.
+	//It returns error of every item, nil for succeeded ones. When atomic is set the first failed item
+	//rolls back the whole batch and the following items are not run, otherwise only the failed items are rolled back.
+	//The error is returned when the transaction can't be started or committed.
+	Batch(ctx context.Context, items [][]*BatchOp, atomic bool) ([]error, error)
+}
+
+//ProjectRepository is the persistence contract of projects.
+//Projects are stored next to todo entities because deleting a project deletes its todo entities.
+type ProjectRepository interface {
+	//CreateProject stores a new project and returns its ID.
+	//ErrProjectAlreadyExists is returned when the name is already used.
+	CreateProject(ctx context.Context, p *Project) (int64, error)
+
+	//GetProject returns project by ID or ErrProjectNotFound
+	GetProject(ctx context.Context, id int64) (*Project, error)
+
+	//UpdateProject overwrites project identified by p.ID and returns number of updated projects.
+	//ErrProjectNotFound is returned when nothing was updated, ErrProjectAlreadyExists when the new name is already used.
+	UpdateProject(ctx context.Context, p *Project) (int64, error)
+
+	//DeleteProject removes project by ID and returns number of deleted todo entities.
+	//Todo entities of the project, their completion history, dependencies, labels and shares are deleted when cascade is true
+	//and their subtasks in other projects become top level todo entities, otherwise ErrProjectNotEmpty is returned when the project has todo entities.
+	//Todo entities in the trash belong to the project too.
+	//ErrProjectNotFound is returned when the project does not exist.
+	DeleteProject(ctx context.Context, id int64, cascade bool) (int64, error)
+
+	//ListProjects returns projects sorted by name, archived projects are returned when includeArchived is true
+	ListProjects(ctx context.Context, includeArchived bool) ([]*Project, error)
+}
+
+//HistoryRepository is the persistence contract of the change history of todo entities.
+//History entries are never changed, they are kept after their todo entities are deleted.
+type HistoryRepository interface {
+	//AddHistory stores a new history entry and returns its ID
+	AddHistory(ctx context.Context, e *HistoryEntry) (int64, error)
+
+	//History returns history entries of todo entity from the oldest to the latest,
+	//the list is empty when nothing was recorded
+	History(ctx context.Context, todoID int64) ([]*HistoryEntry, error)
+}
+
+//ShareRepository is the persistence contract of ownership and sharing of todo entities.
+//Shares of todo entities in the trash are kept, they are removed with their todo entities.
+type ShareRepository interface {
+	//Share grants the user the role on todo entity, the role of already shared todo entity is replaced.
+	//ErrNotFound is returned when todo entity does not exist.
+	Share(ctx context.Context, id int64, user string, role ShareRole) error
+
+	//Unshare revokes access of the user to todo entity or returns ErrShareNotFound
+	Unshare(ctx context.Context, id int64, user string) error
+
+	//Shares returns shares of todo entity sorted by user or ErrNotFound when todo entity does not exist
+	Shares(ctx context.Context, id int64) ([]*Share, error)
+
+	//ShareRole returns role of the user on todo entity, it is empty when todo entity is not shared with the user
+	ShareRole(ctx context.Context, id int64, user string) (ShareRole, error)
+
+	//AssignOwner makes the owner the owner of todo entities without owner including those in the trash
+	//and returns number of changed todo entities
+	AssignOwner(ctx context.Context, owner string) (int64, error)
+}
+
+//TenantRepository is the persistence contract of tenants.
+//Tenants are managed by administrators, the data of a tenant is accessed through the repository scoped to it.
+type TenantRepository interface {
+	//CreateTenant stores a new tenant or returns ErrTenantAlreadyExists when the ID is already used
+	CreateTenant(ctx context.Context, t *Tenant) error
+
+	//GetTenant returns tenant by ID or ErrTenantNotFound
+	GetTenant(ctx context.Context, id string) (*Tenant, error)
+
+	//UpdateTenant overwrites name, quota, suspension and UpdatedAt of tenant identified by t.ID
+	//and returns number of updated tenants or ErrTenantNotFound
+	UpdateTenant(ctx context.Context, t *Tenant) (int64, error)
+
+	//ListTenants returns tenants sorted by ID
+	ListTenants(ctx context.Context) ([]*Tenant, error)
+
+	//DeleteTenant removes tenant together with its todo entities, their completions, dependencies, labels and shares,
+	//its projects and its change history and returns number of deleted todo entities.
+	//ErrTenantNotFound is returned when the tenant does not exist.
+	DeleteTenant(ctx context.Context, id string) (int64, error)
+
+	//ForTenant returns repository scoped to the tenant, empty ID scopes it to data stored without tenant.
+	//The tenant does not have to be stored, repository of deleted tenant must not be used.
+	ForTenant(id string) Repository
+}
+
+//Repository stores todo entities, projects, the change history, shares and tenants
+type Repository interface {
+	ToDoRepository
+	ProjectRepository
+	HistoryRepository
+	ShareRepository
+	TenantRepository
+}
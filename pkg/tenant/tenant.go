@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+//Metadata is the metadata key of the tenant of the call, HTTP gateway sets it from X-Tenant-ID header
+const Metadata = "x-tenant-id"
+
+//MaxIDLength is the maximum length of tenant ID
+const MaxIDLength = 63
+
+//ValidID reports whether id is a valid tenant ID: 1 to MaxIDLength lowercase letters, digits and dashes
+//not starting or ending with a dash
+func ValidID(id string) bool {
+	if len(id) == 0 || len(id) > MaxIDLength || id[0] == '-' || id[len(id)-1] == '-' {
+		return false
+	}
+	for _, c := range id {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+//tenantKey is the context key of the tenant
+type tenantKey struct{}
+
+//NewContext returns context carrying the tenant of the call
+func NewContext(ctx context.Context, t *storage.Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, t)
+}
+
+//FromContext returns the tenant of the call, false is returned when the call is not scoped to a tenant
+func FromContext(ctx context.Context) (*storage.Tenant, bool) {
+	t, ok := ctx.Value(tenantKey{}).(*storage.Tenant)
+	return t, ok && t != nil
+}
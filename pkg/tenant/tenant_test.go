@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/basebandit/go-grpc/pkg/storage"
+)
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{"Letters", "blue", true},
+		{"Digits and dashes", "team-42", true},
+		{"Longest", strings.Repeat("a", MaxIDLength), true},
+		{"Too long", strings.Repeat("a", MaxIDLength+1), false},
+		{"Empty", "", false},
+		{"Uppercase", "Blue", false},
+		{"Leading dash", "-blue", false},
+		{"Trailing dash", "blue-", false},
+		{"Space", "blue team", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidID(tt.id); got != tt.want {
+				t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := FromContext(ctx); ok {
+		t.Errorf("FromContext() found tenant in empty context")
+	}
+	blue := &storage.Tenant{ID: "blue"}
+	if got, ok := FromContext(NewContext(ctx, blue)); !ok || got != blue {
+		t.Errorf("FromContext() = %v, %v, want %v", got, ok, blue)
+	}
+}
@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"go.uber.org/zap"
+)
+
+//Purger permanently removes todo entities which stayed in the trash longer than the retention period
+type Purger struct {
+	repo storage.ToDoRepository
+
+	//retention is how long todo entities are kept in the trash
+	retention time.Duration
+
+	//interval is how often the trash is purged
+	interval time.Duration
+
+	//now returns current time
+	now func() time.Time
+}
+
+//NewPurger creates purger which removes todo entities trashed more than retention ago every interval
+func NewPurger(repo storage.ToDoRepository, retention time.Duration, interval time.Duration) *Purger {
+	return &Purger{
+		repo:      repo,
+		retention: retention,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+//Run purges the trash until the context is done
+func (p *Purger) Run(ctx context.Context) {
+	logger.Log.Info("starting trash purger...", zap.Duration("retention", p.retention), zap.Duration("interval", p.interval))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if err := p.purge(ctx); err != nil {
+			logger.Log.Error("failed to purge trash", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Log.Info("trash purger stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//purge removes todo entities which retention period is over
+func (p *Purger) purge(ctx context.Context) error {
+	purged, err := p.repo.Purge(ctx, p.now().Add(-p.retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		logger.Log.Info("trash purged", zap.Int64("purged", purged))
+	}
+	return nil
+}
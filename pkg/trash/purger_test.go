@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/basebandit/go-grpc/pkg/logger"
+	"github.com/basebandit/go-grpc/pkg/storage"
+	"github.com/basebandit/go-grpc/pkg/storage/memory"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	logger.Log = zap.NewNop()
+	os.Exit(m.Run())
+}
+
+func TestPurgerPurge(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+	repo := memory.NewToDoRepository()
+	for i, title := range []string{"old", "recent", "kept"} {
+		id, err := repo.Create(ctx, &storage.ToDo{Title: title})
+		if err != nil {
+			t.Fatalf("toDoRepository.Create() error = %v", err)
+		}
+		if title == "kept" {
+			continue
+		}
+		if _, err := repo.Trash(ctx, id, 0, storage.DeleteRestrict, tm.Add(time.Duration(i)*time.Hour)); err != nil {
+			t.Fatalf("toDoRepository.Trash() error = %v", err)
+		}
+	}
+
+	p := NewPurger(repo, 24*time.Hour, time.Hour)
+	titles := func() []string {
+		list, _ := repo.List(ctx, storage.ListOptions{ShowDeleted: true})
+		var titles []string
+		for _, td := range list {
+			titles = append(titles, td.Title)
+		}
+		return titles
+	}
+
+	for _, tt := range []struct {
+		now  time.Time
+		want int
+	}{
+		{tm.Add(24 * time.Hour), 3},
+		{tm.Add(24*time.Hour + time.Minute), 2},
+		{tm.Add(48 * time.Hour), 1},
+	} {
+		p.now = func() time.Time { return tt.now }
+		if err := p.purge(ctx); err != nil {
+			t.Fatalf("Purger.purge() error = %v", err)
+		}
+		if got := titles(); len(got) != tt.want {
+			t.Errorf("Purger.purge() at %v left %v, want %d todo entities", tt.now, got, tt.want)
+		}
+	}
+	if got := titles(); len(got) != 1 || got[0] != "kept" {
+		t.Errorf("Purger.purge() left %v, want [kept]", got)
+	}
+}